@@ -54,7 +54,7 @@ func accountCommands() []*cobra.Command {
 					Amount:      amount,
 				}
 
-				tx, err := prepareTxBytes(msg, pk)
+				tx, err := prepareTxBytes(cmd.Context(), msg, pk)
 				if err != nil {
 					return err
 				}
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"regexp"
+	"strconv"
 	"strings"
 
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 	"github.com/spf13/cobra"
 )
@@ -68,6 +71,16 @@ func newActorCommands(cmdDef actorCmdDef) []*cobra.Command {
 		newEditStakeCmd(cmdDef),
 		newUnstakeCmd(cmdDef),
 		newUnpauseCmd(cmdDef),
+		newRotateKeyCmd(cmdDef),
+		newChangeOutputAddressCmd(cmdDef),
+	}
+	// only validators and service nodes share their rewards with delegators
+	if typesUtil.ValidateDelegationActorType(cmdDef.ActorType) == nil {
+		cmds = append(cmds, newDelegateCmd(cmdDef), newUndelegateCmd(cmdDef), newSetCommissionCmd(cmdDef))
+	}
+	// only validators sign consensus votes
+	if cmdDef.ActorType == coreTypes.ActorType_ACTOR_TYPE_VAL {
+		cmds = append(cmds, newRegisterBLSKeyCmd(cmdDef))
 	}
 	applySubcommandOptions(cmds, cmdDef)
 	return cmds
@@ -121,7 +134,7 @@ If no changes are desired for the parameter, just enter the current param value
 				ActorType:     cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd.Context(), msg, pk)
 			if err != nil {
 				return err
 			}
@@ -176,7 +189,7 @@ func newEditStakeCmd(cmdDef actorCmdDef) *cobra.Command {
 				ActorType:  cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd.Context(), msg, pk)
 			if err != nil {
 				return err
 			}
@@ -217,7 +230,7 @@ func newUnstakeCmd(cmdDef actorCmdDef) *cobra.Command {
 				ActorType: cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd.Context(), msg, pk)
 			if err != nil {
 				return err
 			}
@@ -258,7 +271,7 @@ func newUnpauseCmd(cmdDef actorCmdDef) *cobra.Command {
 				ActorType: cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd.Context(), msg, pk)
 			if err != nil {
 				return err
 			}
@@ -276,3 +289,209 @@ func newUnpauseCmd(cmdDef actorCmdDef) *cobra.Command {
 	}
 	return unpauseCmd
 }
+
+func newDelegateCmd(cmdDef actorCmdDef) *cobra.Command {
+	delegateCmd := &cobra.Command{
+		Use:   "Delegate <fromAddr> <actorAddr> <amount>",
+		Short: "Delegate <fromAddr> <actorAddr> <amount>",
+		Long:  fmt.Sprintf(`Delegates <amount> from address <fromAddr> to the %s actor with address <actorAddr>, sharing in its rewards`, cmdDef.Name),
+		Args:  cobra.ExactArgs(3), // REFACTOR(#150): <fromAddr> not being used at the moment. Update once a keybase is implemented.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO(#150): update when we have keybase
+			pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+			if err != nil {
+				return err
+			}
+
+			// TODO (team): passphrase is currently not used since there's no keybase yet, the prompt is here to mimick the real world UX
+			pwd = readPassphrase(pwd)
+
+			msg := &typesUtil.MessageDelegate{
+				Delegator:    pk.Address(),
+				ActorType:    cmdDef.ActorType,
+				ActorAddress: crypto.AddressFromString(args[1]),
+				Amount:       args[2],
+			}
+
+			return submitActorTx(cmd, pk, msg)
+		},
+	}
+	return delegateCmd
+}
+
+func newUndelegateCmd(cmdDef actorCmdDef) *cobra.Command {
+	undelegateCmd := &cobra.Command{
+		Use:   "Undelegate <fromAddr> <actorAddr>",
+		Short: "Undelegate <fromAddr> <actorAddr>",
+		Long:  fmt.Sprintf(`Starts unbonding the tokens delegated by address <fromAddr> to the %s actor with address <actorAddr>`, cmdDef.Name),
+		Args:  cobra.ExactArgs(2), // REFACTOR(#150): <fromAddr> not being used at the moment. Update once a keybase is implemented.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO(#150): update when we have keybase
+			pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+			if err != nil {
+				return err
+			}
+
+			// TODO (team): passphrase is currently not used since there's no keybase yet, the prompt is here to mimick the real world UX
+			pwd = readPassphrase(pwd)
+
+			msg := &typesUtil.MessageUndelegate{
+				Delegator:    pk.Address(),
+				ActorAddress: crypto.AddressFromString(args[1]),
+				ActorType:    cmdDef.ActorType,
+			}
+
+			return submitActorTx(cmd, pk, msg)
+		},
+	}
+	return undelegateCmd
+}
+
+func newSetCommissionCmd(cmdDef actorCmdDef) *cobra.Command {
+	setCommissionCmd := &cobra.Command{
+		Use:   "SetCommission <fromAddr> <percentage>",
+		Short: "SetCommission <fromAddr> <percentage>",
+		Long:  fmt.Sprintf(`Sets the percentage of its delegators' rewards kept by the %s actor with address <fromAddr>`, cmdDef.Name),
+		Args:  cobra.ExactArgs(2), // REFACTOR(#150): <fromAddr> not being used at the moment. Update once a keybase is implemented.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO(#150): update when we have keybase
+			pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+			if err != nil {
+				return err
+			}
+			percentage, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			// TODO (team): passphrase is currently not used since there's no keybase yet, the prompt is here to mimick the real world UX
+			pwd = readPassphrase(pwd)
+
+			msg := &typesUtil.MessageSetCommission{
+				ActorType:  cmdDef.ActorType,
+				Address:    pk.Address(),
+				Percentage: uint32(percentage),
+				Signer:     pk.Address(),
+			}
+
+			return submitActorTx(cmd, pk, msg)
+		},
+	}
+	return setCommissionCmd
+}
+
+func newRotateKeyCmd(cmdDef actorCmdDef) *cobra.Command {
+	rotateKeyCmd := &cobra.Command{
+		Use:   "RotateKey <fromAddr> <newPublicKey>",
+		Short: "RotateKey <fromAddr> <newPublicKey>",
+		Long:  fmt.Sprintf(`Replaces the operator key of the %s actor with address <fromAddr> with <newPublicKey>, keeping its stake, chains and delegations. Must be signed by the output address.`, cmdDef.Name),
+		Args:  cobra.ExactArgs(2), // REFACTOR(#150): <fromAddr> not being used at the moment. Update once a keybase is implemented.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO(#150): update when we have keybase
+			pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+			if err != nil {
+				return err
+			}
+			newPublicKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			// TODO (team): passphrase is currently not used since there's no keybase yet, the prompt is here to mimick the real world UX
+			pwd = readPassphrase(pwd)
+
+			msg := &typesUtil.MessageRotateKey{
+				ActorType:    cmdDef.ActorType,
+				Address:      pk.Address(),
+				NewPublicKey: newPublicKey,
+				Signer:       pk.Address(),
+			}
+
+			return submitActorTx(cmd, pk, msg)
+		},
+	}
+	return rotateKeyCmd
+}
+
+func newRegisterBLSKeyCmd(cmdDef actorCmdDef) *cobra.Command {
+	registerBLSKeyCmd := &cobra.Command{
+		Use:   "RegisterBLSKey <fromAddr>",
+		Short: "RegisterBLSKey <fromAddr>",
+		Long:  fmt.Sprintf(`Registers the BLS key derived from the private key of the %s with address <fromAddr>, which signs the consensus votes aggregated into quorum certificates.`, cmdDef.Name),
+		Args:  cobra.ExactArgs(1), // REFACTOR(#150): <fromAddr> not being used at the moment. Update once a keybase is implemented.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO(#150): update when we have keybase
+			pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+			if err != nil {
+				return err
+			}
+			blsKey, err := crypto.NewBLSPrivateKeyFromPrivateKey(pk)
+			if err != nil {
+				return err
+			}
+			proofOfPossession, err := blsKey.ProvePossession()
+			if err != nil {
+				return err
+			}
+
+			// TODO (team): passphrase is currently not used since there's no keybase yet, the prompt is here to mimick the real world UX
+			pwd = readPassphrase(pwd)
+
+			msg := &typesUtil.MessageRegisterBLSKey{
+				Address:           pk.Address(),
+				PublicKey:         blsKey.PublicKey(),
+				ProofOfPossession: proofOfPossession,
+				Signer:            pk.Address(),
+			}
+
+			return submitActorTx(cmd, pk, msg)
+		},
+	}
+	return registerBLSKeyCmd
+}
+
+func newChangeOutputAddressCmd(cmdDef actorCmdDef) *cobra.Command {
+	changeOutputAddressCmd := &cobra.Command{
+		Use:   "ChangeOutputAddress <fromAddr> <newOutputAddr>",
+		Short: "ChangeOutputAddress <fromAddr> <newOutputAddr>",
+		Long:  fmt.Sprintf(`Changes the output address of the %s actor with address <fromAddr> to <newOutputAddr>. Must be signed by the current output address.`, cmdDef.Name),
+		Args:  cobra.ExactArgs(2), // REFACTOR(#150): <fromAddr> not being used at the moment. Update once a keybase is implemented.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// TODO(#150): update when we have keybase
+			pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+			if err != nil {
+				return err
+			}
+
+			// TODO (team): passphrase is currently not used since there's no keybase yet, the prompt is here to mimick the real world UX
+			pwd = readPassphrase(pwd)
+
+			msg := &typesUtil.MessageChangeOutputAddress{
+				ActorType:        cmdDef.ActorType,
+				Address:          pk.Address(),
+				NewOutputAddress: crypto.AddressFromString(args[1]),
+				Signer:           pk.Address(),
+			}
+
+			return submitActorTx(cmd, pk, msg)
+		},
+	}
+	return changeOutputAddressCmd
+}
+
+func submitActorTx(cmd *cobra.Command, pk crypto.Ed25519PrivateKey, msg typesUtil.Message) error {
+	tx, err := prepareTxBytes(cmd.Context(), msg, pk)
+	if err != nil {
+		return err
+	}
+
+	resp, err := postRawTx(cmd.Context(), pk, tx)
+	if err != nil {
+		return err
+	}
+	// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
+	fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
+	fmt.Println(string(resp.Body))
+
+	return nil
+}
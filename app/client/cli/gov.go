@@ -2,7 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/pokt-network/pocket/rpc"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/utility/types"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -59,7 +64,7 @@ func govCommands() []*cobra.Command {
 					ParameterValue: pbValue,
 				}
 
-				tx, err := prepareTxBytes(msg, pk)
+				tx, err := prepareTxBytes(cmd.Context(), msg, pk)
 				if err != nil {
 					return err
 				}
@@ -72,9 +77,236 @@ func govCommands() []*cobra.Command {
 				fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
 				fmt.Println(string(resp.Body))
 
+				return nil
+			},
+		},
+		{
+			Use:     "SubmitParamChangeProposal <key> <value> <deposit>",
+			Short:   "SubmitParamChangeProposal <key> <value> <deposit>",
+			Long:    "Submits a proposal, escrowing <deposit>, to change the Governance parameter with <key> to <value>",
+			Aliases: []string{},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// TODO(#150): update when we have keybase
+				pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+				if err != nil {
+					return err
+				}
+
+				pbValue, err := proposalValueToAny(args[1])
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageSubmitProposal{
+					Proposer:     pk.Address(),
+					ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE,
+					Key:          args[0],
+					Value:        pbValue,
+					Deposit:      args[2],
+				}
+
+				return submitGovTx(cmd, pk, msg)
+			},
+		},
+		{
+			Use:     "SubmitFlagChangeProposal <key> <value> <enabled> <deposit>",
+			Short:   "SubmitFlagChangeProposal <key> <value> <enabled> <deposit>",
+			Long:    "Submits a proposal, escrowing <deposit>, to change the feature flag with <key> to <value> and <enabled> (true|false)",
+			Aliases: []string{},
+			Args:    cobra.ExactArgs(4),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// TODO(#150): update when we have keybase
+				pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+				if err != nil {
+					return err
+				}
+
+				pbValue, err := proposalValueToAny(args[1])
+				if err != nil {
+					return err
+				}
+
+				enabled, err := strconv.ParseBool(args[2])
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageSubmitProposal{
+					Proposer:     pk.Address(),
+					ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_FLAG_CHANGE,
+					Key:          args[0],
+					Value:        pbValue,
+					FlagEnabled:  enabled,
+					Deposit:      args[3],
+				}
+
+				return submitGovTx(cmd, pk, msg)
+			},
+		},
+		{
+			Use:     "SubmitPoolSpendProposal <pool> <recipient> <amount> <deposit>",
+			Short:   "SubmitPoolSpendProposal <pool> <recipient> <amount> <deposit>",
+			Long:    "Submits a proposal, escrowing <deposit>, to send <amount> from <pool> to address <recipient>",
+			Aliases: []string{},
+			Args:    cobra.ExactArgs(4),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// TODO(#150): update when we have keybase
+				pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+				if err != nil {
+					return err
+				}
+
+				recipient, err := crypto.NewAddress(args[1])
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageSubmitProposal{
+					Proposer:     pk.Address(),
+					ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND,
+					PoolName:     args[0],
+					Recipient:    recipient,
+					Amount:       args[2],
+					Deposit:      args[3],
+				}
+
+				return submitGovTx(cmd, pk, msg)
+			},
+		},
+		{
+			Use:     "Vote <proposalId> <yes|no|abstain>",
+			Short:   "Vote <proposalId> <yes|no|abstain>",
+			Long:    "Casts the vote of the validator on the proposal with <proposalId>; the vote can be changed until the voting period ends",
+			Aliases: []string{"vote"},
+			Args:    cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// TODO(#150): update when we have keybase
+				pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+				if err != nil {
+					return err
+				}
+
+				proposalId, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+
+				option, ok := voteOptions[strings.ToLower(args[1])]
+				if !ok {
+					return fmt.Errorf("invalid vote option %s, expected one of yes, no or abstain", args[1])
+				}
+
+				msg := &types.MessageVote{
+					Voter:      pk.Address(),
+					ProposalId: proposalId,
+					Option:     option,
+				}
+
+				return submitGovTx(cmd, pk, msg)
+			},
+		},
+		{
+			Use:     "ScheduleUpgrade <name> <height>",
+			Short:   "ScheduleUpgrade <name> <height>",
+			Long:    "Schedules the protocol upgrade <name> to activate at <height>; nodes whose binary does not implement the upgrade halt at <height>",
+			Aliases: []string{},
+			Args:    cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// TODO(#150): update when we have keybase
+				pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+				if err != nil {
+					return err
+				}
+
+				height, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageUpgradePlan{
+					Signer: pk.Address(),
+					Name:   args[0],
+					Height: height,
+				}
+
+				return submitGovTx(cmd, pk, msg)
+			},
+		},
+		{
+			Use:     "CancelUpgrade <name>",
+			Short:   "CancelUpgrade <name>",
+			Long:    "Cancels the scheduled protocol upgrade <name> if it has not been activated yet",
+			Aliases: []string{},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// TODO(#150): update when we have keybase
+				pk, err := readEd25519PrivateKeyFromFile(privateKeyFilePath)
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageUpgradePlan{
+					Signer: pk.Address(),
+					Name:   args[0],
+					Cancel: true,
+				}
+
+				return submitGovTx(cmd, pk, msg)
+			},
+		},
+		{
+			Use:     "Proposals",
+			Short:   "Returns the governance proposals and their votes",
+			Long:    "Proposals returns all the governance proposals, and the votes cast on them, at the node's current height",
+			Aliases: []string{"proposals"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := rpc.NewClientWithResponses(remoteCLIURL)
+				if err != nil {
+					return err
+				}
+				response, err := client.GetV1QueryProposalsWithResponse(cmd.Context())
+				if err != nil {
+					return unableToConnectToRpc(err)
+				}
+				// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
+				fmt.Println(string(response.Body))
+
 				return nil
 			},
 		},
 	}
 	return cmds
 }
+
+var voteOptions = map[string]coreTypes.VoteOption{
+	"yes":     coreTypes.VoteOption_VOTE_OPTION_YES,
+	"no":      coreTypes.VoteOption_VOTE_OPTION_NO,
+	"abstain": coreTypes.VoteOption_VOTE_OPTION_ABSTAIN,
+}
+
+// proposalValueToAny wraps numeric values as `Int32Value`s and everything else as `StringValue`s,
+// which are the types the utility module expects for the respective params and flags
+func proposalValueToAny(value string) (*anypb.Any, error) {
+	if i, err := strconv.ParseInt(value, 10, 32); err == nil {
+		return anypb.New(wrapperspb.Int32(int32(i)))
+	}
+	return anypb.New(wrapperspb.String(value))
+}
+
+func submitGovTx(cmd *cobra.Command, pk crypto.Ed25519PrivateKey, msg types.Message) error {
+	tx, err := prepareTxBytes(cmd.Context(), msg, pk)
+	if err != nil {
+		return err
+	}
+
+	resp, err := postRawTx(cmd.Context(), pk, tx)
+	if err != nil {
+		return err
+	}
+	// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
+	fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
+	fmt.Println(string(resp.Body))
+
+	return nil
+}
@@ -91,19 +91,29 @@ func confirmation(pwd string) bool {
 	}
 }
 
+// txValidityBlocks is the number of blocks, after the current height of the node, during which the
+// transactions signed by the CLI can be included in a block
+const txValidityBlocks = 60
+
 // prepareTxBytes wraps a Message into a Transaction and signs it with the provided pk
 //
 // returns the raw protobuf bytes of the signed transaction
-func prepareTxBytes(msg typesUtil.Message, pk crypto.Ed25519PrivateKey) ([]byte, error) {
+func prepareTxBytes(ctx context.Context, msg typesUtil.Message, pk crypto.Ed25519PrivateKey) ([]byte, error) {
 	var err error
 	anyMsg, err := codec.GetCodec().ToAny(msg)
 	if err != nil {
 		return nil, err
 	}
 
+	maxHeight, err := getTxMaxHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := &typesUtil.Transaction{
-		Msg:   anyMsg,
-		Nonce: getNonce(),
+		Msg:       anyMsg,
+		Nonce:     getNonce(),
+		MaxHeight: maxHeight,
 	}
 
 	signBytes, err := tx.SignBytes()
@@ -146,6 +156,22 @@ func postRawTx(ctx context.Context, pk crypto.Ed25519PrivateKey, j []byte) (*rpc
 	return resp, nil
 }
 
+// getTxMaxHeight returns the max height of a transaction signed now, based on the current height of the node
+func getTxMaxHeight(ctx context.Context) (int64, error) {
+	client, err := rpc.NewClientWithResponses(remoteCLIURL)
+	if err != nil {
+		return 0, err
+	}
+	response, err := client.GetV1ConsensusStateWithResponse(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if response.JSON200 == nil {
+		return 0, fmt.Errorf("unable to get the current height: HTTP %d", response.StatusCode())
+	}
+	return response.JSON200.Height + txValidityBlocks, nil
+}
+
 func getNonce() string {
 	rand.Seed(time.Now().UTC().UnixNano())
 	return fmt.Sprintf("%d", rand.Uint64())
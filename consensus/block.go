@@ -6,15 +6,48 @@ import (
 	"unsafe"
 
 	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 )
 
-func (m *consensusModule) commitBlock(block *coreTypes.Block) error {
+// GetBlockHash identifies a block by the hash of its serialization, which is known before the block is committed and
+// therefore before it has a state hash (e.g. in chained HotStuff). Votes sign the hash of their block.
+func GetBlockHash(block *coreTypes.Block) string {
+	if block == nil {
+		return ""
+	}
+	blockBz, err := codec.GetCodec().Marshal(block)
+	if err != nil {
+		log.Fatalf("Could not marshal block: %v", err)
+	}
+	return cryptoPocket.GetHashStringFromBytes(blockBz)
+}
+
+// commitBlock commits the block along with its commit quorum certificate, which is stored in the header of the
+// committed block so peers can validate it when it is synced
+func (m *consensusModule) commitBlock(block *coreTypes.Block, commitQC *typesCons.QuorumCertificate) error {
+	commitQCBytes, err := codec.GetCodec().Marshal(commitQC)
+	if err != nil {
+		return err
+	}
+
 	// Commit the context
-	if err := m.utilityContext.Commit(block.BlockHeader.QuorumCertificate); err != nil {
+	if err := m.utilityContext.Commit(commitQCBytes); err != nil {
 		return err
 	}
-	m.nodeLog(typesCons.CommittingBlock(m.height, len(block.Transactions)))
+	height := block.GetBlockHeader().GetHeight()
+	m.nodeLog(typesCons.CommittingBlock(height, len(block.Transactions)))
+
+	// The evidence handled by the block does not need to be proposed again
+	m.pruneEvidencePool(block.Evidence)
+
+	// The node cannot sign anything at the committed height anymore
+	m.pruneWAL(height)
+	m.pruneValidatorSets(height)
+
+	// The rounds of the next height start with the configured timeout again
+	m.paceMaker.ResetBackoff()
 
 	// Release the context
 	if err := m.utilityContext.Release(); err != nil {
@@ -67,6 +100,12 @@ func (m *consensusModule) isValidMessageBlock(msg *typesCons.HotstuffMessage) (b
 
 // Creates a new Utility context and clears/nullifies any previous contexts if they exist
 func (m *consensusModule) refreshUtilityContext() error {
+	return m.refreshUtilityContextAtHeight(m.height)
+}
+
+// refreshUtilityContextAtHeight creates the utility context of a height other than the current one; chained HotStuff
+// applies the blocks it commits after the node moved to later heights
+func (m *consensusModule) refreshUtilityContextAtHeight(height uint64) error {
 	// Catch-all structure to release the previous utility context if it wasn't properly cleaned up.
 	// Ideally, this should not be called.
 	if m.utilityContext != nil {
@@ -83,11 +122,16 @@ func (m *consensusModule) refreshUtilityContext() error {
 		log.Printf("[WARN] Error releasing persistence write context: %v\n", err)
 	}
 
-	utilityContext, err := m.GetBus().GetUtilityModule().NewContext(int64(m.height))
+	utilityContext, err := m.GetBus().GetUtilityModule().NewContext(int64(height))
 	if err != nil {
 		return err
 	}
 
+	// Halt before applying a block with the rules of an upgrade this binary does not implement
+	if err := utilityContext.CheckScheduledUpgrades(); err != nil {
+		return m.haltForUpgrade(utilityContext, height, err)
+	}
+
 	m.utilityContext = utilityContext
 	return nil
 }
@@ -0,0 +1,118 @@
+package consensus
+
+import (
+	"time"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// While waiting for the empty block interval, the leader checks the mempool again this often so it proposes
+// as soon as a transaction arrives
+const emptyBlockRecheckInterval = 500 * time.Millisecond
+
+// getBlockTime returns the time of the block proposed by the node. It never goes back in time compared to the
+// parent block, even if the local clock is behind the proposer of the parent.
+func (m *consensusModule) getBlockTime(parentTime time.Time) *timestamppb.Timestamp {
+	now := m.GetBus().GetRuntimeMgr().GetClock().Now()
+	if now.Before(parentTime) {
+		return timestamppb.New(parentTime)
+	}
+	return timestamppb.New(now)
+}
+
+// getCommittedBlockTime returns the time of the committed block at `height`; the time of the genesis is the
+// time of the chain before its first block
+func (m *consensusModule) getCommittedBlockTime(height uint64) (time.Time, error) {
+	if height == 0 {
+		return m.genesisState.GetGenesisTime().AsTime(), nil
+	}
+	block, err := m.GetBus().GetPersistenceModule().GetBlock(int64(height))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return block.GetBlockHeader().GetTime().AsTime(), nil
+}
+
+// validateBlockTime checks that the time of a proposed block does not go back in time compared to its parent,
+// and is not further ahead of the local time than the allowed drift
+func (m *consensusModule) validateBlockTime(block *coreTypes.Block, parentTime time.Time) error {
+	blockTimestamp := block.GetBlockHeader().GetTime()
+	if blockTimestamp == nil {
+		return typesCons.ErrNilBlockTime
+	}
+	blockTime := blockTimestamp.AsTime()
+	if blockTime.Before(parentTime) {
+		return typesCons.ErrBlockTimeBeforeParent(blockTime, parentTime)
+	}
+	if maxDrift := time.Duration(m.consCfg.GetMaxBlockTimeDriftMsec()) * time.Millisecond; maxDrift > 0 {
+		maxTime := m.GetBus().GetRuntimeMgr().GetClock().Now().Add(maxDrift)
+		if blockTime.After(maxTime) {
+			return typesCons.ErrBlockTimeTooFarInFuture(blockTime, maxTime)
+		}
+	}
+	return nil
+}
+
+// getProposalDelay returns how long the leader waits before proposing a new block: until the target block interval
+// elapsed since the parent block, and if there is nothing to propose, until the empty block interval elapsed
+func (m *consensusModule) getProposalDelay() (time.Duration, error) {
+	targetInterval := time.Duration(m.consCfg.GetTargetBlockIntervalMsec()) * time.Millisecond
+	emptyInterval := time.Duration(m.consCfg.GetEmptyBlockIntervalMsec()) * time.Millisecond
+	if targetInterval == 0 && emptyInterval == 0 {
+		return 0, nil
+	}
+
+	parentTime, err := m.getCommittedBlockTime(m.height - 1)
+	if err != nil {
+		return 0, err
+	}
+	now := m.GetBus().GetRuntimeMgr().GetClock().Now()
+	delay := parentTime.Add(targetInterval).Sub(now)
+
+	if len(m.evidencePool) == 0 && m.GetBus().GetUtilityModule().IsMempoolEmpty() {
+		if emptyDelay := parentTime.Add(emptyInterval).Sub(now); emptyDelay > delay {
+			delay = emptyDelay
+			if delay > emptyBlockRecheckInterval {
+				delay = emptyBlockRecheckInterval
+			}
+		}
+	}
+	return delay, nil
+}
+
+// scheduleProposal retries to propose the block of the current round once the delay elapsed, if the node is
+// still waiting to propose it by then
+func (m *consensusModule) scheduleProposal(handler *HotstuffLeaderMessageHandler, delay time.Duration) {
+	if m.proposalTimer != nil {
+		m.proposalTimer.Stop()
+	}
+	height, round := m.height, m.round
+	m.proposalTimer = m.GetBus().GetRuntimeMgr().GetClock().AfterFunc(delay, func() {
+		m.m.Lock()
+		defer m.m.Unlock()
+
+		if m.height != height || m.round != round || m.step != NewRound || !m.IsLeader() {
+			return
+		}
+		handler.proposeBlock(m)
+		if m.step != NewRound {
+			m.paceMaker.RestartTimer()
+		}
+	})
+}
+
+// delayNewRoundTimeout lets the validators wait for the leader of the next height, which may wait for the target and
+// empty block intervals since the committed block before proposing
+func (m *consensusModule) delayNewRoundTimeout(committedBlock *coreTypes.Block) {
+	blockInterval := m.consCfg.GetTargetBlockIntervalMsec()
+	if emptyInterval := m.consCfg.GetEmptyBlockIntervalMsec(); emptyInterval > blockInterval {
+		blockInterval = emptyInterval
+	}
+	if blockInterval == 0 {
+		return
+	}
+	blockTime := committedBlock.GetBlockHeader().GetTime().AsTime()
+	m.paceMaker.DelayNewRoundTimeout(blockTime.Add(time.Duration(blockInterval) * time.Millisecond))
+}
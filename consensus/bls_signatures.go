@@ -0,0 +1,181 @@
+package consensus
+
+/*
+	BLS aggregate threshold signatures:
+
+	1. Every validator registers a BLS12-381 public key, with a proof of possession of its private key, through the
+	   utility module; the private key is derived from the ed25519 key of the validator
+	2. Replicas sign their votes with both their ed25519 key, which identifies them and is used as evidence of
+	   double signs, and their BLS key
+	3. The leader aggregates the BLS signatures of the votes into a single signature and records the signers in a
+	   bitmap indexed by their NodeId, so the size of the QC does not grow with the number of validators
+	4. A QC is valid if the signers in the bitmap are 2/3+ of the validators and the aggregate signature verifies
+	   against the aggregate of their registered BLS public keys, which takes a single pairing check
+*/
+
+import (
+	"encoding/hex"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// SignVoteBLS adds the BLS signature of the vote by `blsKey` to its partial signature
+func SignVoteBLS(msg *typesCons.HotstuffMessage, blsKey *cryptoPocket.BLSPrivateKey) error {
+	partialSig := msg.GetPartialSignature()
+	if partialSig == nil {
+		return typesCons.ErrNilPartialSig
+	}
+	bytesToSign, err := getSignableBytes(msg)
+	if err != nil {
+		return err
+	}
+	blsSignature, err := blsKey.Sign(bytesToSign)
+	if err != nil {
+		return err
+	}
+	partialSig.BlsSignature = blsSignature
+	return nil
+}
+
+// getAggregateThresholdSignature aggregates the BLS signatures of the votes and records their signers in a bitmap
+func getAggregateThresholdSignature(partialSigs []*typesCons.PartialSignature, validators []*coreTypes.Actor) (*typesCons.ThresholdSignature, error) {
+	valAddrToIdMap := typesCons.NewActorMapper(validators).GetValAddrToIdMap()
+	signerBitmap := make([]byte, signerBitmapLen(len(validators)))
+	blsSignatures := make([][]byte, 0, len(partialSigs))
+	for _, partialSig := range partialSigs {
+		nodeId, ok := valAddrToIdMap[partialSig.GetAddress()]
+		if !ok {
+			return nil, typesCons.ErrMissingValidator(partialSig.GetAddress(), nodeId)
+		}
+		if partialSig.GetBlsSignature() == nil {
+			return nil, typesCons.ErrNilBLSSignature
+		}
+		// The vote of a validator is only aggregated once
+		index := int(nodeId) - 1
+		if isSignerSet(signerBitmap, index) {
+			continue
+		}
+		signerBitmap[index/8] |= 1 << (index % 8)
+		blsSignatures = append(blsSignatures, partialSig.GetBlsSignature())
+	}
+
+	aggregateSignature, err := cryptoPocket.AggregateBLSSignatures(blsSignatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesCons.ThresholdSignature{
+		AggregateSignature: aggregateSignature,
+		SignerBitmap:       signerBitmap,
+	}, nil
+}
+
+// validateAggregateThresholdSignature verifies that the aggregate signature of the message was produced by validators
+// holding 2/3+ of the voting power, against the BLS keys they registered as of `height`
+func (m *consensusModule) validateAggregateThresholdSignature(msg *typesCons.HotstuffMessage, thresholdSig *typesCons.ThresholdSignature, validators []*coreTypes.Actor, height uint64) error {
+	if len(thresholdSig.GetAggregateSignature()) == 0 {
+		return typesCons.ErrNilThresholdSigInQC
+	}
+
+	signers, err := getSigners(thresholdSig.GetSignerBitmap(), validators)
+	if err != nil {
+		return err
+	}
+	if err := m.isQuorumMet(signers, validators); err != nil {
+		return err
+	}
+
+	blsPubKeys, err := m.getValidatorBLSKeys(height, signers)
+	if err != nil {
+		return err
+	}
+	bytesToVerify, err := getSignableBytes(msg)
+	if err != nil {
+		return err
+	}
+	if !cryptoPocket.VerifyBLSAggregateSignature(blsPubKeys, bytesToVerify, thresholdSig.GetAggregateSignature()) {
+		return typesCons.ErrInvalidAggregateSignature
+	}
+
+	return nil
+}
+
+// validateBLSSignature verifies the BLS signature of a vote against the BLS key registered by its sender, so an
+// invalid signature cannot invalidate the aggregate the leader computes
+func (m *consensusModule) validateBLSSignature(msg *typesCons.HotstuffMessage, nodeId typesCons.NodeId) error {
+	partialSig := msg.GetPartialSignature()
+	if partialSig.GetBlsSignature() == nil {
+		return typesCons.ErrNilBLSSignature
+	}
+
+	blsPubKeys, err := m.getValidatorBLSKeys(msg.GetHeight(), []string{partialSig.GetAddress()})
+	if err != nil {
+		return err
+	}
+	bytesToVerify, err := getSignableBytes(msg)
+	if err != nil {
+		return err
+	}
+	if !cryptoPocket.VerifyBLSSignature(blsPubKeys[0], bytesToVerify, partialSig.GetBlsSignature()) {
+		return typesCons.ErrValidatingBLSSig(partialSig.GetAddress(), nodeId, msg)
+	}
+
+	return nil
+}
+
+// getValidatorBLSKeys returns the BLS keys registered by the validators at the given addresses
+func (m *consensusModule) getValidatorBLSKeys(height uint64, addresses []string) ([][]byte, error) {
+	readCtx, err := m.GetBus().GetPersistenceModule().NewReadContext(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	defer readCtx.Close()
+
+	blsPubKeys := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		addrBz, err := hex.DecodeString(address)
+		if err != nil {
+			return nil, err
+		}
+		blsKey, err := readCtx.GetValidatorBLSKey(addrBz, int64(height))
+		if err != nil {
+			return nil, err
+		}
+		if blsKey == nil {
+			return nil, typesCons.ErrMissingBLSKey(address)
+		}
+		blsPubKeys = append(blsPubKeys, blsKey.GetPublicKey())
+	}
+	return blsPubKeys, nil
+}
+
+// getSigners returns the addresses of the validators set in the signer bitmap
+func getSigners(signerBitmap []byte, validators []*coreTypes.Actor) ([]string, error) {
+	if len(signerBitmap) != signerBitmapLen(len(validators)) {
+		return nil, typesCons.ErrInvalidSignerBitmap
+	}
+
+	idToValAddrMap := typesCons.NewActorMapper(validators).GetIdToValAddrMap()
+	signers := make([]string, 0, len(validators))
+	for index := 0; index < len(signerBitmap)*8; index++ {
+		if !isSignerSet(signerBitmap, index) {
+			continue
+		}
+		// The padding bits of the last byte must not be set
+		if index >= len(validators) {
+			return nil, typesCons.ErrInvalidSignerBitmap
+		}
+		signers = append(signers, idToValAddrMap[typesCons.NodeId(index+1)])
+	}
+	return signers, nil
+}
+
+func signerBitmapLen(numValidators int) int {
+	return (numValidators + 7) / 8
+}
+
+func isSignerSet(signerBitmap []byte, index int) bool {
+	return signerBitmap[index/8]&(1<<(index%8)) != 0
+}
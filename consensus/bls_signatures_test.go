@@ -0,0 +1,129 @@
+package consensus
+
+import (
+	"testing"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetThresholdSignature_CompareSchemes(t *testing.T) {
+	for _, numValidators := range []int{4, 16, 64} {
+		validators, privKeys := newTestingValidators(t, numValidators)
+		vote := newTestingVote(t, privKeys[0], 1, Commit, "state_hash")
+		partialSigs := newTestingPartialSigs(t, vote, privKeys)
+
+		ed25519Sig, err := getThresholdSignature(configs.ThresholdSignatureScheme_Ed25519Signatures, partialSigs, validators)
+		require.NoError(t, err)
+		require.Len(t, ed25519Sig.GetSignatures(), numValidators)
+		require.Empty(t, ed25519Sig.GetAggregateSignature())
+
+		blsSig, err := getThresholdSignature(configs.ThresholdSignatureScheme_BLSAggregateSignature, partialSigs, validators)
+		require.NoError(t, err)
+		require.Empty(t, blsSig.GetSignatures())
+		require.Len(t, blsSig.GetAggregateSignature(), cryptoPocket.BLSSignatureLen)
+		require.Len(t, blsSig.GetSignerBitmap(), signerBitmapLen(numValidators))
+
+		// The size of the aggregate only grows with the signer bitmap
+		ed25519SigBz, err := codec.GetCodec().Marshal(ed25519Sig)
+		require.NoError(t, err)
+		blsSigBz, err := codec.GetCodec().Marshal(blsSig)
+		require.NoError(t, err)
+		require.Less(t, len(blsSigBz), len(ed25519SigBz))
+		require.LessOrEqual(t, len(blsSigBz), cryptoPocket.BLSSignatureLen+signerBitmapLen(numValidators)+8)
+
+		// Both schemes certify the same signers
+		signers, err := getSigners(blsSig.GetSignerBitmap(), validators)
+		require.NoError(t, err)
+		ed25519Signers := make([]string, 0, numValidators)
+		for _, partialSig := range ed25519Sig.GetSignatures() {
+			ed25519Signers = append(ed25519Signers, partialSig.GetAddress())
+		}
+		require.ElementsMatch(t, ed25519Signers, signers)
+
+		blsPubKeys := make([][]byte, 0, numValidators)
+		for _, signer := range signers {
+			blsPubKeys = append(blsPubKeys, getTestingBLSPublicKey(t, privKeys, signer))
+		}
+		bytesToVerify, err := getSignableBytes(vote)
+		require.NoError(t, err)
+		require.True(t, cryptoPocket.VerifyBLSAggregateSignature(blsPubKeys, bytesToVerify, blsSig.GetAggregateSignature()))
+	}
+}
+
+func TestGetAggregateThresholdSignature_SignerBitmap(t *testing.T) {
+	validators, privKeys := newTestingValidators(t, 10)
+	vote := newTestingVote(t, privKeys[0], 1, Commit, "state_hash")
+
+	// Duplicate votes are only aggregated once
+	partialSigs := newTestingPartialSigs(t, vote, privKeys[:7])
+	partialSigs = append(partialSigs, partialSigs[0])
+	thresholdSig, err := getAggregateThresholdSignature(partialSigs, validators)
+	require.NoError(t, err)
+	signers, err := getSigners(thresholdSig.GetSignerBitmap(), validators)
+	require.NoError(t, err)
+	require.Len(t, signers, 7)
+
+	// The bitmap must match the size of the validator set
+	_, err = getSigners(append(thresholdSig.GetSignerBitmap(), 0), validators)
+	require.ErrorIs(t, err, typesCons.ErrInvalidSignerBitmap)
+
+	// The padding bits must not be set
+	paddedBitmap := append([]byte{}, thresholdSig.GetSignerBitmap()...)
+	paddedBitmap[1] |= 1 << 7
+	_, err = getSigners(paddedBitmap, validators)
+	require.ErrorIs(t, err, typesCons.ErrInvalidSignerBitmap)
+
+	// Votes without a BLS signature cannot be aggregated
+	partialSigs[0] = &typesCons.PartialSignature{
+		Signature: partialSigs[0].GetSignature(),
+		Address:   partialSigs[0].GetAddress(),
+	}
+	_, err = getAggregateThresholdSignature(partialSigs, validators)
+	require.ErrorIs(t, err, typesCons.ErrNilBLSSignature)
+}
+
+func newTestingValidators(t *testing.T, numValidators int) ([]*coreTypes.Actor, []cryptoPocket.PrivateKey) {
+	validators := make([]*coreTypes.Actor, 0, numValidators)
+	privKeys := make([]cryptoPocket.PrivateKey, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		privKey, err := cryptoPocket.GeneratePrivateKey()
+		require.NoError(t, err)
+		validators = append(validators, &coreTypes.Actor{
+			Address:   privKey.Address().String(),
+			PublicKey: privKey.PublicKey().String(),
+		})
+		privKeys = append(privKeys, privKey)
+	}
+	return validators, privKeys
+}
+
+// newTestingPartialSigs returns the partial signatures, including the BLS signatures, of the vote by `privKeys`
+func newTestingPartialSigs(t *testing.T, vote *typesCons.HotstuffMessage, privKeys []cryptoPocket.PrivateKey) []*typesCons.PartialSignature {
+	partialSigs := make([]*typesCons.PartialSignature, 0, len(privKeys))
+	for _, privKey := range privKeys {
+		signedVote, err := CreateVoteMessage(vote.GetHeight(), vote.GetRound(), vote.GetStep(), vote.GetBlock(), privKey)
+		require.NoError(t, err)
+		blsKey, err := cryptoPocket.NewBLSPrivateKeyFromPrivateKey(privKey)
+		require.NoError(t, err)
+		require.NoError(t, SignVoteBLS(signedVote, blsKey))
+		partialSigs = append(partialSigs, signedVote.GetPartialSignature())
+	}
+	return partialSigs
+}
+
+func getTestingBLSPublicKey(t *testing.T, privKeys []cryptoPocket.PrivateKey, address string) []byte {
+	for _, privKey := range privKeys {
+		if privKey.Address().String() == address {
+			blsKey, err := cryptoPocket.NewBLSPrivateKeyFromPrivateKey(privKey)
+			require.NoError(t, err)
+			return blsKey.PublicKey()
+		}
+	}
+	require.FailNow(t, "no private key for address", address)
+	return nil
+}
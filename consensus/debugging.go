@@ -39,6 +39,9 @@ func (m *consensusModule) GetNodeState() typesCons.ConsensusNodeState {
 		Step:     uint8(m.step),
 		IsLeader: m.IsLeader(),
 		LeaderId: leaderId,
+
+		PrepareQC: m.prepareQC,
+		LockedQC:  m.lockedQC,
 	}
 }
 
@@ -49,6 +52,8 @@ func (m *consensusModule) resetToGenesis(_ *messaging.DebugMessage) {
 	m.ResetForNewHeight()
 	m.clearLeader()
 	m.clearMessagesPool()
+	m.clearWAL()
+	m.chained = newChainedState()
 	m.GetBus().GetPersistenceModule().HandleDebugMessage(&messaging.DebugMessage{
 		Action:  messaging.DebugMessageAction_DEBUG_PERSISTENCE_RESET_TO_GENESIS,
 		Message: nil,
@@ -0,0 +1,90 @@
+package e2e_tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainedHotstuff4Nodes3BlocksCommitFirstBlock(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	// Test configs
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	for _, runtimeMgr := range runtimeMgrs {
+		runtimeMgr.GetConfig().Consensus.HotstuffMode = configs.HotstuffMode_ChainedHotstuff
+	}
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	// Debug message to start consensus by triggering first view change
+	for _, pocketNode := range pocketNodes {
+		TriggerNextView(t, pocketNode)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	newRoundMessages, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, 250, true)
+	require.NoError(t, err)
+	for _, message := range newRoundMessages {
+		P2PBroadcast(t, pocketNodes, message)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	// Leader election is deterministic: node 2 leads height 1, and the leader of each height aggregates the votes
+	// for the block of the previous height into the QC justifying its own proposal
+	for height, leaderId := range map[uint64]typesCons.NodeId{1: 2, 2: 3, 3: 4, 4: 1} {
+		proposals, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Propose, numValidators, 250, true)
+		require.NoError(t, err)
+		require.Len(t, proposals, 1)
+		proposal := getHotstuffMessage(t, proposals[0])
+		require.Equal(t, height, proposal.GetHeight())
+		require.Equal(t, height, proposal.GetBlock().GetBlockHeader().GetHeight())
+		if height > 1 {
+			require.Equal(t, height-1, proposal.GetQuorumCertificate().GetHeight())
+		}
+		require.Equal(t, leaderId, GetConsensusNodeState(pocketNodes[leaderId]).LeaderId)
+
+		P2PBroadcast(t, pocketNodes, proposals[0])
+		advanceTime(t, clockMock, 10*time.Millisecond)
+
+		for nodeId, pocketNode := range pocketNodes {
+			assertNodeConsensusView(t, nodeId,
+				typesCons.ConsensusNodeState{
+					Height: height,
+					Step:   uint8(consensus.PreCommit),
+					Round:  0,
+				},
+				GetConsensusNodeState(pocketNode))
+		}
+		if height == 4 {
+			break
+		}
+
+		votes, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Vote, numValidators, 250, true)
+		require.NoError(t, err)
+		nextLeader := pocketNodes[(leaderId%numValidators)+1]
+		for _, vote := range votes {
+			P2PSend(t, nextLeader, vote)
+		}
+		advanceTime(t, clockMock, 10*time.Millisecond)
+	}
+
+	// The QC on block 3, carried by the proposal of block 4, commits block 1 and locks the nodes on the QC of block 2
+	for _, pocketNode := range pocketNodes {
+		nodeState := GetConsensusNodeState(pocketNode)
+		require.Equal(t, uint64(2), nodeState.LockedQC.GetHeight())
+		require.Equal(t, uint64(3), nodeState.PrepareQC.GetHeight())
+	}
+}
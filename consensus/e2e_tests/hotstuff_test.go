@@ -10,6 +10,7 @@ import (
 	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 func TestHotstuff4Nodes1BlockHappyPath(t *testing.T) {
@@ -190,6 +191,109 @@ func TestHotstuff4Nodes1BlockHappyPath(t *testing.T) {
 	}
 }
 
+func TestHotstuff4Nodes1BlockStakeWeightedQuorum(t *testing.T) {
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+
+	// The three other validators outnumber the whale but do not hold more than 2/3 of the stake, which the whale only
+	// reaches with the leader
+	leaderId, whaleId := typesCons.NodeId(2), typesCons.NodeId(3)
+	leader := pocketNodes[leaderId]
+	stakes := make(map[string]string, numValidators)
+	for nodeId, pocketNode := range pocketNodes {
+		stakes[getNodeAddress(t, pocketNode)] = "100"
+		if nodeId == whaleId {
+			stakes[getNodeAddress(t, pocketNode)] = "600"
+		}
+	}
+	for _, pocketNode := range pocketNodes {
+		for _, validator := range pocketNode.GetBus().GetRuntimeMgr().GetGenesis().GetValidators() {
+			validator.StakedAmount = stakes[validator.GetAddress()]
+		}
+	}
+
+	StartAllTestPocketNodes(t, pocketNodes)
+	for _, pocketNode := range pocketNodes {
+		TriggerNextView(t, pocketNode)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	// 1. NewRound
+	newRoundMessages, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, 250, true)
+	require.NoError(t, err)
+
+	for _, message := range filterMessagesBySigners(t, pocketNodes, newRoundMessages, 1, leaderId, 4) {
+		P2PBroadcast(t, pocketNodes, message)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+	_, err = WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Propose, 0, 100, true)
+	require.NoError(t, err, "the leader must not propose without the NEWROUND messages of more than 2/3 of the stake")
+
+	for _, message := range filterMessagesBySigners(t, pocketNodes, newRoundMessages, whaleId) {
+		P2PBroadcast(t, pocketNodes, message)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	// 2. Prepare, PreCommit, Commit and Decide, with the votes of the leader and the whale only
+	proposal, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Propose, numValidators, 250, true)
+	require.NoError(t, err)
+	for _, steps := range []struct{ vote, nextProposal typesCons.HotstuffStep }{
+		{consensus.Prepare, consensus.PreCommit},
+		{consensus.PreCommit, consensus.Commit},
+		{consensus.Commit, consensus.Decide},
+	} {
+		for _, message := range proposal {
+			P2PBroadcast(t, pocketNodes, message)
+		}
+		advanceTime(t, clockMock, 10*time.Millisecond)
+
+		votes, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, steps.vote, consensus.Vote, numValidators, 250, true)
+		require.NoError(t, err)
+		for _, vote := range filterMessagesBySigners(t, pocketNodes, votes, leaderId, whaleId) {
+			P2PSend(t, leader, vote)
+		}
+		advanceTime(t, clockMock, 10*time.Millisecond)
+
+		proposal, err = WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, steps.nextProposal, consensus.Propose, numValidators, 250, true)
+		require.NoError(t, err)
+	}
+
+	// The leader committed the block and moved to the next height
+	assertNodeConsensusView(t, leaderId,
+		typesCons.ConsensusNodeState{
+			Height: 2,
+			Step:   uint8(consensus.NewRound),
+			Round:  0,
+		},
+		GetConsensusNodeState(leader))
+}
+
+// filterMessagesBySigners returns the messages signed by the validators of `nodeIds`
+func filterMessagesBySigners(t *testing.T, pocketNodes IdToNodeMapping, msgs []*anypb.Any, nodeIds ...typesCons.NodeId) []*anypb.Any {
+	signers := make(map[string]struct{}, len(nodeIds))
+	for _, nodeId := range nodeIds {
+		signers[getNodeAddress(t, pocketNodes[nodeId])] = struct{}{}
+	}
+	filteredMsgs := make([]*anypb.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		hotstuffMessage := getHotstuffMessage(t, msg)
+		partialSig := hotstuffMessage.GetPartialSignature()
+		if hotstuffMessage.GetStep() == consensus.NewRound {
+			partialSig = hotstuffMessage.GetNewRoundSignature()
+		}
+		if _, ok := signers[partialSig.GetAddress()]; ok {
+			filteredMsgs = append(filteredMsgs, msg)
+		}
+	}
+	return filteredMsgs
+}
+
 // TODO: Implement these tests and use them as a starting point for new ones. Consider using ChatGPT to help you out :)
 
 func TestHotstuff4Nodes1Byzantine1Block(t *testing.T) {
@@ -13,6 +13,7 @@ import (
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestPacemakerTimeoutIncreasesRound(t *testing.T) {
@@ -59,50 +60,33 @@ func TestPacemakerTimeoutIncreasesRound(t *testing.T) {
 			GetConsensusNodeState(pocketNode))
 	}
 
-	// Force the pacemaker to time out
-	forcePacemakerTimeout(t, clockMock, paceMakerTimeout)
+	// Force the pacemaker to time out in three consecutive rounds; the step timeout doubles with every failed round
+	var newRoundMessages []*anypb.Any
+	for round := uint64(1); round <= 3; round++ {
+		forcePacemakerTimeout(t, clockMock, paceMakerTimeout<<(round-1))
 
-	// Verify that a new round started at the same height
-	_, err = WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, consensusMessageTimeoutMsec, true)
-	require.NoError(t, err)
-	for pocketId, pocketNode := range pocketNodes {
-		assertNodeConsensusView(t, pocketId,
-			typesCons.ConsensusNodeState{
-				Height: 1,
-				Step:   uint8(consensus.NewRound),
-				Round:  1,
-			},
-			GetConsensusNodeState(pocketNode))
-	}
-
-	forcePacemakerTimeout(t, clockMock, paceMakerTimeout)
-
-	// Check that a new round starts at the same height
-	_, err = WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, consensusMessageTimeoutMsec, true)
-	require.NoError(t, err)
-	for pocketId, pocketNode := range pocketNodes {
-		assertNodeConsensusView(t, pocketId,
-			typesCons.ConsensusNodeState{
-				Height: 1,
-				Step:   uint8(consensus.NewRound),
-				Round:  2,
-			},
-			GetConsensusNodeState(pocketNode))
-	}
-
-	forcePacemakerTimeout(t, clockMock, paceMakerTimeout)
+		// Every validator signs a TIMEOUT message for the round that failed
+		timeoutMessages, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, typesCons.HotstuffStep_HOTSTUFF_STEP_UNKNOWN, consensus.Timeout, numValidators*numValidators, consensusMessageTimeoutMsec, true)
+		require.NoError(t, err)
+		for _, message := range timeoutMessages {
+			P2PBroadcast(t, pocketNodes, message)
+		}
 
-	// Check that a new round starts at the same height.
-	newRoundMessages, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, consensusMessageTimeoutMsec, true)
-	require.NoError(t, err)
-	for pocketId, pocketNode := range pocketNodes {
-		assertNodeConsensusView(t, pocketId,
-			typesCons.ConsensusNodeState{
-				Height: 1,
-				Step:   uint8(consensus.NewRound),
-				Round:  3,
-			},
-			GetConsensusNodeState(pocketNode))
+		// Verify that the timeout certificate started a new round at the same height
+		newRoundMessages, err = WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, consensusMessageTimeoutMsec, true)
+		require.NoError(t, err)
+		for pocketId, pocketNode := range pocketNodes {
+			assertNodeConsensusView(t, pocketId,
+				typesCons.ConsensusNodeState{
+					Height: 1,
+					Step:   uint8(consensus.NewRound),
+					Round:  round,
+				},
+				GetConsensusNodeState(pocketNode))
+		}
+		for _, message := range newRoundMessages {
+			require.Equal(t, round-1, getHotstuffMessage(t, message).GetTimeoutCertificate().GetRound())
+		}
 	}
 
 	// Continue to the next step at the current round
@@ -160,7 +144,12 @@ func TestPacemakerCatchupSameStepDifferentRounds(t *testing.T) {
 	consensusPK, err := leader.GetBus().GetConsensusModule().GetPrivateKey()
 	require.NoError(t, err)
 
-	// Placeholder block
+	// Placeholder block, which starts a new epoch since every block is an epoch in the test configs
+	validatorSet := &coreTypes.ValidatorSet{
+		Epoch:       coreTypes.GetEpoch(testHeight, 0),
+		StartHeight: testHeight,
+		Validators:  leader.GetBus().GetRuntimeMgr().GetGenesis().GetValidators(),
+	}
 	blockHeader := &coreTypes.BlockHeader{
 		Height:            testHeight,
 		StateHash:         stateHash,
@@ -168,10 +157,13 @@ func TestPacemakerCatchupSameStepDifferentRounds(t *testing.T) {
 		NumTxs:            0,
 		ProposerAddress:   consensusPK.Address(),
 		QuorumCertificate: nil,
+		Time:              timestamppb.New(clockMock.Now()),
+		ValidatorSetHash:  validatorSet.Hash(),
 	}
 	block := &coreTypes.Block{
 		BlockHeader:  blockHeader,
 		Transactions: make([][]byte, 0),
+		ValidatorSet: validatorSet,
 	}
 
 	leaderConsensusModImpl := GetConsensusModImpl(leader)
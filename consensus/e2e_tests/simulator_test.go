@@ -0,0 +1,111 @@
+package e2e_tests
+
+import (
+	"testing"
+	"time"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	simulatorPacemakerTimeout = time.Second
+	simulatorMaxDuration      = 5 * time.Minute
+)
+
+func TestSimulator_NoFaults(t *testing.T) {
+	sim := NewSimulator(t, SimulatorConfig{
+		Seed:             1,
+		NumValidators:    numValidators,
+		PacemakerTimeout: simulatorPacemakerTimeout,
+		Latency:          UniformLatency(10*time.Millisecond, 50*time.Millisecond),
+	})
+	require.NoError(t, sim.RunUntilHeight(5, simulatorMaxDuration))
+}
+
+func TestSimulator_DropsAndReorderings(t *testing.T) {
+	sim := NewSimulator(t, SimulatorConfig{
+		Seed:             2,
+		NumValidators:    numValidators,
+		PacemakerTimeout: simulatorPacemakerTimeout,
+		DropRate:         0.05,
+		Latency:          UniformLatency(time.Millisecond, 300*time.Millisecond),
+	})
+	require.NoError(t, sim.RunUntilHeight(3, simulatorMaxDuration))
+}
+
+func TestSimulator_PartitionHeals(t *testing.T) {
+	partitionEnd := 10 * time.Second
+	sim := NewSimulator(t, SimulatorConfig{
+		Seed:             3,
+		NumValidators:    numValidators,
+		PacemakerTimeout: simulatorPacemakerTimeout,
+		Latency:          UniformLatency(10*time.Millisecond, 50*time.Millisecond),
+		Partitions: []Partition{
+			{Start: 0, End: partitionEnd, Groups: [][]typesCons.NodeId{{1, 2, 3}, {4}}},
+		},
+	})
+
+	// The majority keeps committing blocks while the isolated validator is stuck at the first height
+	sim.Run(partitionEnd)
+	require.NoError(t, sim.CheckSafety())
+	require.Equal(t, uint64(0), sim.CommittedHeight(4))
+	majorityHeight := sim.MaxCommittedHeight()
+	require.Greater(t, majorityHeight, uint64(1))
+
+	// Once the partition heals, the isolated validator catches up and the network keeps going
+	require.NoError(t, sim.RunUntilHeight(majorityHeight+2, simulatorMaxDuration))
+}
+
+func TestSimulator_SilentValidator(t *testing.T) {
+	sim := NewSimulator(t, SimulatorConfig{
+		Seed:             4,
+		NumValidators:    numValidators,
+		PacemakerTimeout: simulatorPacemakerTimeout,
+		Latency:          UniformLatency(10*time.Millisecond, 50*time.Millisecond),
+		Silent:           []typesCons.NodeId{3},
+	})
+
+	// The rounds led by the silent validator time out, and the other validators move on with a timeout certificate
+	require.NoError(t, sim.RunUntilHeight(5, simulatorMaxDuration))
+}
+
+func TestSimulator_EquivocatingLeader(t *testing.T) {
+	sim := NewSimulator(t, SimulatorConfig{
+		Seed:             5,
+		NumValidators:    numValidators,
+		PacemakerTimeout: simulatorPacemakerTimeout,
+		Latency:          UniformLatency(10*time.Millisecond, 50*time.Millisecond),
+		Equivocating:     []typesCons.NodeId{2},
+	})
+
+	// Node 2 leads the first height and sends a conflicting block to node 4, which must not commit it
+	require.NoError(t, sim.RunUntilHeight(5, simulatorMaxDuration))
+}
+
+func TestSimulator_ReproducibleFromSeed(t *testing.T) {
+	newFaultySimulator := func(seed int64) *Simulator {
+		return NewSimulator(t, SimulatorConfig{
+			Seed:             seed,
+			NumValidators:    numValidators,
+			PacemakerTimeout: simulatorPacemakerTimeout,
+			DropRate:         0.1,
+			Latency:          UniformLatency(time.Millisecond, 200*time.Millisecond),
+			Partitions: []Partition{
+				{Start: 2 * time.Second, End: 4 * time.Second, Groups: [][]typesCons.NodeId{{1, 2}, {3, 4}}},
+			},
+		})
+	}
+
+	sim := newFaultySimulator(6)
+	sim.Run(10 * time.Second)
+	require.NoError(t, sim.CheckSafety())
+
+	sameSeedSim := newFaultySimulator(6)
+	sameSeedSim.Run(10 * time.Second)
+	require.Equal(t, sim.Trace(), sameSeedSim.Trace())
+
+	otherSeedSim := newFaultySimulator(7)
+	otherSeedSim.Run(10 * time.Second)
+	require.NotEqual(t, sim.Trace(), otherSeedSim.Trace())
+}
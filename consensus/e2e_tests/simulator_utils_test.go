@@ -0,0 +1,717 @@
+package e2e_tests
+
+/*
+	The consensus simulator runs the real consensus modules of a set of validators over a virtual network with
+	programmable faults, and checks the safety and liveness of the network along the way.
+
+	A run is reproducible from its seed because nothing in it depends on the scheduling of goroutines:
+
+	1. The nodes are not started with their event loop; the simulator hands every message, and every pacemaker
+	   timeout, to the consensus module of its recipient from a single goroutine.
+	2. Time is virtual. The clock of the nodes only moves when the simulator delivers the next event, and the
+	   pacemaker timers are fired by the simulator once the virtual time reaches their deadline.
+	3. Every random decision (drops and latencies) is drawn from a single generator seeded with `Seed`, in the
+	   order the messages are sent, which is itself deterministic.
+
+	The keys of the validators are random, so two runs with the same seed send the same messages between the same
+	NodeIds at the same virtual times, but the signatures and addresses in the messages differ.
+*/
+
+import (
+	"container/heap"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime"
+	"github.com/pokt-network/pocket/runtime/genesis"
+	"github.com/pokt-network/pocket/shared"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"github.com/pokt-network/pocket/shared/modules"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// The transaction appended by equivocating leaders to the block they send to half of the validators
+const equivocationTx = "equivocation"
+
+// LatencyDistribution draws the latency of a message sent over the virtual network
+type LatencyDistribution func(rng *rand.Rand) time.Duration
+
+// UniformLatency draws latencies uniformly in [min, max]
+func UniformLatency(min, max time.Duration) LatencyDistribution {
+	return func(rng *rand.Rand) time.Duration {
+		return min + time.Duration(rng.Int63n(int64(max-min)+1))
+	}
+}
+
+// Partition splits the network into groups that cannot reach each other between `Start` and `End`, relative to
+// the beginning of the simulation. Validators that are not part of any group are isolated.
+type Partition struct {
+	Start  time.Duration
+	End    time.Duration
+	Groups [][]typesCons.NodeId
+}
+
+type SimulatorConfig struct {
+	Seed             int64
+	NumValidators    int
+	PacemakerTimeout time.Duration
+
+	// Network faults; the messages a node sends to itself are never dropped nor delayed
+	DropRate   float64
+	Latency    LatencyDistribution
+	Partitions []Partition
+
+	// Byzantine validators: silent validators never send anything, and equivocating validators send a different
+	// block to half of the validators when they propose. Invariants are only checked on the correct validators.
+	Silent       []typesCons.NodeId
+	Equivocating []typesCons.NodeId
+}
+
+// Simulator runs the real consensus modules of `NumValidators` validators over a seeded virtual network
+type Simulator struct {
+	t   *testing.T
+	cfg SimulatorConfig
+	rng *rand.Rand
+
+	clock *simClock
+	start time.Time
+
+	nodes        map[typesCons.NodeId]*simNode
+	nodeIds      []typesCons.NodeId
+	addrToNodeId map[string]typesCons.NodeId
+	silent       map[typesCons.NodeId]bool
+	equivocating map[typesCons.NodeId]bool
+
+	// The node whose consensus module is being called; its pacemaker timer is the one being set
+	currentNode typesCons.NodeId
+
+	queue     simEventQueue
+	numEvents uint64
+	timers    map[typesCons.NodeId]*simTimer
+
+	// Invariants: the block committed at each height by the first correct validator that committed it
+	committedBlocks  map[uint64]string
+	safetyViolations []error
+
+	trace []string
+}
+
+type simNode struct {
+	id      typesCons.NodeId
+	node    *shared.Node
+	address string
+
+	// The proposal applied by the utility context of the node, committed along with the next commit QC
+	appliedProposer []byte
+	appliedTxs      [][]byte
+	blockStore      map[uint64]*coreTypes.Block
+	commits         []uint64
+}
+
+type simEvent struct {
+	at       time.Time
+	seq      uint64
+	from, to typesCons.NodeId
+	msg      *anypb.Any
+}
+
+type simEventQueue []*simEvent
+
+type simTimer struct {
+	nodeId   typesCons.NodeId
+	deadline time.Time
+}
+
+// NewSimulator creates the validators of the simulated network and starts them at height 1
+func NewSimulator(t *testing.T, cfg SimulatorConfig) *Simulator {
+	if cfg.Latency == nil {
+		cfg.Latency = UniformLatency(0, 0)
+	}
+
+	s := &Simulator{
+		t:               t,
+		cfg:             cfg,
+		rng:             rand.New(rand.NewSource(cfg.Seed)),
+		nodes:           make(map[typesCons.NodeId]*simNode, cfg.NumValidators),
+		addrToNodeId:    make(map[string]typesCons.NodeId, cfg.NumValidators),
+		silent:          make(map[typesCons.NodeId]bool),
+		equivocating:    make(map[typesCons.NodeId]bool),
+		timers:          make(map[typesCons.NodeId]*simTimer),
+		committedBlocks: make(map[uint64]string),
+	}
+	s.clock = &simClock{Mock: clock.NewMock(), sim: s}
+	s.clock.now = s.clock.Mock.Now()
+	s.start = s.clock.now
+	for _, nodeId := range cfg.Silent {
+		s.silent[nodeId] = true
+	}
+	for _, nodeId := range cfg.Equivocating {
+		s.equivocating[nodeId] = true
+	}
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, cfg.NumValidators, s.clock)
+	for _, runtimeMgr := range runtimeMgrs {
+		runtimeMgr.GetConfig().Consensus.PacemakerConfig.TimeoutMsec = uint64(cfg.PacemakerTimeout.Milliseconds())
+		// State sync picks the peers it requests blocks from with the generator of the runtime manager
+		runtime.WithRand(rand.New(rand.NewSource(s.rng.Int63())))(runtimeMgr)
+	}
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Same NodeId assignment as `CreateTestConsensusPocketNodes`
+	sort.Slice(buses, func(i, j int) bool {
+		pk, err := cryptoPocket.NewPrivateKey(buses[i].GetRuntimeMgr().GetConfig().PrivateKey)
+		require.NoError(t, err)
+		pk2, err := cryptoPocket.NewPrivateKey(buses[j].GetRuntimeMgr().GetConfig().PrivateKey)
+		require.NoError(t, err)
+		return pk.Address().String() < pk2.Address().String()
+	})
+	for i, bus := range buses {
+		nodeId := typesCons.NodeId(i + 1)
+		s.nodes[nodeId] = s.createNode(nodeId, bus)
+		s.nodeIds = append(s.nodeIds, nodeId)
+	}
+
+	for _, nodeId := range s.nodeIds {
+		s.callNode(nodeId, func(node *shared.Node) error {
+			require.NoError(t, node.GetBus().GetConsensusModule().Start())
+			return nil
+		})
+	}
+	for _, nodeId := range s.nodeIds {
+		s.triggerNextView(nodeId)
+	}
+
+	return s
+}
+
+// Run moves the simulation forward by `duration` of virtual time
+func (s *Simulator) Run(duration time.Duration) {
+	end := s.clock.now.Add(duration)
+	for s.step(end) {
+	}
+}
+
+// RunUntil moves the simulation forward until `done` returns true, and fails if it takes more than `maxDuration`
+// of virtual time or if the safety of the network is violated on the way
+func (s *Simulator) RunUntil(done func() bool, maxDuration time.Duration) error {
+	end := s.clock.now.Add(maxDuration)
+	for !done() {
+		if err := s.CheckSafety(); err != nil {
+			return err
+		}
+		if !s.step(end) {
+			return fmt.Errorf("simulation did not reach its goal after %v (seed %d)", maxDuration, s.cfg.Seed)
+		}
+	}
+	return s.CheckSafety()
+}
+
+// CheckSafety returns the first conflicting commit of the correct validators: two validators committing different
+// blocks at the same height, or a validator not committing the heights in sequence
+func (s *Simulator) CheckSafety() error {
+	if len(s.safetyViolations) > 0 {
+		return s.safetyViolations[0]
+	}
+	return nil
+}
+
+// CheckLiveness returns an error unless every correct validator committed every height up to `height`
+func (s *Simulator) CheckLiveness(height uint64) error {
+	for _, nodeId := range s.correctNodeIds() {
+		if committedHeight := s.CommittedHeight(nodeId); committedHeight < height {
+			return fmt.Errorf("node %d committed %d blocks instead of %d (seed %d)", nodeId, committedHeight, height, s.cfg.Seed)
+		}
+	}
+	return nil
+}
+
+// RunUntilHeight runs the simulation until every correct validator committed `height` blocks
+func (s *Simulator) RunUntilHeight(height uint64, maxDuration time.Duration) error {
+	return s.RunUntil(func() bool { return s.CheckLiveness(height) == nil }, maxDuration)
+}
+
+func (s *Simulator) CommittedHeight(nodeId typesCons.NodeId) uint64 {
+	return uint64(len(s.nodes[nodeId].commits))
+}
+
+// MaxCommittedHeight returns the highest height committed by a correct validator
+func (s *Simulator) MaxCommittedHeight() (maxHeight uint64) {
+	for _, nodeId := range s.correctNodeIds() {
+		if height := s.CommittedHeight(nodeId); height > maxHeight {
+			maxHeight = height
+		}
+	}
+	return
+}
+
+func (s *Simulator) Node(nodeId typesCons.NodeId) *shared.Node {
+	return s.nodes[nodeId].node
+}
+
+// Trace returns the events handled so far, which are identical for two runs with the same seed and config
+func (s *Simulator) Trace() []string {
+	return s.trace
+}
+
+/*** Event Loop ***/
+
+// step handles the next event if it happens before `end`, and otherwise moves the clock to `end`. The messages
+// delivered at a given time are handled before the timers expiring at the same time.
+func (s *Simulator) step(end time.Time) bool {
+	timer := s.nextTimer()
+	if s.queue.Len() > 0 && (timer == nil || !s.queue[0].at.After(timer.deadline)) {
+		if s.queue[0].at.After(end) {
+			s.clock.now = end
+			return false
+		}
+		event := heap.Pop(&s.queue).(*simEvent)
+		s.clock.now = event.at
+		s.deliver(event)
+		return true
+	}
+
+	if timer == nil || timer.deadline.After(end) {
+		s.clock.now = end
+		return false
+	}
+	delete(s.timers, timer.nodeId)
+	s.clock.now = timer.deadline
+	s.record("%d timeout", timer.nodeId)
+	s.triggerNextView(timer.nodeId)
+	return true
+}
+
+// nextTimer returns the timer expiring first, using the NodeIds to break ties
+func (s *Simulator) nextTimer() (next *simTimer) {
+	for _, nodeId := range s.nodeIds {
+		timer, ok := s.timers[nodeId]
+		if ok && (next == nil || timer.deadline.Before(next.deadline)) {
+			next = timer
+		}
+	}
+	return
+}
+
+func (s *Simulator) deliver(event *simEvent) {
+	s.record("%d -> %d %s", event.from, event.to, describeSimMessage(s.t, event.msg))
+	s.callNode(event.to, func(node *shared.Node) error {
+		return node.GetBus().GetConsensusModule().HandleMessage(event.msg)
+	})
+}
+
+// triggerNextView fires the pacemaker timer of the node through the debug message used to start consensus, which
+// interrupts the current round like a timeout
+func (s *Simulator) triggerNextView(nodeId typesCons.NodeId) {
+	s.callNode(nodeId, func(node *shared.Node) error {
+		return node.GetBus().GetConsensusModule().HandleDebugMessage(&messaging.DebugMessage{
+			Action:  messaging.DebugMessageAction_DEBUG_CONSENSUS_TRIGGER_NEXT_VIEW,
+			Message: nil,
+		})
+	})
+}
+
+func (s *Simulator) callNode(nodeId typesCons.NodeId, call func(node *shared.Node) error) {
+	s.currentNode = nodeId
+	if err := call(s.nodes[nodeId].node); err != nil {
+		s.t.Logf("[SIMULATOR] node %d failed to handle event: %v", nodeId, err)
+	}
+	s.currentNode = 0
+}
+
+func (s *Simulator) record(format string, args ...any) {
+	s.trace = append(s.trace, fmt.Sprintf("%v ", s.clock.now.Sub(s.start))+fmt.Sprintf(format, args...))
+}
+
+/*** Virtual Network ***/
+
+// send applies the faults of the network to a message and schedules its delivery
+func (s *Simulator) send(from, to typesCons.NodeId, msg *anypb.Any) {
+	if from == to {
+		s.schedule(s.clock.now, from, to, msg)
+		return
+	}
+
+	dropped := s.rng.Float64() < s.cfg.DropRate
+	latency := s.cfg.Latency(s.rng)
+	if s.silent[from] || s.isPartitioned(from, to) || dropped {
+		s.record("%d -> %d dropped %s", from, to, describeSimMessage(s.t, msg))
+		return
+	}
+
+	if s.equivocating[from] && to%2 == 0 {
+		msg = equivocate(s.t, msg)
+	}
+	s.schedule(s.clock.now.Add(latency), from, to, msg)
+}
+
+func (s *Simulator) broadcast(from typesCons.NodeId, msg *anypb.Any) {
+	for _, nodeId := range s.nodeIds {
+		if nodeId != from {
+			s.send(from, nodeId, msg)
+		}
+	}
+}
+
+func (s *Simulator) schedule(at time.Time, from, to typesCons.NodeId, msg *anypb.Any) {
+	s.numEvents++
+	heap.Push(&s.queue, &simEvent{at: at, seq: s.numEvents, from: from, to: to, msg: msg})
+}
+
+func (s *Simulator) isPartitioned(from, to typesCons.NodeId) bool {
+	elapsed := s.clock.now.Sub(s.start)
+	for _, partition := range s.cfg.Partitions {
+		if elapsed < partition.Start || elapsed >= partition.End {
+			continue
+		}
+		if partitionGroup(partition, from) != partitionGroup(partition, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionGroup returns the index of the group of the node, or -NodeId for the nodes isolated by the partition
+func partitionGroup(partition Partition, nodeId typesCons.NodeId) int {
+	for i, group := range partition.Groups {
+		for _, member := range group {
+			if member == nodeId {
+				return i
+			}
+		}
+	}
+	return -int(nodeId)
+}
+
+// equivocate replaces the block of a proposal with a conflicting block that is valid on its own
+func equivocate(t *testing.T, anyMsg *anypb.Any) *anypb.Any {
+	msg, err := codec.GetCodec().FromAny(anyMsg)
+	require.NoError(t, err)
+	hotstuffMessage, ok := msg.(*typesCons.HotstuffMessage)
+	if !ok || hotstuffMessage.GetType() != consensus.Propose || hotstuffMessage.GetStep() != consensus.Prepare || hotstuffMessage.GetBlock() == nil {
+		return anyMsg
+	}
+
+	conflictingMessage := proto.Clone(hotstuffMessage).(*typesCons.HotstuffMessage)
+	block := conflictingMessage.GetBlock()
+	block.Transactions = append(block.Transactions, []byte(equivocationTx))
+	block.BlockHeader.NumTxs = uint32(len(block.Transactions))
+	block.BlockHeader.StateHash = simStateHash(block.Transactions)
+
+	conflictingAny, err := codec.GetCodec().ToAny(conflictingMessage)
+	require.NoError(t, err)
+	return conflictingAny
+}
+
+func describeSimMessage(t *testing.T, anyMsg *anypb.Any) string {
+	msg, err := codec.GetCodec().FromAny(anyMsg)
+	require.NoError(t, err)
+	switch msg := msg.(type) {
+	case *typesCons.HotstuffMessage:
+		return fmt.Sprintf("%s %s (height %d, round %d)", msg.GetType(), msg.GetStep(), msg.GetHeight(), msg.GetRound())
+	case *typesCons.StateSyncMessage:
+		return fmt.Sprintf("%T", msg.GetMessage())
+	default:
+		return string(anyMsg.MessageName().Name())
+	}
+}
+
+func (q simEventQueue) Len() int { return len(q) }
+
+func (q simEventQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].at.Before(q[j].at)
+}
+
+func (q simEventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *simEventQueue) Push(x any) { *q = append(*q, x.(*simEvent)) }
+
+func (q *simEventQueue) Pop() any {
+	old := *q
+	event := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return event
+}
+
+/*** Virtual Clock ***/
+
+// simClock is the clock of every node in the simulation. The pacemaker timer of a node is registered with the
+// simulator, which fires it once the virtual time reaches its deadline; the consensus module does not use the other
+// timers of the clock.
+type simClock struct {
+	*clock.Mock
+	sim *Simulator
+	now time.Time
+}
+
+func (c *simClock) Now() time.Time { return c.now }
+
+func (c *simClock) Since(t time.Time) time.Duration { return c.now.Sub(t) }
+
+func (c *simClock) Until(t time.Time) time.Duration { return t.Sub(c.now) }
+
+func (c *simClock) WithTimeout(parent context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	return c.WithDeadline(parent, c.now.Add(d))
+}
+
+// WithDeadline returns a context that is never done on its own: the simulator interrupts the round of the node
+// itself when the deadline is reached
+func (c *simClock) WithDeadline(parent context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	timer := &simTimer{nodeId: c.sim.currentNode, deadline: deadline}
+	c.sim.timers[timer.nodeId] = timer
+	return ctx, func() {
+		if c.sim.timers[timer.nodeId] == timer {
+			delete(c.sim.timers, timer.nodeId)
+		}
+		cancel()
+	}
+}
+
+// After fires immediately since the pacemaker only waits on it to release the goroutine of its timer
+func (c *simClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.now.Add(d)
+	return ch
+}
+
+/*** Invariants ***/
+
+func (s *Simulator) correctNodeIds() (nodeIds []typesCons.NodeId) {
+	for _, nodeId := range s.nodeIds {
+		if !s.silent[nodeId] && !s.equivocating[nodeId] {
+			nodeIds = append(nodeIds, nodeId)
+		}
+	}
+	return
+}
+
+// commit records the block committed by the node and the violations of safety it causes
+func (s *Simulator) commit(nodeId typesCons.NodeId, qcBytes []byte) {
+	node := s.nodes[nodeId]
+	qc := new(typesCons.QuorumCertificate)
+	require.NoError(s.t, codec.GetCodec().Unmarshal(qcBytes, qc))
+
+	height := qc.GetHeight()
+	block := proto.Clone(qc.GetBlock()).(*coreTypes.Block)
+	block.BlockHeader.QuorumCertificate = qcBytes
+	node.blockStore[height] = block
+	s.record("%d committed height %d", nodeId, height)
+
+	if s.silent[nodeId] || s.equivocating[nodeId] {
+		node.commits = append(node.commits, height)
+		return
+	}
+	if expectedHeight := uint64(len(node.commits)) + 1; height != expectedHeight {
+		s.safetyViolations = append(s.safetyViolations,
+			fmt.Errorf("node %d committed height %d instead of height %d (seed %d)", nodeId, height, expectedHeight, s.cfg.Seed))
+	}
+	node.commits = append(node.commits, height)
+
+	appliedProposal := hex.EncodeToString(node.appliedProposer) + "/" + simStateHash(node.appliedTxs)
+	committedBlock, ok := s.committedBlocks[height]
+	if !ok {
+		s.committedBlocks[height] = appliedProposal
+		return
+	}
+	if committedBlock != appliedProposal {
+		s.safetyViolations = append(s.safetyViolations,
+			fmt.Errorf("node %d committed a conflicting block at height %d (seed %d)", nodeId, height, s.cfg.Seed))
+	}
+}
+
+// The state hash of a block only depends on its transactions in the simulation
+func simStateHash(txs [][]byte) string {
+	hasher := cryptoPocket.SHA3Hash
+	var bz []byte
+	for _, tx := range txs {
+		bz = append(bz, hasher(tx)...)
+	}
+	return hex.EncodeToString(hasher(bz))
+}
+
+/*** Nodes ***/
+
+func (s *Simulator) createNode(nodeId typesCons.NodeId, bus modules.Bus) *simNode {
+	t := s.t
+	runtimeMgr := bus.GetRuntimeMgr()
+	pk, err := cryptoPocket.NewPrivateKey(runtimeMgr.GetConfig().PrivateKey)
+	require.NoError(t, err)
+
+	node := &simNode{
+		id:         nodeId,
+		address:    pk.Address().String(),
+		blockStore: make(map[uint64]*coreTypes.Block),
+	}
+	s.addrToNodeId[node.address] = nodeId
+
+	// persistence is a dependency of consensus, so it needs to be registered first
+	bus.RegisterModule(s.persistenceMock(node, bus))
+
+	_, err = consensus.Create(bus)
+	require.NoError(t, err)
+
+	for _, module := range []modules.Module{
+		s.p2pMock(node),
+		s.utilityMock(node, runtimeMgr.GetGenesis()),
+		baseTelemetryMock(t, nil),
+		baseLoggerMock(t, nil),
+		baseRpcMock(t, nil),
+	} {
+		bus.RegisterModule(module)
+	}
+
+	node.node = shared.NewNodeWithP2PAddress(pk.Address())
+	node.node.SetBus(bus)
+	return node
+}
+
+// persistenceMock serves the validators of the genesis and the blocks committed by the node
+func (s *Simulator) persistenceMock(node *simNode, bus modules.Bus) *mockModules.MockPersistenceModule {
+	ctrl := gomock.NewController(s.t)
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceReadContextMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	genesisState := bus.GetRuntimeMgr().GetGenesis()
+
+	persistenceMock.EXPECT().GetModuleName().Return(modules.PersistenceModuleName).AnyTimes()
+	persistenceMock.EXPECT().Start().Return(nil).AnyTimes()
+	persistenceMock.EXPECT().SetBus(gomock.Any()).Return().AnyTimes()
+	persistenceMock.EXPECT().NewReadContext(gomock.Any()).Return(persistenceReadContextMock, nil).AnyTimes()
+	persistenceMock.EXPECT().ReleaseWriteContext().Return(nil).AnyTimes()
+	persistenceMock.EXPECT().GetBlock(gomock.Any()).DoAndReturn(
+		func(height int64) (*coreTypes.Block, error) {
+			block, ok := node.blockStore[uint64(height)]
+			if !ok {
+				return nil, fmt.Errorf("block %d not found", height)
+			}
+			return block, nil
+		}).AnyTimes()
+
+	persistenceReadContextMock.EXPECT().GetLatestBlockHeight().DoAndReturn(
+		func() (uint64, error) {
+			return uint64(len(node.commits)), nil
+		}).AnyTimes()
+	persistenceReadContextMock.EXPECT().GetAllValidators(gomock.Any()).Return(genesisState.GetValidators(), nil).AnyTimes()
+	persistenceReadContextMock.EXPECT().GetBlockHash(gomock.Any()).Return("", nil).AnyTimes()
+	persistenceReadContextMock.EXPECT().GetValidatorBLSKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(address []byte, _ int64) (*coreTypes.BLSKey, error) {
+			for _, blsKey := range genesisState.ValidatorBlsKeys {
+				if blsKey.GetAddress() == hex.EncodeToString(address) {
+					return blsKey, nil
+				}
+			}
+			return nil, nil
+		}).AnyTimes()
+	persistenceReadContextMock.EXPECT().Close().Return(nil).AnyTimes()
+
+	return persistenceMock
+}
+
+// p2pMock sends the messages of the node over the virtual network
+func (s *Simulator) p2pMock(node *simNode) *mockModules.MockP2PModule {
+	ctrl := gomock.NewController(s.t)
+	p2pMock := mockModules.NewMockP2PModule(ctrl)
+
+	p2pMock.EXPECT().Start().Return(nil).AnyTimes()
+	p2pMock.EXPECT().SetBus(gomock.Any()).Return().AnyTimes()
+	p2pMock.EXPECT().
+		Broadcast(gomock.Any()).
+		DoAndReturn(func(msg *anypb.Any) error {
+			s.broadcast(node.id, msg)
+			return nil
+		}).
+		AnyTimes()
+	p2pMock.EXPECT().
+		Send(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(addr cryptoPocket.Address, msg *anypb.Any) error {
+			to, ok := s.addrToNodeId[addr.String()]
+			if !ok {
+				return fmt.Errorf("unknown address %s", addr)
+			}
+			s.send(node.id, to, msg)
+			return nil
+		}).
+		AnyTimes()
+	p2pMock.EXPECT().ReportInvalidMessage(gomock.Any()).AnyTimes()
+	p2pMock.EXPECT().GetModuleName().Return(modules.P2PModuleName).AnyTimes()
+
+	return p2pMock
+}
+
+// utilityMock applies empty blocks, and any block proposed by another node, with a state hash derived from the
+// transactions of the block
+func (s *Simulator) utilityMock(node *simNode, genesisState *genesis.GenesisState) *mockModules.MockUtilityModule {
+	ctrl := gomock.NewController(s.t)
+	utilityMock := mockModules.NewMockUtilityModule(ctrl)
+	utilityContextMock := mockModules.NewMockUtilityContext(ctrl)
+	persistenceContextMock := mockModules.NewMockPersistenceRWContext(ctrl)
+	utilityMock.EXPECT().Start().Return(nil).AnyTimes()
+	utilityMock.EXPECT().SetBus(gomock.Any()).Return().AnyTimes()
+	utilityMock.EXPECT().NewContext(gomock.Any()).Return(utilityContextMock, nil).AnyTimes()
+	utilityMock.EXPECT().ReapMempool(gomock.Any(), gomock.Any()).Return(make([][]byte, 0), nil).AnyTimes()
+	utilityMock.EXPECT().ValidateProposalTransactions(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	utilityMock.EXPECT().IsMempoolEmpty().Return(true).AnyTimes()
+	utilityMock.EXPECT().GetModuleName().Return(modules.UtilityModuleName).AnyTimes()
+
+	utilityContextMock.EXPECT().
+		CreateAndApplyProposalBlock(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(proposer []byte, _ int) (string, [][]byte, error) {
+			node.appliedProposer, node.appliedTxs = proposer, make([][]byte, 0)
+			return simStateHash(node.appliedTxs), node.appliedTxs, nil
+		}).
+		AnyTimes()
+	utilityContextMock.EXPECT().
+		SetProposalBlock(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ string, proposer []byte, txs [][]byte) error {
+			node.appliedProposer, node.appliedTxs = proposer, txs
+			return nil
+		}).
+		AnyTimes()
+	utilityContextMock.EXPECT().
+		ApplyBlock().
+		DoAndReturn(func() (string, error) {
+			return simStateHash(node.appliedTxs), nil
+		}).
+		AnyTimes()
+	utilityContextMock.EXPECT().
+		Commit(gomock.Any()).
+		DoAndReturn(func(qcBytes []byte) error {
+			s.commit(node.id, qcBytes)
+			return nil
+		}).
+		AnyTimes()
+	utilityContextMock.EXPECT().SetProposalBlockTime(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalValidatorSet(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalEvidence(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalMissingSigners(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalRecordFailures(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().GetProposalEvidence().Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().Release().Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().GetPersistenceContext().Return(persistenceContextMock).AnyTimes()
+	utilityContextMock.EXPECT().CheckScheduledUpgrades().Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().IsFeatureActive(gomock.Any()).Return(false, nil).AnyTimes()
+	utilityContextMock.EXPECT().IsFeatureActive(gomock.Any()).Return(false, nil).AnyTimes()
+
+	persistenceContextMock.EXPECT().GetAllValidators(gomock.Any()).Return(genesisState.GetValidators(), nil).AnyTimes()
+	persistenceContextMock.EXPECT().GetBlockHash(gomock.Any()).Return("", nil).AnyTimes()
+	persistenceContextMock.EXPECT().Release().Return(nil).AnyTimes()
+
+	return utilityMock
+}
@@ -0,0 +1,391 @@
+package e2e_tests
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestStateSync_CatchUpFromPeers(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	syncingNode := pocketNodes[1]
+	peerAddr := getNodeAddress(t, pocketNodes[2])
+	validatorKeys := getValidatorKeys(t, pocketNodes)
+
+	// A message from a height ahead of the node triggers state sync
+	triggerStateSync(t, clockMock, eventsChannel, syncingNode)
+
+	// The peer advertises the blocks committed by the network while the node was behind
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, peerAddr, 0, 1))
+	blockRequests, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 2, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+	requestedHeights := make([]uint64, 0, len(blockRequests))
+	for _, anyMsg := range blockRequests {
+		requestedHeights = append(requestedHeights, getStateSyncMessage(t, anyMsg).GetGetBlockReq().GetHeight())
+	}
+	require.ElementsMatch(t, []uint64{0, 1}, requestedHeights)
+
+	// The blocks are applied in order even if they are received out of order
+	P2PSend(t, syncingNode, newTestingBlockResponse(t, peerAddr, newTestingSyncedBlock(t, 1, validatorKeys)))
+	P2PSend(t, syncingNode, newTestingBlockResponse(t, peerAddr, newTestingSyncedBlock(t, 0, validatorKeys)))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return GetConsensusNodeState(syncingNode).Height == 2
+	}, time.Second, 10*time.Millisecond)
+	assertNodeConsensusView(t, 1,
+		typesCons.ConsensusNodeState{
+			Height: 2,
+			Step:   uint8(consensus.NewRound),
+			Round:  0,
+		},
+		GetConsensusNodeState(syncingNode))
+}
+
+func TestStateSync_RejectBlockWithoutQuorum(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	syncingNode := pocketNodes[1]
+	byzantinePeerAddr := getNodeAddress(t, pocketNodes[2])
+	honestPeerAddr := getNodeAddress(t, pocketNodes[3])
+	validatorKeys := getValidatorKeys(t, pocketNodes)
+
+	triggerStateSync(t, clockMock, eventsChannel, syncingNode)
+
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, byzantinePeerAddr, 0, 0))
+	_, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+
+	// Half of the validators is not enough to certify a block
+	P2PSend(t, syncingNode, newTestingBlockResponse(t, byzantinePeerAddr, newTestingSyncedBlock(t, 0, validatorKeys[:numValidators/2])))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	// The block is requested again from another peer
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, honestPeerAddr, 0, 0))
+	blockRequests, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), getStateSyncMessage(t, blockRequests[0]).GetGetBlockReq().GetHeight())
+
+	assertHeight(t, 1, 0, GetConsensusNodeState(syncingNode).Height)
+}
+
+func TestStateSync_BLSAggregateSignatures(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	for _, runtimeMgr := range runtimeMgrs {
+		runtimeMgr.GetConfig().Consensus.ThresholdSignatureScheme = configs.ThresholdSignatureScheme_BLSAggregateSignature
+	}
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	syncingNode := pocketNodes[1]
+	byzantinePeerAddr := getNodeAddress(t, pocketNodes[2])
+	honestPeerAddr := getNodeAddress(t, pocketNodes[3])
+	validatorKeys := getValidatorKeys(t, pocketNodes)
+
+	triggerStateSync(t, clockMock, eventsChannel, syncingNode)
+
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, byzantinePeerAddr, 0, 0))
+	_, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+
+	// An aggregate signature that does not match the signers in the bitmap is rejected
+	forgedBlock := newTestingSyncedBlockWithThresholdSignature(t, 0, func(proposal *coreTypes.Block) *typesCons.ThresholdSignature {
+		thresholdSig := newTestingAggregateThresholdSignature(t, proposal, validatorKeys, validatorKeys[:numValidators-1])
+		thresholdSig.SignerBitmap = newTestingAggregateThresholdSignature(t, proposal, validatorKeys, validatorKeys).GetSignerBitmap()
+		return thresholdSig
+	})
+	P2PSend(t, syncingNode, newTestingBlockResponse(t, byzantinePeerAddr, forgedBlock))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, honestPeerAddr, 0, 0))
+	_, err = WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+	assertHeight(t, 1, 0, GetConsensusNodeState(syncingNode).Height)
+
+	// A constant size aggregate signature of 2/3+ of the validators certifies the block
+	block := newTestingSyncedBlockWithThresholdSignature(t, 0, func(proposal *coreTypes.Block) *typesCons.ThresholdSignature {
+		return newTestingAggregateThresholdSignature(t, proposal, validatorKeys, validatorKeys[:numValidators-1])
+	})
+	P2PSend(t, syncingNode, newTestingBlockResponse(t, honestPeerAddr, block))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return GetConsensusNodeState(syncingNode).Height == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStateSync_RetryTimedOutBlockRequest(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	syncingNode := pocketNodes[1]
+	validatorKeys := getValidatorKeys(t, pocketNodes)
+
+	triggerStateSync(t, clockMock, eventsChannel, syncingNode)
+
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, getNodeAddress(t, pocketNodes[2]), 0, 0))
+	P2PSend(t, syncingNode, newTestingMetadataResponse(t, getNodeAddress(t, pocketNodes[3]), 0, 0))
+	_, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+
+	// The unanswered request is sent again once it times out, without any other message being received
+	advanceTime(t, clockMock, 5*time.Second)
+	blockRequests, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "retried get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), getStateSyncMessage(t, blockRequests[0]).GetGetBlockReq().GetHeight())
+
+	// The peer the block was requested again from serves it
+	for _, nodeId := range []typesCons.NodeId{2, 3} {
+		P2PSendFrom(t, syncingNode, getNodeAddress(t, pocketNodes[nodeId]),
+			newTestingBlockResponse(t, getNodeAddress(t, pocketNodes[nodeId]), newTestingSyncedBlock(t, 0, validatorKeys)))
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return GetConsensusNodeState(syncingNode).Height == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStateSync_ResponsesBoundToAuthenticatedSender(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	syncingNode := pocketNodes[1]
+	senderAddr := getNodeAddress(t, pocketNodes[2])
+	impersonatedAddr := getNodeAddress(t, pocketNodes[3])
+	validatorKeys := getValidatorKeys(t, pocketNodes)
+
+	triggerStateSync(t, clockMock, eventsChannel, syncingNode)
+
+	// The metadata is attributed to the peer that sent it, whatever peer id it claims
+	P2PSendFrom(t, syncingNode, senderAddr, newTestingMetadataResponse(t, impersonatedAddr, 0, 0))
+	_, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "get block requests", 1, 250, true, isGetBlockRequest)
+	require.NoError(t, err)
+
+	// Another peer cannot answer the request by claiming the peer id of the peer the block was requested from
+	P2PSendFrom(t, syncingNode, impersonatedAddr, newTestingBlockResponse(t, senderAddr, newTestingSyncedBlock(t, 0, validatorKeys)))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+	require.Never(t, func() bool {
+		return GetConsensusNodeState(syncingNode).Height != 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	P2PSendFrom(t, syncingNode, senderAddr, newTestingBlockResponse(t, impersonatedAddr, newTestingSyncedBlock(t, 0, validatorKeys)))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return GetConsensusNodeState(syncingNode).Height == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+// P2PSendFrom delivers the message to the node as if it was received from the peer that authenticated with the
+// address `sender`
+func P2PSendFrom(_ *testing.T, node *shared.Node, sender string, any *anypb.Any) {
+	e := &messaging.PocketEnvelope{Content: any, Sender: sender}
+	node.GetBus().PublishEventToBus(e)
+}
+
+// triggerStateSync sends a message from a future height to the node and waits for its metadata request
+func triggerStateSync(t *testing.T, clockMock *clock.Mock, eventsChannel modules.EventsChannel, node *shared.Node) {
+	futureMsg, err := codec.GetCodec().ToAny(&typesCons.HotstuffMessage{
+		Type:   consensus.Propose,
+		Height: 2,
+		Step:   consensus.NewRound,
+		Round:  0,
+	})
+	require.NoError(t, err)
+	P2PSend(t, node, futureMsg)
+
+	metadataRequests, err := WaitForNetworkStateSyncEvents(t, clockMock, eventsChannel, "metadata requests", 1, 250, true, func(msg *typesCons.StateSyncMessage) bool {
+		return msg.GetMetadataReq() != nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, getNodeAddress(t, node), getStateSyncMessage(t, metadataRequests[0]).GetMetadataReq().GetPeerId())
+}
+
+func isGetBlockRequest(msg *typesCons.StateSyncMessage) bool {
+	return msg.GetGetBlockReq() != nil
+}
+
+func getStateSyncMessage(t *testing.T, anyMsg *anypb.Any) *typesCons.StateSyncMessage {
+	msg, err := codec.GetCodec().FromAny(anyMsg)
+	require.NoError(t, err)
+	return msg.(*typesCons.StateSyncMessage)
+}
+
+func getNodeAddress(t *testing.T, node *shared.Node) string {
+	pk, err := cryptoPocket.NewPrivateKey(node.GetBus().GetRuntimeMgr().GetConfig().PrivateKey)
+	require.NoError(t, err)
+	return pk.Address().String()
+}
+
+func getValidatorKeys(t *testing.T, nodes IdToNodeMapping) []cryptoPocket.PrivateKey {
+	keys := make([]cryptoPocket.PrivateKey, 0, len(nodes))
+	for _, node := range nodes {
+		pk, err := cryptoPocket.NewPrivateKey(node.GetBus().GetRuntimeMgr().GetConfig().PrivateKey)
+		require.NoError(t, err)
+		keys = append(keys, pk)
+	}
+	return keys
+}
+
+func newTestingMetadataResponse(t *testing.T, peerId string, minHeight, maxHeight uint64) *anypb.Any {
+	anyMsg, err := codec.GetCodec().ToAny(&typesCons.StateSyncMessage{
+		Message: &typesCons.StateSyncMessage_MetadataRes{
+			MetadataRes: &typesCons.StateSyncMetadataResponse{
+				PeerId:    peerId,
+				MinHeight: minHeight,
+				MaxHeight: maxHeight,
+			},
+		},
+	})
+	require.NoError(t, err)
+	return anyMsg
+}
+
+func newTestingBlockResponse(t *testing.T, peerId string, block *coreTypes.Block) *anypb.Any {
+	anyMsg, err := codec.GetCodec().ToAny(&typesCons.StateSyncMessage{
+		Message: &typesCons.StateSyncMessage_GetBlockRes{
+			GetBlockRes: &typesCons.GetBlockResponse{
+				PeerId: peerId,
+				Block:  block,
+			},
+		},
+	})
+	require.NoError(t, err)
+	return anyMsg
+}
+
+// newTestingSyncedBlock returns a committed block whose commit QC is signed by `signers`
+func newTestingSyncedBlock(t *testing.T, height uint64, signers []cryptoPocket.PrivateKey) *coreTypes.Block {
+	return newTestingSyncedBlockWithThresholdSignature(t, height, func(proposal *coreTypes.Block) *typesCons.ThresholdSignature {
+		partialSigs := make([]*typesCons.PartialSignature, 0, len(signers))
+		for _, signer := range signers {
+			vote, err := consensus.CreateVoteMessage(height, 0, consensus.Commit, proposal, signer)
+			require.NoError(t, err)
+			partialSigs = append(partialSigs, vote.GetPartialSignature())
+		}
+		return &typesCons.ThresholdSignature{Signatures: partialSigs}
+	})
+}
+
+// newTestingSyncedBlockWithThresholdSignature returns a committed block whose commit QC carries the threshold
+// signature returned by `getThresholdSignature` for the proposal
+func newTestingSyncedBlockWithThresholdSignature(t *testing.T, height uint64, getThresholdSignature func(*coreTypes.Block) *typesCons.ThresholdSignature) *coreTypes.Block {
+	proposal := &coreTypes.Block{
+		BlockHeader: &coreTypes.BlockHeader{
+			Height:    height,
+			StateHash: stateHash,
+		},
+		Transactions: make([][]byte, 0),
+	}
+
+	commitQCBytes, err := codec.GetCodec().Marshal(&typesCons.QuorumCertificate{
+		Height:             height,
+		Step:               consensus.Commit,
+		Round:              0,
+		Block:              proposal,
+		ThresholdSignature: getThresholdSignature(proposal),
+	})
+	require.NoError(t, err)
+
+	return &coreTypes.Block{
+		BlockHeader: &coreTypes.BlockHeader{
+			Height:            height,
+			StateHash:         stateHash,
+			QuorumCertificate: commitQCBytes,
+		},
+	}
+}
+
+// newTestingAggregateThresholdSignature aggregates the BLS signatures of the commit votes of `signers` for the
+// proposal, and sets their bits in the signer bitmap of the validator set
+func newTestingAggregateThresholdSignature(t *testing.T, proposal *coreTypes.Block, validators, signers []cryptoPocket.PrivateKey) *typesCons.ThresholdSignature {
+	valAddresses := make([]string, 0, len(validators))
+	for _, validator := range validators {
+		valAddresses = append(valAddresses, validator.Address().String())
+	}
+	sort.Strings(valAddresses)
+
+	signerBitmap := make([]byte, (len(validators)+7)/8)
+	blsSignatures := make([][]byte, 0, len(signers))
+	for _, signer := range signers {
+		vote, err := consensus.CreateVoteMessage(proposal.GetBlockHeader().GetHeight(), 0, consensus.Commit, proposal, signer)
+		require.NoError(t, err)
+		blsKey, err := cryptoPocket.NewBLSPrivateKeyFromPrivateKey(signer)
+		require.NoError(t, err)
+		require.NoError(t, consensus.SignVoteBLS(vote, blsKey))
+		blsSignatures = append(blsSignatures, vote.GetPartialSignature().GetBlsSignature())
+
+		index := sort.SearchStrings(valAddresses, signer.Address().String())
+		signerBitmap[index/8] |= 1 << (index % 8)
+	}
+
+	aggregateSignature, err := cryptoPocket.AggregateBLSSignatures(blsSignatures)
+	require.NoError(t, err)
+	require.Len(t, aggregateSignature, cryptoPocket.BLSSignatureLen)
+
+	return &typesCons.ThresholdSignature{
+		AggregateSignature: aggregateSignature,
+		SignerBitmap:       signerBitmap,
+	}
+}
@@ -2,8 +2,10 @@ package e2e_tests
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
@@ -19,6 +21,7 @@ import (
 	"github.com/pokt-network/pocket/runtime/test_artifacts"
 	"github.com/pokt-network/pocket/shared"
 	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/messaging"
 	"github.com/pokt-network/pocket/shared/modules"
@@ -44,15 +47,17 @@ type IdToNodeMapping map[typesCons.NodeId]*shared.Node
 
 /*** Node Generation Helpers ***/
 
-func GenerateNodeRuntimeMgrs(_ *testing.T, validatorCount int, clockMgr clock.Clock) []*runtime.Manager {
+func GenerateNodeRuntimeMgrs(t *testing.T, validatorCount int, clockMgr clock.Clock) []*runtime.Manager {
 	runtimeMgrs := make([]*runtime.Manager, validatorCount)
 	var validatorKeys []string
 	genesisState, validatorKeys := test_artifacts.NewGenesisState(validatorCount, 1, 1, 1)
 	cfgs := test_artifacts.NewDefaultConfigs(validatorKeys)
+	walDir := t.TempDir()
 	for i, config := range cfgs {
 		config.Consensus = &configs.ConsensusConfig{
 			PrivateKey:      config.PrivateKey,
 			MaxMempoolBytes: 500000000,
+			WalPath:         filepath.Join(walDir, fmt.Sprintf("node%d", i), "wal"),
 			PacemakerConfig: &configs.PacemakerConfig{
 				TimeoutMsec:               5000,
 				Manual:                    false,
@@ -132,6 +137,21 @@ func CreateTestConsensusPocketNode(
 	return pocketNode
 }
 
+// RestartTestConsensusPocketNode simulates a crash of the consensus module of the node by replacing it with a new
+// consensus module created from the same config, which recovers its state from the persistence module and the WAL
+func RestartTestConsensusPocketNode(t *testing.T, node *shared.Node) {
+	bus := node.GetBus()
+
+	// Stopping the module only releases its timer and WAL file; every WAL entry is already synced to disk
+	require.NoError(t, bus.GetConsensusModule().Stop())
+
+	_, err := consensus.Create(bus)
+	require.NoError(t, err)
+	// The telemetry metrics are registered again when the module starts
+	bus.RegisterModule(baseTelemetryMock(t, nil))
+	require.NoError(t, bus.GetConsensusModule().Start())
+}
+
 func GenerateBuses(t *testing.T, runtimeMgrs []*runtime.Manager) (buses []modules.Bus) {
 	buses = make([]modules.Bus, len(runtimeMgrs))
 	for i := range runtimeMgrs {
@@ -232,6 +252,30 @@ func WaitForNetworkConsensusEvents(
 	return waitForEventsInternal(t, clock, eventsChannel, consensus.HotstuffMessageContentType, numExpectedMsgs, millis, includeFilter, errMsg, failOnExtraMessages)
 }
 
+// This is a helper for `waitForEventsInternal` that waits for the state sync messages matching `msgIncludeFilter`
+func WaitForNetworkStateSyncEvents(
+	t *testing.T,
+	clock *clock.Mock,
+	eventsChannel modules.EventsChannel,
+	errMsg string,
+	numExpectedMsgs int,
+	millis time.Duration,
+	failOnExtraMessages bool,
+	msgIncludeFilter func(m *typesCons.StateSyncMessage) bool,
+) (messages []*anypb.Any, err error) {
+	includeFilter := func(anyMsg *anypb.Any) bool {
+		msg, err := codec.GetCodec().FromAny(anyMsg)
+		require.NoError(t, err)
+
+		stateSyncMessage, ok := msg.(*typesCons.StateSyncMessage)
+		require.True(t, ok)
+
+		return msgIncludeFilter(stateSyncMessage)
+	}
+
+	return waitForEventsInternal(t, clock, eventsChannel, consensus.StateSyncMessageContentType, numExpectedMsgs, millis, includeFilter, errMsg, failOnExtraMessages)
+}
+
 // RESEARCH(#462): Research ways to eliminate time-based non-determinism from the test framework
 // IMPROVE: This function can be extended to testing events outside of just the consensus module.
 func waitForEventsInternal(
@@ -328,6 +372,7 @@ func basePersistenceMock(t *testing.T, _ modules.EventsChannel, bus modules.Bus)
 	persistenceMock.EXPECT().SetBus(gomock.Any()).Return().AnyTimes()
 	persistenceMock.EXPECT().NewReadContext(gomock.Any()).Return(persistenceReadContextMock, nil).AnyTimes()
 	persistenceMock.EXPECT().ReleaseWriteContext().Return(nil).AnyTimes()
+	persistenceMock.EXPECT().GetBlock(gomock.Any()).Return(&coreTypes.Block{BlockHeader: &coreTypes.BlockHeader{}}, nil).AnyTimes()
 
 	// The persistence context should usually be accessed via the utility module within the context
 	// of the consensus module. This one is only used when loading the initial consensus module
@@ -335,6 +380,15 @@ func basePersistenceMock(t *testing.T, _ modules.EventsChannel, bus modules.Bus)
 	persistenceContextMock.EXPECT().Close().Return(nil).AnyTimes()
 	persistenceReadContextMock.EXPECT().GetLatestBlockHeight().Return(uint64(0), nil).AnyTimes()
 	persistenceReadContextMock.EXPECT().GetAllValidators(gomock.Any()).Return(bus.GetRuntimeMgr().GetGenesis().Validators, nil).AnyTimes()
+	persistenceReadContextMock.EXPECT().GetValidatorBLSKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(address []byte, _ int64) (*coreTypes.BLSKey, error) {
+			for _, blsKey := range bus.GetRuntimeMgr().GetGenesis().ValidatorBlsKeys {
+				if blsKey.GetAddress() == hex.EncodeToString(address) {
+					return blsKey, nil
+				}
+			}
+			return nil, nil
+		}).AnyTimes()
 
 	persistenceReadContextMock.EXPECT().Close().Return(nil).AnyTimes()
 
@@ -362,6 +416,7 @@ func baseP2PMock(t *testing.T, eventsChannel modules.EventsChannel) *mockModules
 			eventsChannel <- e
 		}).
 		AnyTimes()
+	p2pMock.EXPECT().ReportInvalidMessage(gomock.Any()).AnyTimes()
 	p2pMock.EXPECT().GetModuleName().Return(modules.P2PModuleName).AnyTimes()
 
 	return p2pMock
@@ -379,6 +434,12 @@ func baseUtilityMock(t *testing.T, _ modules.EventsChannel, genesisState *genesi
 		NewContext(gomock.Any()).
 		Return(utilityContextMock, nil).
 		MaxTimes(4)
+	utilityMock.EXPECT().
+		ReapMempool(maxTxBytes, gomock.Any()).
+		Return(make([][]byte, 0), nil).
+		AnyTimes()
+	utilityMock.EXPECT().ValidateProposalTransactions(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	utilityMock.EXPECT().IsMempoolEmpty().Return(true).AnyTimes()
 	utilityMock.EXPECT().GetModuleName().Return(modules.UtilityModuleName).AnyTimes()
 
 	return utilityMock
@@ -400,9 +461,18 @@ func baseUtilityContextMock(t *testing.T, genesisState *genesis.GenesisState) *m
 		Return(stateHash, nil).
 		AnyTimes()
 	utilityContextMock.EXPECT().SetProposalBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalBlockTime(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalValidatorSet(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalEvidence(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalMissingSigners(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().SetProposalRecordFailures(gomock.Any()).Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().GetProposalEvidence().Return(nil).AnyTimes()
 	utilityContextMock.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
 	utilityContextMock.EXPECT().Release().Return(nil).AnyTimes()
 	utilityContextMock.EXPECT().GetPersistenceContext().Return(persistenceContextMock).AnyTimes()
+	utilityContextMock.EXPECT().CheckScheduledUpgrades().Return(nil).AnyTimes()
+	utilityContextMock.EXPECT().IsFeatureActive(gomock.Any()).Return(false, nil).AnyTimes()
+	utilityContextMock.EXPECT().IsFeatureActive(gomock.Any()).Return(false, nil).AnyTimes()
 
 	persistenceContextMock.EXPECT().Release().Return(nil).AnyTimes()
 
@@ -437,8 +507,10 @@ func baseRpcMock(t *testing.T, _ modules.EventsChannel) *mockModules.MockRPCModu
 func baseTelemetryTimeSeriesAgentMock(t *testing.T) *mockModules.MockTimeSeriesAgent {
 	ctrl := gomock.NewController(t)
 	timeSeriesAgentMock := mockModules.NewMockTimeSeriesAgent(ctrl)
-	timeSeriesAgentMock.EXPECT().CounterRegister(gomock.Any(), gomock.Any()).MaxTimes(1)
+	timeSeriesAgentMock.EXPECT().CounterRegister(gomock.Any(), gomock.Any()).MaxTimes(2)
 	timeSeriesAgentMock.EXPECT().CounterIncrement(gomock.Any()).AnyTimes()
+	timeSeriesAgentMock.EXPECT().GaugeRegister(gomock.Any(), gomock.Any()).MaxTimes(1)
+	timeSeriesAgentMock.EXPECT().GaugeSet(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	return timeSeriesAgentMock
 }
 
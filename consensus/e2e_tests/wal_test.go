@@ -0,0 +1,211 @@
+package e2e_tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestConsensusWAL_RestartedReplicaStaysLocked(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	// Debug message to start consensus by triggering first view change
+	for _, pocketNode := range pocketNodes {
+		TriggerNextView(t, pocketNode)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	// Leader election is deterministic: node 2 leads round 0 and node 1 is a replica
+	leader := pocketNodes[2]
+	replicaId := typesCons.NodeId(1)
+	replica := pocketNodes[replicaId]
+
+	// The replicas lock on the PRECOMMIT QC before voting COMMIT
+	runRoundUntilVotes(t, clockMock, eventsChannel, pocketNodes, leader, consensus.Commit)
+	lockedQC := GetConsensusNodeState(replica).LockedQC
+	require.NotNil(t, lockedQC)
+
+	// The replica crashes after voting and restarts
+	RestartTestConsensusPocketNode(t, replica)
+
+	nodeState := GetConsensusNodeState(replica)
+	assertNodeConsensusView(t, replicaId,
+		typesCons.ConsensusNodeState{
+			Height: 1,
+			Step:   uint8(consensus.NewRound),
+			Round:  0,
+		},
+		nodeState)
+	require.True(t, proto.Equal(lockedQC, nodeState.LockedQC), "the replica should still be locked on the PRECOMMIT QC")
+	require.NotNil(t, nodeState.PrepareQC)
+
+	// A proposal for another block in the next round cannot unlock the replica without a newer QC
+	conflictingProposal := &typesCons.HotstuffMessage{
+		Type:   consensus.Propose,
+		Height: 1,
+		Step:   consensus.Prepare,
+		Round:  1,
+		Block:  newConflictingBlock(lockedQC.GetBlock()),
+	}
+	P2PSend(t, replica, toAny(t, conflictingProposal))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	_, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Vote, 0, 250, true)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(lockedQC, GetConsensusNodeState(replica).LockedQC))
+}
+
+func TestConsensusWAL_RestartedReplicaDoesNotVoteTwice(t *testing.T) {
+	// Test preparation
+	clockMock := clock.NewMock()
+	timeReminder(t, clockMock, time.Second)
+
+	runtimeMgrs := GenerateNodeRuntimeMgrs(t, numValidators, clockMock)
+	buses := GenerateBuses(t, runtimeMgrs)
+
+	// Create & start test pocket nodes
+	eventsChannel := make(modules.EventsChannel, 100)
+	pocketNodes := CreateTestConsensusPocketNodes(t, buses, eventsChannel)
+	StartAllTestPocketNodes(t, pocketNodes)
+
+	// Debug message to start consensus by triggering first view change
+	for _, pocketNode := range pocketNodes {
+		TriggerNextView(t, pocketNode)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	// Leader election is deterministic: node 2 leads round 0 and node 1 is a replica
+	leader := pocketNodes[2]
+	replicaId := typesCons.NodeId(1)
+	replica := pocketNodes[replicaId]
+
+	prepareVotes := runRoundUntilVotes(t, clockMock, eventsChannel, pocketNodes, leader, consensus.Prepare)
+	votedBlock := getHotstuffMessage(t, prepareVotes[0]).GetBlock()
+
+	// The replica crashes after voting PREPARE and restarts before it received a QC
+	RestartTestConsensusPocketNode(t, replica)
+
+	nodeState := GetConsensusNodeState(replica)
+	assertNodeConsensusView(t, replicaId,
+		typesCons.ConsensusNodeState{
+			Height: 1,
+			Step:   uint8(consensus.NewRound),
+			Round:  0,
+		},
+		nodeState)
+	require.Nil(t, nodeState.LockedQC)
+
+	// A proposal for another block in the same round is valid, but the replica already voted in this round
+	sendProposal(t, clockMock, replica, newConflictingBlock(votedBlock))
+	_, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Vote, 0, 250, true)
+	require.NoError(t, err)
+
+	// The replica restarts again and sends the vote it already sent if it receives the same proposal
+	RestartTestConsensusPocketNode(t, replica)
+	sendProposal(t, clockMock, replica, votedBlock)
+	votes, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.Prepare, consensus.Vote, 1, 250, true)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(getHotstuffMessage(t, prepareVotes[0]).GetBlock(), getHotstuffMessage(t, votes[0]).GetBlock()))
+}
+
+// runRoundUntilVotes runs the first round of the first height until every node voted in `lastStep`, and returns
+// these votes without sending them to the leader
+func runRoundUntilVotes(
+	t *testing.T,
+	clockMock *clock.Mock,
+	eventsChannel modules.EventsChannel,
+	pocketNodes IdToNodeMapping,
+	leader *shared.Node,
+	lastStep typesCons.HotstuffStep,
+) []*anypb.Any {
+	newRoundMessages, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, consensus.NewRound, consensus.Propose, numValidators*numValidators, 250, true)
+	require.NoError(t, err)
+	for _, message := range newRoundMessages {
+		P2PBroadcast(t, pocketNodes, message)
+	}
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	var votes []*anypb.Any
+	for _, step := range []typesCons.HotstuffStep{consensus.Prepare, consensus.PreCommit, consensus.Commit} {
+		for _, vote := range votes {
+			P2PSend(t, leader, vote)
+		}
+		advanceTime(t, clockMock, 10*time.Millisecond)
+
+		proposals, err := WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, step, consensus.Propose, numValidators, 250, true)
+		require.NoError(t, err)
+		for _, proposal := range proposals {
+			P2PBroadcast(t, pocketNodes, proposal)
+		}
+		advanceTime(t, clockMock, 10*time.Millisecond)
+
+		votes, err = WaitForNetworkConsensusEvents(t, clockMock, eventsChannel, step, consensus.Vote, numValidators, 250, true)
+		require.NoError(t, err)
+		if step == lastStep {
+			break
+		}
+	}
+	return votes
+}
+
+// sendProposal starts round 0 on the node and sends it the PREPARE proposal of the block
+func sendProposal(t *testing.T, clockMock *clock.Mock, node *shared.Node, block *coreTypes.Block) {
+	P2PSend(t, node, toAny(t, &typesCons.HotstuffMessage{
+		Type:   consensus.Propose,
+		Height: 1,
+		Step:   consensus.NewRound,
+		Round:  0,
+	}))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+
+	P2PSend(t, node, toAny(t, &typesCons.HotstuffMessage{
+		Type:   consensus.Propose,
+		Height: 1,
+		Step:   consensus.Prepare,
+		Round:  0,
+		Block:  block,
+	}))
+	advanceTime(t, clockMock, 10*time.Millisecond)
+}
+
+// newConflictingBlock returns a block at the same height as `block` with different transactions
+func newConflictingBlock(block *coreTypes.Block) *coreTypes.Block {
+	conflictingBlock := proto.Clone(block).(*coreTypes.Block)
+	conflictingBlock.Transactions = [][]byte{[]byte("conflicting_tx")}
+	conflictingBlock.BlockHeader.NumTxs = 1
+	return conflictingBlock
+}
+
+func getHotstuffMessage(t *testing.T, anyMsg *anypb.Any) *typesCons.HotstuffMessage {
+	msg, err := codec.GetCodec().FromAny(anyMsg)
+	require.NoError(t, err)
+	hotstuffMessage, ok := msg.(*typesCons.HotstuffMessage)
+	require.True(t, ok)
+	return hotstuffMessage
+}
+
+func toAny(t *testing.T, msg proto.Message) *anypb.Any {
+	anyMsg, err := codec.GetCodec().ToAny(msg)
+	require.NoError(t, err)
+	return anyMsg
+}
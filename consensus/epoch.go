@@ -0,0 +1,123 @@
+package consensus
+
+import (
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// getValidatorSet returns the validator set of the epoch of the block at `height`, which certifies the blocks of
+// the epoch and elects their leaders. The snapshot is read from the first block of the epoch once it is committed,
+// and is otherwise taken from the committed state at the snapshot height of the epoch (see `getEpochSnapshotHeight`).
+// The node neither proposes nor votes on a block of an epoch whose snapshot is not committed yet, since the
+// validator set would otherwise depend on how far the node has committed.
+func (m *consensusModule) getValidatorSet(height uint64) (*coreTypes.ValidatorSet, error) {
+	epochLength := m.consCfg.GetEpochLength()
+	epoch := coreTypes.GetEpoch(height, epochLength)
+	if validatorSet, ok := m.validatorSets[epoch]; ok {
+		return validatorSet, nil
+	}
+
+	startHeight := coreTypes.GetEpochStartHeight(epoch, epochLength)
+	snapshotHeight := m.getEpochSnapshotHeight(height)
+	committedHeight := m.getCommittedHeight()
+
+	if startHeight <= committedHeight {
+		if block, err := m.GetBus().GetPersistenceModule().GetBlock(int64(startHeight)); err == nil && block.GetValidatorSet() != nil {
+			m.validatorSets[epoch] = block.GetValidatorSet()
+			return block.GetValidatorSet(), nil
+		}
+	}
+
+	if snapshotHeight > committedHeight {
+		return nil, typesCons.ErrValidatorSetSnapshotNotCommitted(height, snapshotHeight, committedHeight)
+	}
+
+	persistenceReadContext, err := m.GetBus().GetPersistenceModule().NewReadContext(int64(snapshotHeight))
+	if err != nil {
+		return nil, err
+	}
+	defer persistenceReadContext.Close()
+
+	validators, err := persistenceReadContext.GetAllValidators(int64(snapshotHeight))
+	if err != nil {
+		return nil, err
+	}
+	validatorSet := &coreTypes.ValidatorSet{
+		Epoch:       epoch,
+		StartHeight: startHeight,
+		Validators:  validators,
+	}
+	m.validatorSets[epoch] = validatorSet
+	return validatorSet, nil
+}
+
+// getEpochSnapshotHeight returns the height of the committed state the validator set of the epoch of the block at
+// `height` is snapshotted from. Chained HotStuff snapshots an earlier state, since the last block of the previous
+// epoch is not committed yet when the first block of the epoch is proposed.
+func (m *consensusModule) getEpochSnapshotHeight(height uint64) uint64 {
+	if m.isChainedHotstuff() {
+		return coreTypes.GetChainedEpochSnapshotHeight(height, m.consCfg.GetEpochLength())
+	}
+	return coreTypes.GetEpochSnapshotHeight(height, m.consCfg.GetEpochLength())
+}
+
+// getCommittedHeight returns the height of the last block committed by the node
+func (m *consensusModule) getCommittedHeight() uint64 {
+	if m.isChainedHotstuff() {
+		return m.chained.committedHeight
+	}
+	if m.height == 0 {
+		return 0
+	}
+	return m.height - 1
+}
+
+// pruneValidatorSets forgets the validator sets of the epochs before the epoch of the committed block, except for
+// the previous one, since votes for its last block may still be received
+func (m *consensusModule) pruneValidatorSets(committedHeight uint64) {
+	currentEpoch := coreTypes.GetEpoch(committedHeight, m.consCfg.GetEpochLength())
+	for epoch := range m.validatorSets {
+		if epoch+1 < currentEpoch {
+			delete(m.validatorSets, epoch)
+		}
+	}
+}
+
+// setBlockValidatorSet commits the validator set of the epoch of the block in its header, and stores the snapshot
+// in the first block of the epoch. The validator set is returned for the utility context of the proposal.
+func (m *consensusModule) setBlockValidatorSet(block *coreTypes.Block) (*coreTypes.ValidatorSet, error) {
+	validatorSet, err := m.getValidatorSet(block.GetBlockHeader().GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	block.BlockHeader.ValidatorSetHash = validatorSet.Hash()
+	if block.GetBlockHeader().GetHeight() == validatorSet.GetStartHeight() {
+		block.ValidatorSet = validatorSet
+	}
+	return validatorSet, nil
+}
+
+// validateBlockValidatorSet checks that the block commits the validator set of its epoch, and that the first block
+// of an epoch carries the snapshot of the validator set
+func (m *consensusModule) validateBlockValidatorSet(block *coreTypes.Block) error {
+	height := block.GetBlockHeader().GetHeight()
+	validatorSet, err := m.getValidatorSet(height)
+	if err != nil {
+		return err
+	}
+	if blockValidatorSetHash := block.GetBlockHeader().GetValidatorSetHash(); blockValidatorSetHash != validatorSet.Hash() {
+		return typesCons.ErrInvalidValidatorSetHash(height, blockValidatorSetHash, validatorSet.Hash())
+	}
+
+	snapshot := block.GetValidatorSet()
+	if height != validatorSet.GetStartHeight() {
+		if snapshot != nil {
+			return typesCons.ErrInvalidValidatorSetSnapshot(height, "only the first block of an epoch carries the snapshot")
+		}
+		return nil
+	}
+	if snapshot.GetEpoch() != validatorSet.GetEpoch() || snapshot.GetStartHeight() != validatorSet.GetStartHeight() || snapshot.Hash() != validatorSet.Hash() {
+		return typesCons.ErrInvalidValidatorSetSnapshot(height, "the snapshot is not the validator set of the epoch")
+	}
+	return nil
+}
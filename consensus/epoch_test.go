@@ -0,0 +1,146 @@
+package consensus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const testingEpochLength = 3
+
+func TestGetValidatorSet_JoinsAndLeavesTakeEffectAtEpochBoundaries(t *testing.T) {
+	valA, valB, valC, valD := newTestingActor("a"), newTestingActor("b"), newTestingActor("c"), newTestingActor("d")
+
+	// D joins at height 2 and A leaves at height 4, in the middle of the first two epochs
+	m := newTestingEpochModule(t, map[uint64][]*coreTypes.Actor{
+		0: {valA, valB, valC},
+		2: {valA, valB, valC, valD},
+		4: {valB, valC, valD},
+	}, 10)
+
+	expectedValidators := map[uint64][]*coreTypes.Actor{
+		1: {valA, valB, valC},
+		3: {valA, valB, valC},
+		4: {valA, valB, valC, valD},
+		6: {valA, valB, valC, valD},
+		7: {valB, valC, valD},
+		9: {valB, valC, valD},
+	}
+	for height, validators := range expectedValidators {
+		validatorSet, err := m.getValidatorSet(height)
+		require.NoError(t, err)
+		require.Equal(t, validators, validatorSet.GetValidators(), "height %d", height)
+		require.Equal(t, coreTypes.GetEpochStartHeight(coreTypes.GetEpoch(height, testingEpochLength), testingEpochLength), validatorSet.GetStartHeight())
+	}
+
+	// The validator sets of the epochs before the previous one are forgotten once a block is committed
+	m.pruneValidatorSets(9)
+	require.Len(t, m.validatorSets, 2)
+}
+
+func TestGetValidatorSet_RequiresCommittedSnapshot(t *testing.T) {
+	valA, valB := newTestingActor("a"), newTestingActor("b")
+	validatorsHistory := map[uint64][]*coreTypes.Actor{
+		0:  {valA},
+		13: {valA, valB},
+	}
+
+	// The node at height 5 has committed height 4, which is not the snapshot of the epoch starting at height 7
+	m := newTestingEpochModule(t, validatorsHistory, 5)
+	_, err := m.getValidatorSet(6)
+	require.NoError(t, err)
+	_, err = m.getValidatorSet(7)
+	require.EqualError(t, err, typesCons.ErrValidatorSetSnapshotNotCommitted(7, 6, 4).Error())
+	require.NotContains(t, m.validatorSets, coreTypes.GetEpoch(7, testingEpochLength))
+
+	// Chained HotStuff snapshots the validator set of an epoch from an earlier committed state
+	m = newTestingEpochModule(t, validatorsHistory, 0)
+	m.consCfg.HotstuffMode = configs.HotstuffMode_ChainedHotstuff
+	m.chained.committedHeight = 13
+	validatorSet, err := m.getValidatorSet(16)
+	require.NoError(t, err)
+	require.Equal(t, []*coreTypes.Actor{valA}, validatorSet.GetValidators())
+	validatorSet, err = m.getValidatorSet(25)
+	require.NoError(t, err)
+	require.Equal(t, []*coreTypes.Actor{valA, valB}, validatorSet.GetValidators())
+	_, err = m.getValidatorSet(28)
+	require.EqualError(t, err, typesCons.ErrValidatorSetSnapshotNotCommitted(28, 17, 13).Error())
+}
+
+func TestValidateBlockValidatorSet(t *testing.T) {
+	valA, valB := newTestingActor("a"), newTestingActor("b")
+	m := newTestingEpochModule(t, map[uint64][]*coreTypes.Actor{
+		0: {valA},
+		2: {valA, valB},
+	}, 5)
+
+	// Only the first block of an epoch carries the snapshot
+	startBlock := &coreTypes.Block{BlockHeader: &coreTypes.BlockHeader{Height: 4}}
+	validatorSet, err := m.setBlockValidatorSet(startBlock)
+	require.NoError(t, err)
+	require.Equal(t, validatorSet, startBlock.GetValidatorSet())
+	require.NoError(t, m.validateBlockValidatorSet(startBlock))
+
+	block := &coreTypes.Block{BlockHeader: &coreTypes.BlockHeader{Height: 5}}
+	_, err = m.setBlockValidatorSet(block)
+	require.NoError(t, err)
+	require.Nil(t, block.GetValidatorSet())
+	require.NoError(t, m.validateBlockValidatorSet(block))
+
+	// A block of the first epoch cannot commit the validators joining during the epoch
+	earlyBlock := &coreTypes.Block{BlockHeader: &coreTypes.BlockHeader{
+		Height:           3,
+		ValidatorSetHash: coreTypes.HashValidators([]*coreTypes.Actor{valA, valB}),
+	}}
+	require.EqualError(t, m.validateBlockValidatorSet(earlyBlock),
+		typesCons.ErrInvalidValidatorSetHash(3, earlyBlock.GetBlockHeader().GetValidatorSetHash(), coreTypes.HashValidators([]*coreTypes.Actor{valA})).Error())
+
+	block.ValidatorSet = startBlock.GetValidatorSet()
+	require.Error(t, m.validateBlockValidatorSet(block))
+	startBlock.ValidatorSet = nil
+	require.Error(t, m.validateBlockValidatorSet(startBlock))
+}
+
+// newTestingEpochModule creates a consensus module at `height` whose persistence returns the latest validators of
+// `validatorsHistory` at or below the height read
+func newTestingEpochModule(t *testing.T, validatorsHistory map[uint64][]*coreTypes.Actor, height uint64) *consensusModule {
+	ctrl := gomock.NewController(t)
+	busMock := mockModules.NewMockBus(ctrl)
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceReadContextMock := mockModules.NewMockPersistenceReadContext(ctrl)
+
+	busMock.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
+	persistenceMock.EXPECT().GetBlock(gomock.Any()).Return(nil, fmt.Errorf("no block stored")).AnyTimes()
+	persistenceMock.EXPECT().NewReadContext(gomock.Any()).Return(persistenceReadContextMock, nil).AnyTimes()
+	persistenceReadContextMock.EXPECT().GetAllValidators(gomock.Any()).DoAndReturn(
+		func(height int64) ([]*coreTypes.Actor, error) {
+			for h := uint64(height); ; h-- {
+				if validators, ok := validatorsHistory[h]; ok {
+					return validators, nil
+				}
+			}
+		}).AnyTimes()
+	persistenceReadContextMock.EXPECT().Close().Return(nil).AnyTimes()
+
+	m := &consensusModule{
+		bus:           busMock,
+		consCfg:       &configs.ConsensusConfig{EpochLength: testingEpochLength},
+		height:        height,
+		validatorSets: make(map[uint64]*coreTypes.ValidatorSet),
+	}
+	return m
+}
+
+func newTestingActor(name string) *coreTypes.Actor {
+	return &coreTypes.Actor{
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:      fmt.Sprintf("%x", name),
+		StakedAmount: "1000000000000",
+	}
+}
@@ -0,0 +1,133 @@
+package consensus
+
+import (
+	"fmt"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// detectDoubleSign returns an error if `msg` is a vote for a different block than a vote of the same
+// validator, for the same (height, step, round), already in the consensus mempool. The two votes are
+// kept as evidence that is proposed in the next block this node leads.
+func (m *consensusModule) detectDoubleSign(msg *typesCons.HotstuffMessage) error {
+	if msg.GetType() != Vote || msg.GetPartialSignature() == nil || msg.GetBlock() == nil {
+		return nil
+	}
+	address := msg.GetPartialSignature().GetAddress()
+	blockHash := GetBlockHash(msg.GetBlock())
+	for _, indexedMsg := range m.messagePool[msg.GetStep()] {
+		if indexedMsg.GetPartialSignature().GetAddress() != address ||
+			indexedMsg.GetHeight() != msg.GetHeight() ||
+			indexedMsg.GetRound() != msg.GetRound() ||
+			GetBlockHash(indexedMsg.GetBlock()) == blockHash {
+			continue
+		}
+		evidence, err := m.newDoubleSignEvidence(indexedMsg, msg)
+		if err != nil {
+			return err
+		}
+		m.addEvidence(evidence)
+		return typesCons.ErrDoubleSignDetected(address, msg)
+	}
+	return nil
+}
+
+// newDoubleSignEvidence packages two conflicting votes, whose signatures were already validated, so that
+// they can be verified by any node. The evidence only carries the signed bytes of the votes, which commit to
+// their blocks by hash, so its size does not depend on the size of the blocks.
+func (m *consensusModule) newDoubleSignEvidence(voteA, voteB *typesCons.HotstuffMessage) (*coreTypes.DoubleSignEvidence, error) {
+	address := voteA.GetPartialSignature().GetAddress()
+	if voteB.GetPartialSignature().GetAddress() != address {
+		return nil, typesCons.ErrInvalidDoubleSignEvidence(fmt.Errorf("votes of %s and %s", address, voteB.GetPartialSignature().GetAddress()))
+	}
+	validators, err := m.getValidatorsAtHeight(voteA.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	validator, ok := typesCons.NewActorMapper(validators).GetValidatorMap()[address]
+	if !ok {
+		return nil, typesCons.ErrMissingValidator(address, 0)
+	}
+	pubKey, err := cryptoPocket.NewPublicKey(validator.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	signableBytesA, err := getSignableBytes(voteA)
+	if err != nil {
+		return nil, err
+	}
+	signableBytesB, err := getSignableBytes(voteB)
+	if err != nil {
+		return nil, err
+	}
+	return &coreTypes.DoubleSignEvidence{
+		PublicKey:  pubKey.Bytes(),
+		Height:     voteA.GetHeight(),
+		Round:      voteA.GetRound(),
+		Step:       uint32(voteA.GetStep()),
+		VoteA:      signableBytesA,
+		SignatureA: voteA.GetPartialSignature().GetSignature(),
+		VoteB:      signableBytesB,
+		SignatureB: voteB.GetPartialSignature().GetSignature(),
+	}, nil
+}
+
+// validateDoubleSignEvidence checks that the votes of the evidence are hotstuff votes for two different
+// blocks at the (height, step, round) of the evidence. The votes are the bytes signed by the double signer,
+// and `ValidateBasic` checks that both were signed by the key of `evidence.Address()`. The stateful rules
+// (e.g. the maximum age of the evidence) are validated by the utility module.
+func validateDoubleSignEvidence(evidence *coreTypes.DoubleSignEvidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return typesCons.ErrInvalidDoubleSignEvidence(err)
+	}
+	voteA, voteB := new(typesCons.SignableHotstuffMessage), new(typesCons.SignableHotstuffMessage)
+	if err := codec.GetCodec().Unmarshal(evidence.GetVoteA(), voteA); err != nil {
+		return typesCons.ErrInvalidDoubleSignEvidence(err)
+	}
+	if err := codec.GetCodec().Unmarshal(evidence.GetVoteB(), voteB); err != nil {
+		return typesCons.ErrInvalidDoubleSignEvidence(err)
+	}
+	for _, vote := range []*typesCons.SignableHotstuffMessage{voteA, voteB} {
+		// A timeout is not bound to a block, so two timeouts cannot conflict
+		if vote.GetType() != Vote {
+			return typesCons.ErrInvalidDoubleSignEvidence(fmt.Errorf("expected a %s but got a %s", Vote, vote.GetType()))
+		}
+		if vote.GetHeight() != evidence.GetHeight() || vote.GetRound() != evidence.GetRound() || uint32(vote.GetStep()) != evidence.GetStep() {
+			return typesCons.ErrInvalidDoubleSignEvidence(fmt.Errorf("vote (%d, %d, %d) does not match the evidence (%d, %d, %d)",
+				vote.GetHeight(), vote.GetStep(), vote.GetRound(), evidence.GetHeight(), evidence.GetStep(), evidence.GetRound()))
+		}
+	}
+	if voteA.GetBlockHash() == voteB.GetBlockHash() {
+		return typesCons.ErrInvalidDoubleSignEvidence(fmt.Errorf("votes are for the same block"))
+	}
+	return nil
+}
+
+// addEvidence adds the evidence to the pool of evidence waiting to be proposed, unless the same double
+// sign is already in it
+func (m *consensusModule) addEvidence(evidence *coreTypes.DoubleSignEvidence) {
+	for _, pooledEvidence := range m.evidencePool {
+		if pooledEvidence.Hash() == evidence.Hash() {
+			return
+		}
+	}
+	m.evidencePool = append(m.evidencePool, evidence)
+}
+
+// pruneEvidencePool removes the evidence handled by a committed block from the pool
+func (m *consensusModule) pruneEvidencePool(committedEvidence []*coreTypes.DoubleSignEvidence) {
+	committed := make(map[string]struct{}, len(committedEvidence))
+	for _, evidence := range committedEvidence {
+		committed[evidence.Hash()] = struct{}{}
+	}
+	pendingEvidence := make([]*coreTypes.DoubleSignEvidence, 0, len(m.evidencePool))
+	for _, evidence := range m.evidencePool {
+		if _, ok := committed[evidence.Hash()]; !ok {
+			pendingEvidence = append(pendingEvidence, evidence)
+		}
+	}
+	m.evidencePool = pendingEvidence
+}
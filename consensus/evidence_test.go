@@ -0,0 +1,79 @@
+package consensus
+
+import (
+	"testing"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDoubleSignEvidence(t *testing.T) {
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	voteA := newTestingVote(t, privKey, 1, Prepare, "state_hash_a")
+	voteB := newTestingVote(t, privKey, 1, Prepare, "state_hash_b")
+	require.NoError(t, validateDoubleSignEvidence(newTestingEvidence(t, privKey, voteA, voteB)))
+
+	// the votes commit to their blocks by hash, so blocks that only differ by their transactions conflict
+	voteC := newTestingVote(t, privKey, 1, Prepare, "state_hash_a")
+	voteC.Block.Transactions = [][]byte{[]byte("tx")}
+	voteC.Justification = &typesCons.HotstuffMessage_PartialSignature{
+		PartialSignature: &typesCons.PartialSignature{
+			Signature: getMessageSignature(voteC, privKey),
+			Address:   privKey.Address().String(),
+		},
+	}
+	evidence := newTestingEvidence(t, privKey, voteA, voteC)
+	require.NoError(t, validateDoubleSignEvidence(evidence))
+	require.Less(t, len(evidence.GetVoteB()), 200, "the evidence should not carry the blocks")
+
+	// two timeouts of the same round are not a double sign
+	timeoutA := CreateTimeoutMessage(1, 0, privKey)
+	timeoutB := CreateTimeoutMessage(1, 0, privKey)
+	timeoutB.Block = voteA.GetBlock()
+	timeoutB.GetPartialSignature().Signature = getMessageSignature(timeoutB, privKey)
+	require.Error(t, validateDoubleSignEvidence(newTestingEvidence(t, privKey, timeoutA, timeoutB)))
+
+	// votes for different steps are not a double sign
+	voteD := newTestingVote(t, privKey, 1, PreCommit, "state_hash_b")
+	require.Error(t, validateDoubleSignEvidence(newTestingEvidence(t, privKey, voteA, voteD)))
+
+	// the evidence must be signed by the double signer
+	otherPrivKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+	forgedVote := newTestingVote(t, otherPrivKey, 1, Prepare, "state_hash_b")
+	evidence = newTestingEvidence(t, privKey, voteA, forgedVote)
+	require.Error(t, validateDoubleSignEvidence(evidence))
+}
+
+func newTestingVote(t *testing.T, privKey cryptoPocket.PrivateKey, height uint64, step typesCons.HotstuffStep, stateHash string) *typesCons.HotstuffMessage {
+	block := &coreTypes.Block{
+		BlockHeader: &coreTypes.BlockHeader{
+			Height:    height,
+			StateHash: stateHash,
+		},
+	}
+	vote, err := CreateVoteMessage(height, 0, step, block, privKey)
+	require.NoError(t, err)
+	return vote
+}
+
+func newTestingEvidence(t *testing.T, privKey cryptoPocket.PrivateKey, voteA, voteB *typesCons.HotstuffMessage) *coreTypes.DoubleSignEvidence {
+	signableBytesA, err := getSignableBytes(voteA)
+	require.NoError(t, err)
+	signableBytesB, err := getSignableBytes(voteB)
+	require.NoError(t, err)
+	return &coreTypes.DoubleSignEvidence{
+		PublicKey:  privKey.PublicKey().Bytes(),
+		Height:     voteA.GetHeight(),
+		Round:      voteA.GetRound(),
+		Step:       uint32(voteA.GetStep()),
+		VoteA:      signableBytesA,
+		SignatureA: voteA.GetPartialSignature().GetSignature(),
+		VoteB:      signableBytesB,
+		SignatureB: voteB.GetPartialSignature().GetSignature(),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
@@ -23,10 +24,10 @@ const (
 
 	Propose = typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_PROPOSE
 	Vote    = typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_VOTE
+	Timeout = typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_TIMEOUT
 
-	ByzantineThreshold = float64(2) / float64(3)
-
-	HotstuffMessageContentType = "consensus.HotstuffMessage"
+	HotstuffMessageContentType  = "consensus.HotstuffMessage"
+	StateSyncMessageContentType = "consensus.StateSyncMessage"
 )
 
 var (
@@ -62,11 +63,11 @@ func (m *consensusModule) getQuorumCertificate(height uint64, step typesCons.Hot
 		return nil, err
 	}
 
-	if err := m.isOptimisticThresholdMet(len(pss), validators); err != nil {
+	if err := m.isQuorumMet(getPartialSignatureSigners(pss), validators); err != nil {
 		return nil, err
 	}
 
-	thresholdSig, err := getThresholdSignature(pss)
+	thresholdSig, err := getThresholdSignature(m.consCfg.GetThresholdSignatureScheme(), pss, validators)
 	if err != nil {
 		return nil, err
 	}
@@ -93,13 +94,61 @@ func (m *consensusModule) findHighQC(msgs []*typesCons.HotstuffMessage) (qc *typ
 	return
 }
 
-func getThresholdSignature(partialSigs []*typesCons.PartialSignature) (*typesCons.ThresholdSignature, error) {
+// createVoteMessage creates the vote of this node for the current block, which also carries the BLS signature of
+// the vote if the votes are aggregated into BLS threshold signatures
+func (m *consensusModule) createVoteMessage(step typesCons.HotstuffStep) (*typesCons.HotstuffMessage, error) {
+	msg, err := CreateVoteMessage(m.height, m.round, step, m.block, m.privateKey)
+	if err != nil || m.blsPrivateKey == nil {
+		return msg, err
+	}
+	return msg, SignVoteBLS(msg, m.blsPrivateKey)
+}
+
+// getThresholdSignature combines the partial signatures of the votes into the threshold signature of a QC. With BLS
+// aggregate signatures, it is a constant size aggregate signature and a bitmap of the signers; otherwise, it is the
+// list of the individual signatures.
+func getThresholdSignature(scheme configs.ThresholdSignatureScheme, partialSigs []*typesCons.PartialSignature, validators []*coreTypes.Actor) (*typesCons.ThresholdSignature, error) {
+	if scheme == configs.ThresholdSignatureScheme_BLSAggregateSignature {
+		return getAggregateThresholdSignature(partialSigs, validators)
+	}
+
 	thresholdSig := new(typesCons.ThresholdSignature)
 	thresholdSig.Signatures = make([]*typesCons.PartialSignature, len(partialSigs))
 	copy(thresholdSig.Signatures, partialSigs)
 	return thresholdSig, nil
 }
 
+// VerifyQuorumCertificate checks the ed25519 threshold signature of the QC against the validator set of its height
+// without a consensus module, which light clients use to verify the QCs stored in the block headers.
+// TODO: Verify BLS aggregate signatures once the light clients can prove the BLS keys of the validators.
+func VerifyQuorumCertificate(qc *typesCons.QuorumCertificate, validators []*coreTypes.Actor, quorumType configs.QuorumType) error {
+	if qc == nil {
+		return typesCons.ErrNilQC
+	}
+	if qc.GetBlock() == nil {
+		return typesCons.ErrNilBlockInQC
+	}
+	thresholdSig := qc.GetThresholdSignature()
+	if thresholdSig == nil || len(thresholdSig.GetSignatures()) == 0 {
+		return typesCons.ErrNilThresholdSigInQC
+	}
+
+	msgToJustify := qcToHotstuffMessage(qc)
+	validatorMap := typesCons.NewActorMapper(validators).GetValidatorMap()
+	signers := make([]string, 0, len(thresholdSig.GetSignatures()))
+	for _, partialSig := range thresholdSig.GetSignatures() {
+		validator, ok := validatorMap[partialSig.Address]
+		if !ok {
+			continue
+		}
+		if !isSignatureValid(msgToJustify, validator.GetPublicKey(), partialSig.Signature) {
+			continue
+		}
+		signers = append(signers, partialSig.Address)
+	}
+	return IsOptimisticThresholdMet(signers, validators, quorumType)
+}
+
 func isSignatureValid(msg *typesCons.HotstuffMessage, pubKeyString string, signature []byte) bool {
 	pubKey, err := cryptoPocket.NewPublicKey(pubKeyString)
 	if err != nil {
@@ -119,15 +168,7 @@ func (m *consensusModule) didReceiveEnoughMessageForStep(step typesCons.Hotstuff
 	if err != nil {
 		return err
 	}
-	return m.isOptimisticThresholdMet(len(m.messagePool[step]), validators)
-}
-
-func (m *consensusModule) isOptimisticThresholdMet(numSignatures int, validators []*coreTypes.Actor) error {
-	numValidators := len(validators)
-	if !(float64(numSignatures) > ByzantineThreshold*float64(numValidators)) {
-		return typesCons.ErrByzantineThresholdCheck(numSignatures, ByzantineThreshold*float64(numValidators))
-	}
-	return nil
+	return m.isQuorumMet(getMessageSigners(m.messagePool[step]), validators)
 }
 
 func protoHash(m proto.Message) string {
@@ -141,13 +182,20 @@ func protoHash(m proto.Message) string {
 /*** P2P Helpers ***/
 
 func (m *consensusModule) sendToLeader(msg *typesCons.HotstuffMessage) {
-	m.nodeLog(typesCons.SendingMessage(msg, *m.leaderId))
-
 	// TODO: This can happen due to a race condition with the pacemaker.
 	if m.leaderId == nil {
 		m.nodeLogError(typesCons.ErrNilLeaderId.Error(), nil)
 		return
 	}
+	m.sendToNode(msg, *m.leaderId)
+}
+
+func (m *consensusModule) sendToNode(msg *typesCons.HotstuffMessage, nodeId typesCons.NodeId) {
+	if err := m.writeSentMessage(msg); err != nil {
+		m.nodeLogError(typesCons.ErrSendMessage.Error(), err)
+		return
+	}
+	m.nodeLog(typesCons.SendingMessage(msg, nodeId))
 
 	anyConsensusMessage, err := codec.GetCodec().ToAny(msg)
 	if err != nil {
@@ -162,7 +210,7 @@ func (m *consensusModule) sendToLeader(msg *typesCons.HotstuffMessage) {
 
 	idToValAddrMap := typesCons.NewActorMapper(validators).GetIdToValAddrMap()
 
-	if err := m.GetBus().GetP2PModule().Send(cryptoPocket.AddressFromString(idToValAddrMap[*m.leaderId]), anyConsensusMessage); err != nil {
+	if err := m.GetBus().GetP2PModule().Send(cryptoPocket.AddressFromString(idToValAddrMap[nodeId]), anyConsensusMessage); err != nil {
 		m.nodeLogError(typesCons.ErrSendMessage.Error(), err)
 		return
 	}
@@ -171,6 +219,10 @@ func (m *consensusModule) sendToLeader(msg *typesCons.HotstuffMessage) {
 // Star-like (O(n)) broadcast - send to all nodes directly
 // INVESTIGATE: Re-evaluate if we should be using our structured broadcast (RainTree O(log3(n))) algorithm instead
 func (m *consensusModule) broadcastToValidators(msg *typesCons.HotstuffMessage) {
+	if err := m.writeSentMessage(msg); err != nil {
+		m.nodeLogError(typesCons.ErrBroadcastMessage.Error(), err)
+		return
+	}
 	m.nodeLog(typesCons.BroadcastingMessage(msg))
 
 	anyConsensusMessage, err := codec.GetCodec().ToAny(msg)
@@ -191,6 +243,17 @@ func (m *consensusModule) broadcastToValidators(msg *typesCons.HotstuffMessage)
 	}
 }
 
+// reportInvalidMessage reports a message with an invalid signature to the P2P module, so the peers that relayed it
+// are penalized
+func (m *consensusModule) reportInvalidMessage(msg *typesCons.HotstuffMessage) {
+	anyConsensusMessage, err := codec.GetCodec().ToAny(msg)
+	if err != nil {
+		m.nodeLogError(typesCons.ErrCreateConsensusMessage.Error(), err)
+		return
+	}
+	m.GetBus().GetP2PModule().ReportInvalidMessage(anyConsensusMessage)
+}
+
 /*** Persistence Helpers ***/
 
 // TECHDEBT(#388): Integrate this with the `persistence` module or a real mempool.
@@ -212,11 +275,38 @@ func (m *consensusModule) clearLeader() {
 
 func (m *consensusModule) electNextLeader(message *typesCons.HotstuffMessage) error {
 	leaderId, err := m.leaderElectionMod.ElectNextLeader(message)
-	if err != nil || leaderId == 0 {
+	if err != nil {
 		m.nodeLogError(typesCons.ErrLeaderElection(message).Error(), err)
 		m.clearLeader()
 		return err
 	}
+
+	// With VRF sortition, a node that is not eligible to lead the round learns the leader from its proposal
+	if leaderId == 0 {
+		m.clearLeader()
+		if m.consCfg.GetLeaderElectionStrategy() != configs.LeaderElectionStrategy_VRFSortition {
+			m.nodeLogError(typesCons.ErrLeaderElection(message).Error(), nil)
+			return nil
+		}
+		m.setLogPrefix("REPLICA")
+		m.nodeLog(typesCons.NotElectedLeader(m.height, m.round))
+		return nil
+	}
+
+	return m.setLeader(leaderId)
+}
+
+// acceptProposer verifies that the proposer of the block in the message was elected to lead the round, in
+// which case it becomes the leader this replica votes for
+func (m *consensusModule) acceptProposer(message *typesCons.HotstuffMessage) error {
+	leaderId, err := m.leaderElectionMod.VerifyLeader(message)
+	if err != nil {
+		return err
+	}
+	return m.setLeader(leaderId)
+}
+
+func (m *consensusModule) setLeader(leaderId typesCons.NodeId) error {
 	m.leaderId = &leaderId
 
 	validators, err := m.getValidatorsAtHeight(m.CurrentHeight())
@@ -254,12 +344,23 @@ func (m *consensusModule) setLogPrefix(logPrefix string) {
 	m.paceMaker.SetLogPrefix(logPrefix)
 }
 
+// getValidatorsAtHeight returns the validators of the epoch of the height (see `getValidatorSet`), so the node ids,
+// the quorum and the leader rotation do not change within an epoch
 func (m *consensusModule) getValidatorsAtHeight(height uint64) ([]*coreTypes.Actor, error) {
-	persistenceReadContext, err := m.GetBus().GetPersistenceModule().NewReadContext(int64(height))
+	validatorSet, err := m.getValidatorSet(height)
 	if err != nil {
 		return nil, err
 	}
-	defer persistenceReadContext.Close()
+	return validatorSet.GetValidators(), nil
+}
 
-	return persistenceReadContext.GetAllValidators(int64(height))
+// refreshNodeId updates the id of this node in the validator set of the current height, which changes
+// when validators join, leave, or rotate their operator key
+func (m *consensusModule) refreshNodeId() {
+	validators, err := m.getValidatorsAtHeight(m.CurrentHeight())
+	if err != nil {
+		m.nodeLogError("Failed to refresh the node id", err)
+		return
+	}
+	m.nodeId = typesCons.NewActorMapper(validators).GetValAddrToIdMap()[m.privateKey.Address().String()]
 }
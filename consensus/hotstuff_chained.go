@@ -0,0 +1,718 @@
+package consensus
+
+/*
+	Chained HotStuff:
+
+	1. The leader of a view proposes a block extending the block certified by the highest QC it knows of (its highQC),
+	   in a PREPARE proposal justified by that QC at the height after the certified block
+	2. Replicas vote once per view and send their vote to the leader of the first round of the next height, which
+	   aggregates the votes into the QC justifying its own proposal. The QC of a block therefore doubles as the PREPARE
+	   QC of the block, the PRECOMMIT QC of its parent and the COMMIT QC of its grandparent, and a new block is proposed
+	   every round trip.
+	3. On every new QC, a node updates its highQC (i.e. its prepareQC), moves to the height after the certified block,
+	   locks on the QC of the parent of the certified block (two-chain), and commits the grandparent of the certified
+	   block, along with its uncommitted ancestors, if the block and its parent were proposed in the first round of
+	   their height, i.e. right after the QC of their own parent (three-chain)
+	4. A replica votes for a proposal if it extends the block it is locked on, or if it is justified by a QC from a
+	   later view than its lockedQC
+	5. Blocks are applied to the state once they are committed, so proposals do not carry a state hash and are linked
+	   to their parent by hash. The transactions and evidence of the uncommitted ancestors of a proposal are not
+	   proposed again. Replicas only check the transactions and evidence of a proposal against the committed state
+	   before voting, so the transactions that cannot be applied anymore once the block is committed are recorded as
+	   failed, and the evidence that cannot be handled anymore is dropped.
+	6. The pacemaker is the same as in basic HotStuff: after a timeout, nodes broadcast a NEWROUND message carrying their
+	   highQC, and the leader of the next round proposes on top of the highest QC of 2/3+ of these messages
+
+	Chained HotStuff requires the round robin leader election: replicas send their votes to the leader of the next
+	height before it is known to them with VRF sortition, which is also seeded with the hash of the previous block
+	that is not committed yet.
+*/
+
+import (
+	"fmt"
+	"time"
+
+	consensusTelemetry "github.com/pokt-network/pocket/consensus/telemetry"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// The chained handlers reuse the validation and telemetry of the basic HotStuff handlers
+var (
+	chainedLeaderHandler  = &HotstuffLeaderMessageHandler{}
+	chainedReplicaHandler = &HotstuffReplicaMessageHandler{}
+)
+
+// chainedState tracks the blocks certified since the last committed block
+type chainedState struct {
+	qcs             map[string]*typesCons.QuorumCertificate // The QC of each uncommitted block, by block hash
+	committedHeight uint64                                  // The height of the last committed block
+}
+
+func newChainedState() chainedState {
+	return chainedState{
+		qcs: make(map[string]*typesCons.QuorumCertificate),
+	}
+}
+
+func (m *consensusModule) isChainedHotstuff() bool {
+	return m.consCfg.GetHotstuffMode() == configs.HotstuffMode_ChainedHotstuff
+}
+
+// isLaterView returns true if `qc1` was formed in a later view, i.e. (height, round), than `qc2`
+func isLaterView(qc1, qc2 *typesCons.QuorumCertificate) bool {
+	return qc1.GetHeight() > qc2.GetHeight() || (qc1.GetHeight() == qc2.GetHeight() && qc1.GetRound() > qc2.GetRound())
+}
+
+// addQC records the block certified by the QC, unless it is already committed
+func (s *chainedState) addQC(qc *typesCons.QuorumCertificate) {
+	if qc.GetHeight() <= s.committedHeight {
+		return
+	}
+	blockHash := GetBlockHash(qc.GetBlock())
+	if knownQC, ok := s.qcs[blockHash]; ok && !isLaterView(qc, knownQC) {
+		return
+	}
+	s.qcs[blockHash] = qc
+}
+
+// getParentQC returns the QC of the parent of the block, or nil if the parent is unknown or committed
+func (s *chainedState) getParentQC(block *coreTypes.Block) *typesCons.QuorumCertificate {
+	return s.qcs[block.GetBlockHeader().GetParentHash()]
+}
+
+// getThreeChainQC returns the QC of the block committed by `qc`: the grandparent of the block certified by `qc` is
+// committed if the block and its parent were proposed in the first round of their height, so that the three QCs were
+// formed in consecutive views
+func (s *chainedState) getThreeChainQC(qc *typesCons.QuorumCertificate) *typesCons.QuorumCertificate {
+	parentQC := s.getParentQC(qc.GetBlock())
+	if parentQC == nil {
+		return nil
+	}
+	grandparentQC := s.getParentQC(parentQC.GetBlock())
+	if grandparentQC == nil {
+		return nil
+	}
+	if qc.GetRound() != 0 || parentQC.GetRound() != 0 {
+		return nil
+	}
+	return grandparentQC
+}
+
+// extends returns true if `block` descends from `ancestor`. The committed blocks are ancestors of every block.
+func (s *chainedState) extends(block, ancestor *coreTypes.Block) bool {
+	ancestorHeight := ancestor.GetBlockHeader().GetHeight()
+	if ancestorHeight <= s.committedHeight {
+		return true
+	}
+	ancestorHash := GetBlockHash(ancestor)
+	parentHash := block.GetBlockHeader().GetParentHash()
+	for {
+		parentQC, ok := s.qcs[parentHash]
+		if !ok {
+			return false
+		}
+		parentHeight := parentQC.GetHeight()
+		if parentHeight <= ancestorHeight {
+			return parentHeight == ancestorHeight && parentHash == ancestorHash
+		}
+		parentHash = parentQC.GetBlock().GetBlockHeader().GetParentHash()
+	}
+}
+
+// getUncommittedChain returns the QCs of the block and of its uncommitted ancestors, from the lowest height
+func (s *chainedState) getUncommittedChain(qc *typesCons.QuorumCertificate) ([]*typesCons.QuorumCertificate, error) {
+	chain := make([]*typesCons.QuorumCertificate, 0)
+	for qc.GetHeight() > s.committedHeight {
+		chain = append([]*typesCons.QuorumCertificate{qc}, chain...)
+		if qc.GetHeight() == s.committedHeight+1 {
+			break
+		}
+		parentQC := s.getParentQC(qc.GetBlock())
+		if parentQC == nil {
+			return nil, typesCons.ErrMissingChainedBlock(qc.GetHeight() - 1)
+		}
+		qc = parentQC
+	}
+	return chain, nil
+}
+
+// getUncommittedTransactions returns the hashes of the transactions of the block and of its uncommitted ancestors,
+// which the blocks extending it must not include again
+func (s *chainedState) getUncommittedTransactions(blockHash string) map[string]struct{} {
+	txs := make(map[string]struct{})
+	for qc, ok := s.qcs[blockHash]; ok; qc, ok = s.qcs[qc.GetBlock().GetBlockHeader().GetParentHash()] {
+		for _, tx := range qc.GetBlock().GetTransactions() {
+			txs[cryptoPocket.GetHashStringFromBytes(tx)] = struct{}{}
+		}
+	}
+	return txs
+}
+
+// getUncommittedEvidence returns the hashes of the evidence of the block and of its uncommitted ancestors, which the
+// blocks extending it must not include again
+func (s *chainedState) getUncommittedEvidence(blockHash string) map[string]struct{} {
+	evidence := make(map[string]struct{})
+	for qc, ok := s.qcs[blockHash]; ok; qc, ok = s.qcs[qc.GetBlock().GetBlockHeader().GetParentHash()] {
+		for _, blockEvidence := range qc.GetBlock().GetEvidence() {
+			evidence[blockEvidence.Hash()] = struct{}{}
+		}
+	}
+	return evidence
+}
+
+// prune forgets the blocks up to the committed height
+func (s *chainedState) prune(committedHeight uint64) {
+	s.committedHeight = committedHeight
+	for blockHash, qc := range s.qcs {
+		if qc.GetHeight() <= committedHeight {
+			delete(s.qcs, blockHash)
+		}
+	}
+}
+
+/*** Handlers ***/
+
+// handleChainedVote aggregates the votes for a block at the height of the node, or later, if this node leads the
+// first round of the next height. Votes are handled before the pacemaker checks since the leader may have moved past
+// the view of the votes.
+func (m *consensusModule) handleChainedVote(msg *typesCons.HotstuffMessage) {
+	if msg.GetStep() != Prepare || msg.GetHeight() <= m.chained.committedHeight {
+		m.nodeLog(typesCons.WarnDiscardHotstuffMessage(msg, "chained vote is stale"))
+		return
+	}
+	if leaderId, err := m.getChainedVoteLeaderId(msg.GetHeight()); err != nil || leaderId != m.nodeId {
+		m.nodeLog(typesCons.WarnDiscardHotstuffMessage(msg, "node does not aggregate the chained votes of the height"))
+		return
+	}
+	chainedLeaderHandler.emitTelemetryEvent(m, msg)
+
+	// The votes for a height certified since the last commit are only compared with the votes aggregated for it, which
+	// are kept until the height is committed, to detect the validators voting for two blocks in the same view
+	if msg.GetHeight() < m.height {
+		if err := m.validateMessageSignature(msg); err != nil {
+			m.nodeLogError(typesCons.ErrHotstuffValidation.Error(), err)
+			return
+		}
+		if err := m.detectDoubleSign(msg); err != nil {
+			m.nodeLogError(typesCons.ErrHotstuffValidation.Error(), err)
+			return
+		}
+		m.nodeLog(typesCons.WarnDiscardHotstuffMessage(msg, "chained vote is stale"))
+		return
+	}
+
+	if err := chainedLeaderHandler.anteHandle(m, msg); err != nil {
+		m.nodeLogError(typesCons.ErrHotstuffValidation.Error(), err)
+		return
+	}
+
+	qc, err := m.getChainedQuorumCertificate(msg)
+	if err != nil {
+		m.nodeLog(typesCons.OptimisticVoteCountWaiting(Prepare, err.Error()))
+		return
+	}
+	m.nodeLog(typesCons.OptimisticVoteCountPassed(msg.GetHeight(), Prepare, msg.GetRound()))
+
+	m.processChainedQC(qc)
+
+	// The QC justifies the proposal of this node in the first round of the next height
+	if m.height == qc.GetHeight()+1 && m.round == 0 && m.step == NewRound && m.IsLeader() {
+		m.proposeChainedBlock(qc)
+	}
+}
+
+// adoptChainedQC processes the QC carried by a message if it certifies a block at the height of the node or later,
+// so the node moves to the height after the certified block even if it missed its proposal
+func (m *consensusModule) adoptChainedQC(qc *typesCons.QuorumCertificate) {
+	if qc == nil || qc.GetHeight() < m.height {
+		return
+	}
+	if err := m.validateChainedQC(qc); err != nil {
+		m.nodeLogError(typesCons.ErrQCInvalid(qc.GetStep()).Error(), err)
+		return
+	}
+	m.processChainedQC(qc)
+}
+
+// handleChainedMessage handles the messages of the current view once they passed the pacemaker checks
+func (m *consensusModule) handleChainedMessage(msg *typesCons.HotstuffMessage) {
+	switch msg.GetStep() {
+	case NewRound:
+		m.handleChainedNewRound(msg)
+	case Prepare:
+		m.handleChainedProposal(msg)
+	default:
+		m.nodeLog(typesCons.WarnDiscardHotstuffMessage(msg, "chained HotStuff only has NEWROUND and PREPARE messages"))
+	}
+}
+
+func (m *consensusModule) handleChainedNewRound(msg *typesCons.HotstuffMessage) {
+	defer m.paceMaker.RestartTimer()
+
+	if m.isReplica() {
+		chainedReplicaHandler.emitTelemetryEvent(m, msg)
+		m.step = Prepare
+		return
+	}
+	chainedLeaderHandler.emitTelemetryEvent(m, msg)
+
+	if err := chainedLeaderHandler.anteHandle(m, msg); err != nil {
+		m.nodeLogError(typesCons.ErrHotstuffValidation.Error(), err)
+		return
+	}
+
+	if err := m.didReceiveEnoughMessageForStep(NewRound); err != nil {
+		m.nodeLog(typesCons.OptimisticVoteCountWaiting(NewRound, err.Error()))
+		return
+	}
+	m.nodeLog(typesCons.OptimisticVoteCountPassed(m.height, NewRound, m.round))
+
+	// The highQCs of the other validators are only used if they are from a later view than the one of this node
+	highQC := m.prepareQC
+	for _, newRoundMsg := range m.messagePool[NewRound] {
+		qc := newRoundMsg.GetQuorumCertificate()
+		if qc == nil || (highQC != nil && !isLaterView(qc, highQC)) {
+			continue
+		}
+		if err := m.validateChainedQC(qc); err != nil {
+			m.nodeLogError(typesCons.ErrQCInvalid(qc.GetStep()).Error(), err)
+			continue
+		}
+		highQC = qc
+	}
+	m.messagePool[NewRound] = nil
+
+	if highQC != nil && highQC != m.prepareQC {
+		m.processChainedQC(highQC)
+	}
+	// The QC moved the node past the view of the round
+	if m.step != NewRound {
+		return
+	}
+
+	m.proposeChainedBlock(highQC)
+}
+
+func (m *consensusModule) handleChainedProposal(msg *typesCons.HotstuffMessage) {
+	defer m.paceMaker.RestartTimer()
+
+	if m.IsLeader() || msg.GetType() != Propose {
+		return
+	}
+	chainedReplicaHandler.emitTelemetryEvent(m, msg)
+
+	if err := chainedReplicaHandler.anteHandle(m, msg); err != nil {
+		m.nodeLogError(typesCons.ErrHotstuffValidation.Error(), err)
+		return
+	}
+
+	if err := m.validateChainedProposal(msg); err != nil {
+		m.nodeLogError(fmt.Sprintf("Invalid proposal in %s message", Prepare), err)
+		m.paceMaker.InterruptRound("invalid proposal")
+		return
+	}
+
+	m.block = msg.GetBlock()
+	m.sendChainedVote()
+
+	if justifyQC := msg.GetQuorumCertificate(); justifyQC != nil {
+		m.processChainedQC(justifyQC)
+	}
+}
+
+/*** Helpers ***/
+
+// processChainedQC updates the state of the node with a valid QC: the QC becomes the highQC if it is from a later
+// view, the node moves to the height after the certified block, locks on the two-chain ending with the QC and commits
+// the blocks of the three-chain ending with it
+func (m *consensusModule) processChainedQC(qc *typesCons.QuorumCertificate) {
+	if qc.GetHeight() <= m.chained.committedHeight {
+		return
+	}
+	m.chained.addQC(qc)
+
+	if m.prepareQC == nil || isLaterView(qc, m.prepareQC) {
+		if err := m.setPrepareQC(qc); err != nil {
+			m.nodeLogError("Could not write the QC to the write-ahead log", err)
+			return
+		}
+	}
+	if qc.GetHeight() >= m.height {
+		m.advanceChainedHeight(qc.GetHeight() + 1)
+	}
+
+	if parentQC := m.chained.getParentQC(qc.GetBlock()); parentQC != nil && (m.lockedQC == nil || isLaterView(parentQC, m.lockedQC)) {
+		if err := m.setLockedQC(parentQC); err != nil {
+			m.nodeLogError("Could not write the QC to the write-ahead log", err)
+			return
+		}
+	}
+
+	if committedQC := m.chained.getThreeChainQC(qc); committedQC != nil {
+		if err := m.commitChainedBlocks(committedQC); err != nil {
+			m.nodeLogError(typesCons.ErrCommitBlock.Error(), err)
+		}
+	}
+}
+
+// advanceChainedHeight moves the node to the first round of a height without waiting for the blocks of the previous
+// heights to be committed
+func (m *consensusModule) advanceChainedHeight(height uint64) {
+	m.nodeLog(typesCons.PacemakerNewHeight(height))
+	m.height = height
+	m.round = 0
+	m.step = NewRound
+	m.block = nil
+	m.clearLeader()
+	m.messagePool[NewRound] = nil
+	m.clearTimeoutPool()
+
+	// The leader is known before its proposal since the replicas send it their votes for the previous height
+	if err := m.electNextLeader(&typesCons.HotstuffMessage{Height: height, Round: 0, Step: NewRound}); err != nil {
+		return
+	}
+	m.paceMaker.RestartTimer()
+}
+
+// getChainedVoteLeaderId returns the leader of the first round of the height after `height`, which aggregates the
+// votes for the blocks at `height`
+func (m *consensusModule) getChainedVoteLeaderId(height uint64) (typesCons.NodeId, error) {
+	return m.leaderElectionMod.ElectNextLeader(&typesCons.HotstuffMessage{Height: height + 1, Round: 0, Step: NewRound})
+}
+
+// getChainedQuorumCertificate aggregates the votes for the block of `vote` in its view. Unlike basic HotStuff, the
+// leader aggregating the votes may not have seen the proposal of the block.
+func (m *consensusModule) getChainedQuorumCertificate(vote *typesCons.HotstuffMessage) (*typesCons.QuorumCertificate, error) {
+	blockHash := GetBlockHash(vote.GetBlock())
+	signers := make(map[string]struct{})
+	var pss []*typesCons.PartialSignature
+	for _, msg := range m.messagePool[Prepare] {
+		ps := msg.GetPartialSignature()
+		if ps == nil || msg.GetHeight() != vote.GetHeight() || msg.GetRound() != vote.GetRound() {
+			continue
+		}
+		if _, ok := signers[ps.GetAddress()]; ok || GetBlockHash(msg.GetBlock()) != blockHash {
+			continue
+		}
+		signers[ps.GetAddress()] = struct{}{}
+		pss = append(pss, ps)
+	}
+
+	validators, err := m.getValidatorsAtHeight(vote.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.isQuorumMet(getPartialSignatureSigners(pss), validators); err != nil {
+		return nil, err
+	}
+
+	thresholdSig, err := getThresholdSignature(m.consCfg.GetThresholdSignatureScheme(), pss, validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesCons.QuorumCertificate{
+		Height:             vote.GetHeight(),
+		Step:               Prepare,
+		Round:              vote.GetRound(),
+		Block:              vote.GetBlock(),
+		ThresholdSignature: thresholdSig,
+	}, nil
+}
+
+// pruneChainedVotes drops the votes up to the committed height; the votes for later heights are kept to detect double
+// signs or arrived before their QC
+func (m *consensusModule) pruneChainedVotes(height uint64) {
+	votes := make([]*typesCons.HotstuffMessage, 0)
+	for _, msg := range m.messagePool[Prepare] {
+		if msg.GetHeight() > height {
+			votes = append(votes, msg)
+		}
+	}
+	m.messagePool[Prepare] = votes
+}
+
+// proposeChainedBlock proposes a block extending the block certified by the highQC, or the last committed block if
+// there is none, and votes for it like a replica
+func (m *consensusModule) proposeChainedBlock(highQC *typesCons.QuorumCertificate) {
+	block, err := m.prepareChainedBlock(highQC)
+	if err != nil {
+		m.nodeLogError(typesCons.ErrPrepareBlock.Error(), err)
+		m.paceMaker.InterruptRound("failed to prepare block")
+		return
+	}
+	m.block = block
+	m.step = Prepare
+
+	proposeMessage, err := CreateProposeMessage(m.height, m.round, Prepare, block, highQC)
+	if err != nil {
+		m.nodeLogError(typesCons.ErrCreateProposeMessage(Prepare).Error(), err)
+		m.paceMaker.InterruptRound("failed to create propose message")
+		return
+	}
+	m.broadcastToValidators(proposeMessage)
+
+	// Leader also acts like a replica
+	m.sendChainedVote()
+}
+
+// prepareChainedBlock reaps the mempool for a block that is applied once it is committed
+func (m *consensusModule) prepareChainedBlock(highQC *typesCons.QuorumCertificate) (*coreTypes.Block, error) {
+	if m.isReplica() {
+		return nil, typesCons.ErrReplicaPrepareBlock
+	}
+
+	parentHash := ""
+	if highQC != nil {
+		parentHash = GetBlockHash(highQC.GetBlock())
+	}
+	parentTime, err := m.getChainedParentBlockTime(highQC)
+	if err != nil {
+		return nil, err
+	}
+
+	// TECHDEBT: Retrieve this from consensus consensus config
+	maxTxBytes := 90000
+
+	// The transactions of the uncommitted ancestors are applied before the block
+	txs, err := m.GetBus().GetUtilityModule().ReapMempool(maxTxBytes, m.chained.getUncommittedTransactions(parentHash))
+	if err != nil {
+		return nil, err
+	}
+
+	// Propose the double sign evidence detected by this node, unless an uncommitted ancestor already carries it
+	uncommittedEvidence := m.chained.getUncommittedEvidence(parentHash)
+	evidence := make([]*coreTypes.DoubleSignEvidence, 0, len(m.evidencePool))
+	for _, pooledEvidence := range m.evidencePool {
+		if _, ok := uncommittedEvidence[pooledEvidence.Hash()]; !ok {
+			evidence = append(evidence, pooledEvidence)
+		}
+	}
+
+	block := &coreTypes.Block{
+		BlockHeader: &coreTypes.BlockHeader{
+			Height:          m.height,
+			NumTxs:          uint32(len(txs)),
+			ProposerAddress: m.privateKey.Address().Bytes(),
+			ParentHash:      parentHash,
+			Time:            m.getBlockTime(parentTime),
+		},
+		Transactions: txs,
+		Evidence:     evidence,
+	}
+	if _, err := m.setBlockValidatorSet(block); err != nil {
+		return nil, err
+	}
+
+	if err := m.validateChainedBlock(block, highQC); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// sendChainedVote votes for the current block and sends the vote to the leader aggregating the votes of the height
+func (m *consensusModule) sendChainedVote() {
+	voteMessage, err := m.createVoteMessage(Prepare)
+	if err != nil {
+		m.nodeLogError(typesCons.ErrCreateVoteMessage(Prepare).Error(), err)
+		return // Not interrupting the round because liveness could continue with one failed vote
+	}
+	m.step = PreCommit
+
+	leaderId, err := m.getChainedVoteLeaderId(m.height)
+	if err != nil {
+		m.nodeLogError(typesCons.ErrLeaderElection(voteMessage).Error(), err)
+		return
+	}
+	m.sendToNode(voteMessage, leaderId)
+}
+
+func (m *consensusModule) validateChainedProposal(msg *typesCons.HotstuffMessage) error {
+	if err := m.validateChainedProposer(msg); err != nil {
+		return err
+	}
+
+	block := msg.GetBlock()
+	justifyQC := msg.GetQuorumCertificate()
+	if justifyQC != nil {
+		if err := m.validateChainedQC(justifyQC); err != nil {
+			return err
+		}
+		m.chained.addQC(justifyQC)
+	}
+	if err := m.validateChainedBlock(block, justifyQC); err != nil {
+		return err
+	}
+
+	// Safety: not locked
+	if m.lockedQC == nil {
+		m.nodeLog(typesCons.NotLockedOnQC)
+		return nil
+	}
+
+	// Safety: the block extends the locked block
+	if m.chained.extends(block, m.lockedQC.GetBlock()) {
+		m.nodeLog(typesCons.ProposalBlockExtends)
+		return nil
+	}
+
+	// Liveness: the QC is from a later view than the locked QC, so the locked block cannot have been committed
+	if justifyQC != nil && isLaterView(justifyQC, m.lockedQC) {
+		return nil
+	}
+
+	return typesCons.ErrUnsafeChainedProposal
+}
+
+// validateChainedProposer checks that the proposer leads the round. Chained proposals may reach replicas that did not
+// elect the leader of the round at the NEWROUND step, so the leader is elected from the view of the proposal.
+func (m *consensusModule) validateChainedProposer(msg *typesCons.HotstuffMessage) error {
+	leaderId, err := m.leaderElectionMod.ElectNextLeader(&typesCons.HotstuffMessage{Height: msg.GetHeight(), Round: msg.GetRound(), Step: NewRound})
+	if err != nil {
+		return err
+	}
+
+	validators, err := m.getValidatorsAtHeight(msg.GetHeight())
+	if err != nil {
+		return err
+	}
+	idToValAddrMap := typesCons.NewActorMapper(validators).GetIdToValAddrMap()
+
+	proposerAddress := cryptoPocket.Address(msg.GetBlock().GetBlockHeader().GetProposerAddress()).String()
+	if idToValAddrMap[leaderId] != proposerAddress {
+		return typesCons.ErrUnexpectedProposer(proposerAddress, msg.GetHeight(), msg.GetRound())
+	}
+
+	if m.leaderId == nil || *m.leaderId != leaderId {
+		return m.setLeader(leaderId)
+	}
+	return nil
+}
+
+// validateChainedQC validates the QC of a block; chained QCs aggregate the PREPARE votes for a block at their height
+func (m *consensusModule) validateChainedQC(qc *typesCons.QuorumCertificate) error {
+	if err := m.validateQuorumCertificate(qc); err != nil {
+		return err
+	}
+	if qc.GetStep() != Prepare || qc.GetHeight() != qc.GetBlock().GetBlockHeader().GetHeight() {
+		return typesCons.ErrQCInvalid(qc.GetStep())
+	}
+	return nil
+}
+
+// validateChainedBlock checks that the block extends the block certified by its justify QC, or the last committed
+// block if the proposal does not carry a QC, that its time does not go back compared to its parent, that it commits the
+// validator set of its epoch, and that its transactions and evidence can be proposed
+func (m *consensusModule) validateChainedBlock(block *coreTypes.Block, justifyQC *typesCons.QuorumCertificate) error {
+	blockHeader := block.GetBlockHeader()
+	if justifyQC == nil {
+		if blockHeader.GetHeight() != m.chained.committedHeight+1 || blockHeader.GetParentHash() != "" {
+			return typesCons.ErrInvalidChainedProposal("a proposal without a QC must extend the last committed block")
+		}
+	} else if blockHeader.GetHeight() != justifyQC.GetHeight()+1 || blockHeader.GetParentHash() != GetBlockHash(justifyQC.GetBlock()) {
+		return typesCons.ErrInvalidChainedProposal("the block does not extend the block certified by its QC")
+	}
+
+	parentTime, err := m.getChainedParentBlockTime(justifyQC)
+	if err != nil {
+		return err
+	}
+	if err := m.validateBlockTime(block, parentTime); err != nil {
+		return err
+	}
+	if err := m.validateBlockValidatorSet(block); err != nil {
+		return err
+	}
+	return m.validateChainedBlockContents(block)
+}
+
+// validateChainedBlockContents checks the transactions and evidence of a block against the committed state, since the
+// block is only applied once it is committed, and that they are not in the block or its uncommitted ancestors already
+func (m *consensusModule) validateChainedBlockContents(block *coreTypes.Block) error {
+	blockHeader := block.GetBlockHeader()
+	if blockHeader.GetNumTxs() != uint32(len(block.GetTransactions())) {
+		return typesCons.ErrInvalidChainedProposal("the number of transactions does not match the header")
+	}
+	uncommittedTxs := m.chained.getUncommittedTransactions(blockHeader.GetParentHash())
+	if err := m.GetBus().GetUtilityModule().ValidateProposalTransactions(block.GetTransactions(), uncommittedTxs); err != nil {
+		return err
+	}
+
+	uncommittedEvidence := m.chained.getUncommittedEvidence(blockHeader.GetParentHash())
+	for _, evidence := range block.GetEvidence() {
+		if err := validateDoubleSignEvidence(evidence); err != nil {
+			return err
+		}
+		if evidence.GetHeight() >= blockHeader.GetHeight() {
+			return typesCons.ErrInvalidChainedProposal("the evidence is not from a previous height")
+		}
+		if _, ok := uncommittedEvidence[evidence.Hash()]; ok {
+			return typesCons.ErrInvalidChainedProposal("the evidence is already in the block or its uncommitted ancestors")
+		}
+		uncommittedEvidence[evidence.Hash()] = struct{}{}
+	}
+	return nil
+}
+
+// getChainedParentBlockTime returns the time of the block certified by the QC, or of the last committed block if
+// there is no QC
+func (m *consensusModule) getChainedParentBlockTime(justifyQC *typesCons.QuorumCertificate) (time.Time, error) {
+	if justifyQC == nil {
+		return m.getCommittedBlockTime(m.chained.committedHeight)
+	}
+	return justifyQC.GetBlock().GetBlockHeader().GetTime().AsTime(), nil
+}
+
+// commitChainedBlocks commits the block certified by the QC and its uncommitted ancestors. A node that did not see
+// the QCs of all the ancestors syncs the missing blocks from its peers.
+func (m *consensusModule) commitChainedBlocks(qc *typesCons.QuorumCertificate) error {
+	chain, err := m.chained.getUncommittedChain(qc)
+	if err != nil {
+		if syncErr := m.stateSync.TriggerSync(); syncErr != nil {
+			m.nodeLogError("Failed to trigger state sync", syncErr)
+		}
+		return err
+	}
+	for _, blockQC := range chain {
+		if err := m.commitChainedBlock(blockQC); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitChainedBlock applies the block certified by the QC to the state and commits it. The QC is stored in the
+// header of the committed block, like the commit QC of basic HotStuff.
+func (m *consensusModule) commitChainedBlock(qc *typesCons.QuorumCertificate) error {
+	block := qc.GetBlock()
+	height := qc.GetHeight()
+
+	if err := m.refreshUtilityContextAtHeight(height); err != nil {
+		return err
+	}
+	if err := m.applyBlock(block); err != nil {
+		if releaseErr := m.ReleaseUtilityContext(); releaseErr != nil {
+			m.nodeLogError("Failed to release utility context", releaseErr)
+		}
+		return err
+	}
+	if err := m.commitBlock(block, qc); err != nil {
+		return err
+	}
+	m.chained.prune(height)
+	m.pruneChainedVotes(height)
+	m.refreshNodeId()
+
+	m.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		CounterIncrement(
+			consensusTelemetry.CONSENSUS_BLOCKCHAIN_HEIGHT_COUNTER_NAME,
+		)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package consensus
+
+import (
+	"testing"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainedState_ThreeChainCommit(t *testing.T) {
+	state := newChainedState()
+	qc1 := newTestingChainedQC(nil, 1, 0, "tx1")
+	qc2 := newTestingChainedQC(qc1, 2, 0, "tx2")
+	qc3 := newTestingChainedQC(qc2, 3, 0, "tx3")
+	for _, qc := range []*typesCons.QuorumCertificate{qc1, qc2, qc3} {
+		state.addQC(qc)
+	}
+
+	// The QC of the parent is the two-chain lock, and the QC of the grandparent is committed
+	require.Equal(t, qc1, state.getParentQC(qc2.GetBlock()))
+	require.Nil(t, state.getThreeChainQC(qc2))
+	require.Equal(t, qc1, state.getThreeChainQC(qc3))
+
+	chain, err := state.getUncommittedChain(qc2)
+	require.NoError(t, err)
+	require.Equal(t, []*typesCons.QuorumCertificate{qc1, qc2}, chain)
+
+	state.prune(1)
+	require.Nil(t, state.getParentQC(qc2.GetBlock()))
+	chain, err = state.getUncommittedChain(qc3)
+	require.NoError(t, err)
+	require.Equal(t, []*typesCons.QuorumCertificate{qc2, qc3}, chain)
+}
+
+func TestChainedState_NonConsecutiveViewsDoNotCommit(t *testing.T) {
+	state := newChainedState()
+	qc1 := newTestingChainedQC(nil, 1, 0, "tx1")
+	qc2 := newTestingChainedQC(qc1, 2, 1, "tx2") // The first round of height 2 timed out
+	qc3 := newTestingChainedQC(qc2, 3, 0, "tx3")
+	qc4 := newTestingChainedQC(qc3, 4, 0, "tx4")
+	for _, qc := range []*typesCons.QuorumCertificate{qc1, qc2, qc3, qc4} {
+		state.addQC(qc)
+	}
+
+	require.Nil(t, state.getThreeChainQC(qc3))
+	require.Equal(t, qc2, state.getThreeChainQC(qc4))
+
+	// Committing block 2 commits its uncommitted parent as well
+	chain, err := state.getUncommittedChain(qc2)
+	require.NoError(t, err)
+	require.Equal(t, []*typesCons.QuorumCertificate{qc1, qc2}, chain)
+}
+
+func TestChainedState_MissingAncestor(t *testing.T) {
+	state := newChainedState()
+	qc1 := newTestingChainedQC(nil, 1, 0, "tx1")
+	qc2 := newTestingChainedQC(qc1, 2, 0, "tx2")
+	state.addQC(qc2)
+
+	_, err := state.getUncommittedChain(qc2)
+	require.EqualError(t, err, typesCons.ErrMissingChainedBlock(1).Error())
+}
+
+func TestChainedState_Extends(t *testing.T) {
+	state := newChainedState()
+	qc1 := newTestingChainedQC(nil, 1, 0, "tx1")
+	qc2 := newTestingChainedQC(qc1, 2, 0, "tx2")
+	forkQC2 := newTestingChainedQC(qc1, 2, 1, "fork_tx2")
+	for _, qc := range []*typesCons.QuorumCertificate{qc1, qc2, forkQC2} {
+		state.addQC(qc)
+	}
+
+	block3 := newTestingChainedQC(qc2, 3, 0, "tx3").GetBlock()
+	require.True(t, state.extends(block3, qc2.GetBlock()))
+	require.True(t, state.extends(block3, qc1.GetBlock()))
+	require.False(t, state.extends(block3, forkQC2.GetBlock()))
+
+	// Every block extends the committed blocks
+	state.prune(1)
+	require.True(t, state.extends(block3, qc1.GetBlock()))
+}
+
+func TestChainedState_UncommittedTransactions(t *testing.T) {
+	state := newChainedState()
+	qc1 := newTestingChainedQC(nil, 1, 0, "tx1")
+	qc2 := newTestingChainedQC(qc1, 2, 0, "tx2")
+	forkQC2 := newTestingChainedQC(qc1, 2, 1, "fork_tx2")
+	for _, qc := range []*typesCons.QuorumCertificate{qc1, qc2, forkQC2} {
+		state.addQC(qc)
+	}
+
+	txs := state.getUncommittedTransactions(GetBlockHash(qc2.GetBlock()))
+	require.Equal(t, map[string]struct{}{
+		cryptoPocket.GetHashStringFromBytes([]byte("tx1")): {},
+		cryptoPocket.GetHashStringFromBytes([]byte("tx2")): {},
+	}, txs)
+	require.Empty(t, state.getUncommittedTransactions(""))
+}
+
+func TestChainedState_UncommittedEvidence(t *testing.T) {
+	state := newChainedState()
+	evidence := &coreTypes.DoubleSignEvidence{PublicKey: []byte("double_signer"), Height: 1}
+	qc1 := newTestingChainedQC(nil, 1, 0, "tx1")
+	qc2 := newTestingChainedQC(qc1, 2, 0, "tx2")
+	qc2.Block.Evidence = []*coreTypes.DoubleSignEvidence{evidence}
+	for _, qc := range []*typesCons.QuorumCertificate{qc1, qc2} {
+		state.addQC(qc)
+	}
+
+	require.Equal(t, map[string]struct{}{evidence.Hash(): {}}, state.getUncommittedEvidence(GetBlockHash(qc2.GetBlock())))
+	require.Empty(t, state.getUncommittedEvidence(GetBlockHash(qc1.GetBlock())))
+
+	// Utility remembers the evidence handled by the committed blocks
+	state.prune(2)
+	require.Empty(t, state.getUncommittedEvidence(GetBlockHash(qc2.GetBlock())))
+}
+
+// newTestingChainedQC returns a QC, without signatures, for a block extending the block certified by `parentQC`
+func newTestingChainedQC(parentQC *typesCons.QuorumCertificate, height, round uint64, tx string) *typesCons.QuorumCertificate {
+	parentHash := ""
+	if parentQC != nil {
+		parentHash = GetBlockHash(parentQC.GetBlock())
+	}
+	return &typesCons.QuorumCertificate{
+		Height: height,
+		Round:  round,
+		Step:   Prepare,
+		Block: &coreTypes.Block{
+			BlockHeader: &coreTypes.BlockHeader{
+				Height:     height,
+				NumTxs:     1,
+				ParentHash: parentHash,
+			},
+			Transactions: [][]byte{[]byte(tx)},
+		},
+	}
+}
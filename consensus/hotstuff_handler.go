@@ -17,6 +17,34 @@ func (m *consensusModule) handleHotstuffMessage(msg *typesCons.HotstuffMessage)
 	step := msg.GetStep()
 
 	m.nodeLog(typesCons.DebugReceivedHandlingHotstuffMessage(msg))
+
+	// Timeouts are aggregated by every validator, whatever the step it is at
+	if msg.GetType() == Timeout {
+		m.handleTimeoutMessage(msg)
+		return nil
+	}
+
+	// Chained HotStuff - Votes are aggregated by the leader of the next height, and QCs move nodes to the height
+	// after the block they certify, before the pacemaker compares the message with the view of the node
+	if m.isChainedHotstuff() {
+		if msg.GetType() == Vote {
+			m.handleChainedVote(msg)
+			return nil
+		}
+		m.adoptChainedQC(msg.GetQuorumCertificate())
+	}
+
+	// A timeout certificate moves the node to the next round before the pacemaker compares the message with its view
+	if tc := msg.GetTimeoutCertificate(); tc != nil {
+		m.adoptTimeoutCertificate(tc)
+	}
+
+	// The network is ahead of this node, which needs to catch up via state sync before participating again
+	if msg.GetHeight() > m.CurrentHeight() {
+		if err := m.stateSync.TriggerSync(); err != nil {
+			m.nodeLogError("Failed to trigger state sync", err)
+		}
+	}
 	// Pacemaker - Liveness & safety checks
 	if shouldHandle, err := m.paceMaker.ShouldHandleMessage(msg); !shouldHandle {
 		return err
@@ -30,6 +58,11 @@ func (m *consensusModule) handleHotstuffMessage(msg *typesCons.HotstuffMessage)
 		}
 	}
 
+	if m.isChainedHotstuff() {
+		m.handleChainedMessage(msg)
+		return nil
+	}
+
 	// Hotstuff - Handle message as a replica
 	if m.isReplica() {
 		replicaHandlers[step](m, msg)
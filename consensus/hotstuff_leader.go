@@ -5,6 +5,7 @@ import (
 
 	consensusTelemetry "github.com/pokt-network/pocket/consensus/telemetry"
 	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 )
@@ -35,27 +36,43 @@ func (handler *HotstuffLeaderMessageHandler) HandleNewRoundMessage(m *consensusM
 		return
 	}
 
-	// DISCUSS: Do we need to pause for `MinBlockFreqMSec` here to let more transactions or should we stick with optimistic responsiveness?
-
 	if err := m.didReceiveEnoughMessageForStep(NewRound); err != nil {
 		m.nodeLog(typesCons.OptimisticVoteCountWaiting(NewRound, err.Error()))
 		return
 	}
 	m.nodeLog(typesCons.OptimisticVoteCountPassed(m.height, NewRound, m.round))
 
+	handler.proposeBlock(m)
+}
+
+// proposeBlock proposes the block of the current round once enough NEWROUND messages were received. A new block may
+// be deferred to meet the target and empty block intervals (see `getProposalDelay`).
+func (handler *HotstuffLeaderMessageHandler) proposeBlock(m *consensusModule) {
+	// Likely to be `nil` if blockchain is progressing well.
+	// TECHDEBT: How do we properly validate `prepareQC` here?
+	highPrepareQC := m.findHighQC(m.messagePool[NewRound])
+	prepareNewBlock := m.shouldPrepareNewBlock(highPrepareQC)
+
+	if prepareNewBlock {
+		delay, err := m.getProposalDelay()
+		if err != nil {
+			m.nodeLogError(typesCons.ErrProposalDelay.Error(), err)
+		} else if delay > 0 {
+			m.nodeLog(typesCons.DelayingProposal(m.height, m.round, delay))
+			m.scheduleProposal(handler, delay)
+			return
+		}
+	}
+
 	// Clear the previous utility context, if it exists, and create a new one
 	if err := m.refreshUtilityContext(); err != nil {
 		m.nodeLogError("Could not refresh utility context", err)
 		return
 	}
 
-	// Likely to be `nil` if blockchain is progressing well.
-	// TECHDEBT: How do we properly validate `prepareQC` here?
-	highPrepareQC := m.findHighQC(m.messagePool[NewRound])
-
 	// TODO: Add test to make sure same block is not applied twice if round is interrupted after being 'Applied'.
 	// TODO: Add more unit tests for these checks...
-	if m.shouldPrepareNewBlock(highPrepareQC) {
+	if prepareNewBlock {
 		block, err := m.prepareAndApplyBlock(highPrepareQC)
 		if err != nil {
 			m.nodeLogError(typesCons.ErrPrepareBlock.Error(), err)
@@ -83,10 +100,18 @@ func (handler *HotstuffLeaderMessageHandler) HandleNewRoundMessage(m *consensusM
 		m.paceMaker.InterruptRound("failed to create propose message")
 		return
 	}
+	// With VRF sortition, replicas only accept the leader once they verified its eligibility
+	leaderElectionProof, err := m.leaderElectionMod.GetLeaderElectionProof(prepareProposeMessage)
+	if err != nil {
+		m.nodeLogError(typesCons.ErrLeaderElection(prepareProposeMessage).Error(), err)
+		m.paceMaker.InterruptRound("failed to prove leader eligibility")
+		return
+	}
+	prepareProposeMessage.LeaderElectionProof = leaderElectionProof
 	m.broadcastToValidators(prepareProposeMessage)
 
 	// Leader also acts like a replica
-	prepareVoteMessage, err := CreateVoteMessage(m.height, m.round, Prepare, m.block, m.privateKey)
+	prepareVoteMessage, err := m.createVoteMessage(Prepare)
 	if err != nil {
 		m.nodeLogError(typesCons.ErrCreateVoteMessage(Prepare).Error(), err)
 		return
@@ -117,8 +142,11 @@ func (handler *HotstuffLeaderMessageHandler) HandlePrepareMessage(m *consensusMo
 		return // TODO(olshansky): Should we interrupt the round here?
 	}
 
+	if err := m.setPrepareQC(prepareQC); err != nil {
+		m.nodeLogError("Could not write the QC to the write-ahead log", err)
+		return
+	}
 	m.step = PreCommit
-	m.prepareQC = prepareQC
 	m.messagePool[Prepare] = nil
 
 	preCommitProposeMessage, err := CreateProposeMessage(m.height, m.round, PreCommit, m.block, prepareQC)
@@ -130,7 +158,7 @@ func (handler *HotstuffLeaderMessageHandler) HandlePrepareMessage(m *consensusMo
 	m.broadcastToValidators(preCommitProposeMessage)
 
 	// Leader also acts like a replica
-	precommitVoteMessage, err := CreateVoteMessage(m.height, m.round, PreCommit, m.block, m.privateKey)
+	precommitVoteMessage, err := m.createVoteMessage(PreCommit)
 	if err != nil {
 		m.nodeLogError(typesCons.ErrCreateVoteMessage(PreCommit).Error(), err)
 		return
@@ -161,8 +189,11 @@ func (handler *HotstuffLeaderMessageHandler) HandlePrecommitMessage(m *consensus
 		return // TODO(olshansky): Should we interrupt the round here?
 	}
 
+	if err := m.setLockedQC(preCommitQC); err != nil {
+		m.nodeLogError("Could not write the QC to the write-ahead log", err)
+		return
+	}
 	m.step = Commit
-	m.lockedQC = preCommitQC
 	m.messagePool[PreCommit] = nil
 
 	commitProposeMessage, err := CreateProposeMessage(m.height, m.round, Commit, m.block, preCommitQC)
@@ -174,7 +205,7 @@ func (handler *HotstuffLeaderMessageHandler) HandlePrecommitMessage(m *consensus
 	m.broadcastToValidators(commitProposeMessage)
 
 	// Leader also acts like a replica
-	commitVoteMessage, err := CreateVoteMessage(m.height, m.round, Commit, m.block, m.privateKey)
+	commitVoteMessage, err := m.createVoteMessage(Commit)
 	if err != nil {
 		m.nodeLogError(typesCons.ErrCreateVoteMessage(Commit).Error(), err)
 		return
@@ -216,7 +247,7 @@ func (handler *HotstuffLeaderMessageHandler) HandleCommitMessage(m *consensusMod
 	}
 	m.broadcastToValidators(decideProposeMessage)
 
-	if err := m.commitBlock(m.block); err != nil {
+	if err := m.commitBlock(m.block, commitQC); err != nil {
 		m.nodeLogError(typesCons.ErrCommitBlock.Error(), err)
 		m.paceMaker.InterruptRound("failed to commit block")
 		return
@@ -224,6 +255,7 @@ func (handler *HotstuffLeaderMessageHandler) HandleCommitMessage(m *consensusMod
 
 	// There is no "replica behavior" to imitate here because the leader already committed the block proposal.
 
+	m.delayNewRoundTimeout(m.block)
 	m.paceMaker.NewHeight()
 	m.GetBus().
 		GetTelemetryModule().
@@ -245,14 +277,19 @@ func (handler *HotstuffLeaderMessageHandler) HandleDecideMessage(m *consensusMod
 
 // anteHandle is the general handler called for every before every specific HotstuffLeaderMessageHandler handler
 func (handler *HotstuffLeaderMessageHandler) anteHandle(m *consensusModule, msg *typesCons.HotstuffMessage) error {
-	// Basic block metadata validation
+	// Discard messages with invalid partial signatures before storing it in the leader's consensus mempool
+	if err := m.validateMessageSignature(msg); err != nil {
+		return err
+	}
 
-	if valid, err := m.isValidMessageBlock(msg); !valid {
+	// A validly signed vote for a different block than an indexed vote of the same validator is a double sign.
+	// This is checked before the block validation below, which discards votes for other blocks.
+	if err := m.detectDoubleSign(msg); err != nil {
 		return err
 	}
 
-	// Discard messages with invalid partial signatures before storing it in the leader's consensus mempool
-	if err := m.validateMessageSignature(msg); err != nil {
+	// Basic block metadata validation
+	if valid, err := m.isValidMessageBlock(msg); !valid {
 		return err
 	}
 
@@ -282,14 +319,13 @@ func (handler *HotstuffLeaderMessageHandler) emitTelemetryEvent(m *consensusModu
 func (m *consensusModule) validateMessageSignature(msg *typesCons.HotstuffMessage) error {
 	partialSig := msg.GetPartialSignature()
 
+	// The justification of a NEWROUND message is the highQC of the node, so its signature has its own field
 	if msg.GetStep() == NewRound {
 		if partialSig != nil {
 			m.nodeLog(typesCons.ErrUnnecessaryPartialSigForNewRound.Error())
 		}
-		return nil
-	}
-
-	if msg.GetType() == Propose {
+		partialSig = msg.GetNewRoundSignature()
+	} else if msg.GetType() == Propose {
 		if partialSig != nil {
 			m.nodeLog(typesCons.ErrUnnecessaryPartialSigForLeaderProposal.Error())
 		}
@@ -320,12 +356,18 @@ func (m *consensusModule) validateMessageSignature(msg *typesCons.HotstuffMessag
 		return typesCons.ErrMissingValidator(address, valAddrToIdMap[address])
 	}
 	pubKey := validator.GetPublicKey()
-	if isSignatureValid(msg, pubKey, partialSig.GetSignature()) {
-		return nil
+	if !isSignatureValid(msg, pubKey, partialSig.GetSignature()) {
+		m.reportInvalidMessage(msg)
+		return typesCons.ErrValidatingPartialSig(
+			address, valAddrToIdMap[address], msg, pubKey)
+	}
+
+	// NEWROUND messages are only counted towards their own quorum, and are never aggregated into a QC
+	if m.consCfg.GetThresholdSignatureScheme() == configs.ThresholdSignatureScheme_BLSAggregateSignature && msg.GetStep() != NewRound {
+		return m.validateBLSSignature(msg, valAddrToIdMap[address])
 	}
 
-	return typesCons.ErrValidatingPartialSig(
-		address, valAddrToIdMap[address], msg, pubKey)
+	return nil
 }
 
 // TODO(#388): Utilize the shared mempool implementation for consensus messages.
@@ -357,12 +399,40 @@ func (m *consensusModule) prepareAndApplyBlock(qc *typesCons.QuorumCertificate)
 	// TECHDEBT: Retrieve this from consensus consensus config
 	maxTxBytes := 90000
 
+	// The block time never goes back in time compared to the parent block
+	parentTime, err := m.getCommittedBlockTime(m.height - 1)
+	if err != nil {
+		return nil, err
+	}
+	blockTime := m.getBlockTime(parentTime)
+	if err := m.utilityContext.SetProposalBlockTime(blockTime); err != nil {
+		return nil, err
+	}
+
+	// Propose the double sign evidence detected by this node
+	if err := m.utilityContext.SetProposalEvidence(m.evidencePool); err != nil {
+		return nil, err
+	}
+
+	// The validators that did not sign the parent block miss a block
+	missingSigners, err := m.getMissingSigners(m.height - 1)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.utilityContext.SetProposalMissingSigners(missingSigners); err != nil {
+		return nil, err
+	}
+
 	// Reap the mempool for transactions to be applied in this block
 	stateHash, txs, err := m.utilityContext.CreateAndApplyProposalBlock(m.privateKey.Address(), maxTxBytes)
 	if err != nil {
 		return nil, err
 	}
 
+	// The evidence utility dropped (e.g. because it is too old) can never be proposed
+	evidence := m.utilityContext.GetProposalEvidence()
+	m.evidencePool = evidence
+
 	// IMPROVE: This data can be read via an ephemeral read context - no need to use the utility's persistence context
 	prevBlockHash, err := m.utilityContext.GetPersistenceContext().GetBlockHash(int64(m.height) - 1)
 	if err != nil {
@@ -382,10 +452,22 @@ func (m *consensusModule) prepareAndApplyBlock(qc *typesCons.QuorumCertificate)
 		NumTxs:            uint32(len(txs)),
 		ProposerAddress:   m.privateKey.Address().Bytes(),
 		QuorumCertificate: qcBytes,
+		Time:              blockTime,
 	}
 	block := &coreTypes.Block{
 		BlockHeader:  blockHeader,
 		Transactions: txs,
+		Evidence:     evidence,
+	}
+	validatorSet, err := m.setBlockValidatorSet(block)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.validateBlockSize(block); err != nil {
+		return nil, err
+	}
+	if err := m.utilityContext.SetProposalValidatorSet(validatorSet); err != nil {
+		return nil, err
 	}
 
 	// Set the proposal block in the persistence context
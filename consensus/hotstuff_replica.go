@@ -6,6 +6,7 @@ import (
 	consensusTelemetry "github.com/pokt-network/pocket/consensus/telemetry"
 	"github.com/pokt-network/pocket/consensus/types"
 	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 )
 
@@ -55,6 +56,15 @@ func (handler *HotstuffReplicaMessageHandler) HandlePrepareMessage(m *consensusM
 		return
 	}
 
+	// A replica that was not eligible to lead the round with VRF sortition learns the leader from its proposal.
+	// Invalid proofs do not interrupt the round since any validator can send a proposal.
+	if !m.IsLeaderSet() {
+		if err := m.acceptProposer(msg); err != nil {
+			m.nodeLogError(fmt.Sprintf("Invalid leader in %s message", Prepare), err)
+			return
+		}
+	}
+
 	if err := m.validateProposal(msg); err != nil {
 		m.nodeLogError(fmt.Sprintf("Invalid proposal in %s message", Prepare), err)
 		m.paceMaker.InterruptRound("invalid proposal")
@@ -70,7 +80,7 @@ func (handler *HotstuffReplicaMessageHandler) HandlePrepareMessage(m *consensusM
 	m.block = block
 	m.step = PreCommit
 
-	prepareVoteMessage, err := CreateVoteMessage(m.height, m.round, Prepare, m.block, m.privateKey)
+	prepareVoteMessage, err := m.createVoteMessage(Prepare)
 	if err != nil {
 		m.nodeLogError(typesCons.ErrCreateVoteMessage(Prepare).Error(), err)
 		return // Not interrupting the round because liveness could continue with one failed vote
@@ -96,10 +106,14 @@ func (handler *HotstuffReplicaMessageHandler) HandlePrecommitMessage(m *consensu
 		return
 	}
 
+	// INVESTIGATE: Why are we never using this for validation?
+	if err := m.setPrepareQC(quorumCert); err != nil {
+		m.nodeLogError("Could not write the QC to the write-ahead log", err)
+		return // Not voting for a QC that would be forgotten on restart
+	}
 	m.step = Commit
-	m.prepareQC = quorumCert // INVESTIGATE: Why are we never using this for validation?
 
-	preCommitVoteMessage, err := CreateVoteMessage(m.height, m.round, PreCommit, m.block, m.privateKey)
+	preCommitVoteMessage, err := m.createVoteMessage(PreCommit)
 	if err != nil {
 		m.nodeLogError(typesCons.ErrCreateVoteMessage(PreCommit).Error(), err)
 		return // Not interrupting the round because liveness could continue with one failed vote
@@ -125,10 +139,14 @@ func (handler *HotstuffReplicaMessageHandler) HandleCommitMessage(m *consensusMo
 		return
 	}
 
+	// DISCUSS: How does the replica recover if it's locked? Replica `formally` agrees on the QC while the rest of the network `verbally` agrees on the QC.
+	if err := m.setLockedQC(quorumCert); err != nil {
+		m.nodeLogError("Could not write the QC to the write-ahead log", err)
+		return // Not voting for a QC that would be forgotten on restart
+	}
 	m.step = Decide
-	m.lockedQC = quorumCert // DISCUSS: How does the replica recover if it's locked? Replica `formally` agrees on the QC while the rest of the network `verbally` agrees on the QC.
 
-	commitVoteMessage, err := CreateVoteMessage(m.height, m.round, Commit, m.block, m.privateKey)
+	commitVoteMessage, err := m.createVoteMessage(Commit)
 	if err != nil {
 		m.nodeLogError(typesCons.ErrCreateVoteMessage(Commit).Error(), err)
 		return // Not interrupting the round because liveness could continue with one failed vote
@@ -154,12 +172,13 @@ func (handler *HotstuffReplicaMessageHandler) HandleDecideMessage(m *consensusMo
 		return
 	}
 
-	if err := m.commitBlock(m.block); err != nil {
+	if err := m.commitBlock(m.block, quorumCert); err != nil {
 		m.nodeLogError("Could not commit block", err)
 		m.paceMaker.InterruptRound("failed to commit block")
 		return
 	}
 
+	m.delayNewRoundTimeout(m.block)
 	m.paceMaker.NewHeight()
 }
 
@@ -194,6 +213,21 @@ func (m *consensusModule) validateProposal(msg *typesCons.HotstuffMessage) error
 		return typesCons.ErrProposalNotValidInPrepare
 	}
 
+	// The block must not go back in time, nor be too far ahead of the local time
+	parentTime, err := m.getCommittedBlockTime(m.height - 1)
+	if err != nil {
+		return err
+	}
+	if err := m.validateBlockTime(msg.GetBlock(), parentTime); err != nil {
+		return err
+	}
+	if err := m.validateBlockValidatorSet(msg.GetBlock()); err != nil {
+		return err
+	}
+	if err := m.validateBlockSize(msg.GetBlock()); err != nil {
+		return err
+	}
+
 	quorumCert := msg.GetQuorumCertificate()
 	// A nil QC implies a successful CommitQC or TimeoutQC, which have been omitted intentionally
 	// since they are not needed for consensus validity. However, if a QC is specified, it must be valid.
@@ -212,6 +246,11 @@ func (m *consensusModule) validateProposal(msg *typesCons.HotstuffMessage) error
 		return nil
 	}
 
+	// Safety: a proposal without a QC cannot unlock the node
+	if justifyQC == nil {
+		return typesCons.ErrNilJustifyQCWhileLocked
+	}
+
 	// Safety: check the hash of the locked QC
 	// The equivalent of `lockedQC.Block.ExtendsFrom(justifyQC.Block)` in the hotstuff whitepaper is done in `applyBlock` below.
 	if protoHash(lockedQC.GetBlock()) == protoHash(justifyQC.Block) {
@@ -235,6 +274,43 @@ func (m *consensusModule) applyBlock(block *coreTypes.Block) error {
 	if err := m.utilityContext.SetProposalBlock(blockHeader.StateHash, blockHeader.ProposerAddress, block.Transactions); err != nil {
 		return err
 	}
+	if err := m.utilityContext.SetProposalBlockTime(blockHeader.Time); err != nil {
+		return err
+	}
+	validatorSet, err := m.getValidatorSet(blockHeader.Height)
+	if err != nil {
+		return err
+	}
+	if err := m.utilityContext.SetProposalValidatorSet(validatorSet); err != nil {
+		return err
+	}
+
+	// Chained blocks are voted on before they are applied, so the transactions and evidence that cannot be applied
+	// anymore are recorded as failures instead of failing the block on every node
+	if m.isChainedHotstuff() {
+		if err := m.utilityContext.SetProposalRecordFailures(true); err != nil {
+			return err
+		}
+	}
+
+	// The evidence is handled at the beginning of the block, after consensus validated the votes it contains
+	for _, evidence := range block.Evidence {
+		if err := validateDoubleSignEvidence(evidence); err != nil {
+			return err
+		}
+	}
+	if err := m.utilityContext.SetProposalEvidence(block.Evidence); err != nil {
+		return err
+	}
+
+	// The validators that did not sign the parent block miss a block
+	missingSigners, err := m.getMissingSigners(blockHeader.Height - 1)
+	if err != nil {
+		return err
+	}
+	if err := m.utilityContext.SetProposalMissingSigners(missingSigners); err != nil {
+		return err
+	}
 
 	// Apply all the transactions in the block and get the stateHash
 	stateHash, err := m.utilityContext.ApplyBlock()
@@ -242,13 +318,15 @@ func (m *consensusModule) applyBlock(block *coreTypes.Block) error {
 		return err
 	}
 
-	if blockHeader.StateHash != stateHash {
+	// Chained HotStuff applies blocks once they are committed, so their proposals cannot carry their state hash
+	if !m.isChainedHotstuff() && blockHeader.StateHash != stateHash {
 		return typesCons.ErrInvalidAppHash(blockHeader.StateHash, stateHash)
 	}
 
 	return nil
 }
 
+// validateQuorumCertificate checks the QC against the validator set at the height of the block it certifies
 func (m *consensusModule) validateQuorumCertificate(qc *typesCons.QuorumCertificate) error {
 	if qc == nil {
 		return typesCons.ErrNilQC
@@ -258,24 +336,37 @@ func (m *consensusModule) validateQuorumCertificate(qc *typesCons.QuorumCertific
 		return typesCons.ErrNilBlockInQC
 	}
 
-	if qc.ThresholdSignature == nil || len(qc.ThresholdSignature.Signatures) == 0 {
+	if qc.ThresholdSignature == nil {
 		return typesCons.ErrNilThresholdSigInQC
 	}
 
 	msgToJustify := qcToHotstuffMessage(qc)
-	numValid := 0
+	return m.validateThresholdSignature(msgToJustify, qc.ThresholdSignature)
+}
 
-	validators, err := m.getValidatorsAtHeight(m.CurrentHeight())
+// validateThresholdSignature checks that validators holding 2/3+ of the voting power at the height of `msgToJustify`
+// signed it
+func (m *consensusModule) validateThresholdSignature(msgToJustify *typesCons.HotstuffMessage, thresholdSig *typesCons.ThresholdSignature) error {
+	height := msgToJustify.GetHeight()
+	validators, err := m.getValidatorsAtHeight(height)
 	if err != nil {
 		return err
 	}
 
+	if m.consCfg.GetThresholdSignatureScheme() == configs.ThresholdSignatureScheme_BLSAggregateSignature {
+		return m.validateAggregateThresholdSignature(msgToJustify, thresholdSig, validators, height)
+	}
+
+	if len(thresholdSig.GetSignatures()) == 0 {
+		return typesCons.ErrNilThresholdSigInQC
+	}
+	signers := make([]string, 0, len(thresholdSig.GetSignatures()))
+
 	actorMapper := typesCons.NewActorMapper(validators)
 	validatorMap := actorMapper.GetValidatorMap()
 	valAddrToIdMap := actorMapper.GetValAddrToIdMap()
 
-	// TODO(#109): Aggregate signatures once BLS or DKG is implemented
-	for _, partialSig := range qc.ThresholdSignature.Signatures {
+	for _, partialSig := range thresholdSig.GetSignatures() {
 		validator, ok := validatorMap[partialSig.Address]
 		if !ok {
 			m.nodeLogError(typesCons.ErrMissingValidator(partialSig.Address, valAddrToIdMap[partialSig.Address]).Error(), nil)
@@ -287,9 +378,9 @@ func (m *consensusModule) validateQuorumCertificate(qc *typesCons.QuorumCertific
 			m.nodeLog(typesCons.WarnInvalidPartialSigInQC(partialSig.Address, valAddrToIdMap[partialSig.Address]))
 			continue
 		}
-		numValid++
+		signers = append(signers, partialSig.Address)
 	}
-	if err := m.isOptimisticThresholdMet(numValid, validators); err != nil {
+	if err := m.isQuorumMet(signers, validators); err != nil {
 		return err
 	}
 
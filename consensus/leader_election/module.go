@@ -4,18 +4,33 @@ import (
 	"log"
 
 	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 )
 
 type LeaderElectionModule interface {
 	modules.Module
+	// Returns the leader of the round of the message. With VRF sortition, a node can only prove its own
+	// eligibility, so the id of this node is returned if it is eligible and 0 is returned otherwise.
 	ElectNextLeader(*typesCons.HotstuffMessage) (typesCons.NodeId, error)
+	// Returns the proof, carried by the proposals of this node, that it was elected to lead the round of
+	// the message. The proof is nil if the leader is elected by round robin.
+	GetLeaderElectionProof(*typesCons.HotstuffMessage) (*typesCons.LeaderElectionProof, error)
+	// Verifies that the proposer of the block in the message was elected to lead its round and returns its id
+	VerifyLeader(*typesCons.HotstuffMessage) (typesCons.NodeId, error)
 }
 
 var _ LeaderElectionModule = &leaderElectionModule{}
 
 type leaderElectionModule struct {
 	bus modules.Bus
+
+	strategy     configs.LeaderElectionStrategy
+	privateKey   cryptoPocket.PrivateKey // Used to prove the eligibility of this node with VRF sortition
+	epochLength  uint64                  // The leaders of an epoch are elected among the validator set of the epoch
+	hotstuffMode configs.HotstuffMode    // Chained HotStuff snapshots the validator set of an epoch from an earlier state
 }
 
 func Create(bus modules.Bus) (modules.Module, error) {
@@ -25,6 +40,20 @@ func Create(bus modules.Bus) (modules.Module, error) {
 func (*leaderElectionModule) Create(bus modules.Bus) (modules.Module, error) {
 	m := &leaderElectionModule{}
 	bus.RegisterModule(m)
+
+	consensusCfg := bus.GetRuntimeMgr().GetConfig().Consensus
+	m.strategy = consensusCfg.GetLeaderElectionStrategy()
+	m.epochLength = consensusCfg.GetEpochLength()
+	m.hotstuffMode = consensusCfg.GetHotstuffMode()
+
+	if m.strategy == configs.LeaderElectionStrategy_VRFSortition {
+		privateKey, err := cryptoPocket.NewPrivateKey(consensusCfg.GetPrivateKey())
+		if err != nil {
+			return nil, err
+		}
+		m.privateKey = privateKey
+	}
+
 	return m, nil
 }
 
@@ -53,6 +82,10 @@ func (m *leaderElectionModule) GetBus() modules.Bus {
 }
 
 func (m *leaderElectionModule) ElectNextLeader(message *typesCons.HotstuffMessage) (typesCons.NodeId, error) {
+	if m.strategy == configs.LeaderElectionStrategy_VRFSortition {
+		return m.electSelfVRFSortition(message)
+	}
+
 	nodeId, err := m.electNextLeaderDeterministicRoundRobin(message)
 	if err != nil {
 		return typesCons.NodeId(0), err
@@ -60,13 +93,31 @@ func (m *leaderElectionModule) ElectNextLeader(message *typesCons.HotstuffMessag
 	return nodeId, nil
 }
 
+func (m *leaderElectionModule) GetLeaderElectionProof(message *typesCons.HotstuffMessage) (*typesCons.LeaderElectionProof, error) {
+	if m.strategy != configs.LeaderElectionStrategy_VRFSortition {
+		return nil, nil
+	}
+
+	proof, _, err := m.proveVRFSortition(message)
+	return proof, err
+}
+
+func (m *leaderElectionModule) VerifyLeader(message *typesCons.HotstuffMessage) (typesCons.NodeId, error) {
+	if m.strategy == configs.LeaderElectionStrategy_VRFSortition {
+		return m.verifyLeaderVRFSortition(message)
+	}
+
+	// Every replica knows the leader elected by round robin, so proposals do not need to prove anything
+	return m.electNextLeaderDeterministicRoundRobin(message)
+}
+
 func (m *leaderElectionModule) electNextLeaderDeterministicRoundRobin(message *typesCons.HotstuffMessage) (typesCons.NodeId, error) {
 	height := int64(message.Height)
 	readCtx, err := m.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return typesCons.NodeId(0), err
 	}
-	vals, err := readCtx.GetAllValidators(height)
+	vals, err := readCtx.GetAllValidators(m.getEpochSnapshotHeight(message))
 	if err != nil {
 		return typesCons.NodeId(0), err
 	}
@@ -76,3 +127,12 @@ func (m *leaderElectionModule) electNextLeaderDeterministicRoundRobin(message *t
 
 	return typesCons.NodeId(value%numVals + 1), nil
 }
+
+// getEpochSnapshotHeight returns the height of the state the validator set of the epoch of the message is
+// snapshotted from, which is the validator set consensus uses at the height of the message
+func (m *leaderElectionModule) getEpochSnapshotHeight(message *typesCons.HotstuffMessage) int64 {
+	if m.hotstuffMode == configs.HotstuffMode_ChainedHotstuff {
+		return int64(coreTypes.GetChainedEpochSnapshotHeight(message.GetHeight(), m.epochLength))
+	}
+	return int64(coreTypes.GetEpochSnapshotHeight(message.GetHeight(), m.epochLength))
+}
@@ -7,11 +7,13 @@ import (
 
 const (
 	NilPrivateKeyError    = "private key cannot be nil"
+	NilPublicKeyError     = "public key cannot be nil"
 	BadAppHashLengthError = "the last block hash must be at least %d bytes in length"
 )
 
 var (
 	ErrNilPrivateKey = errors.New(NilPrivateKeyError)
+	ErrNilPublicKey  = errors.New(NilPublicKeyError)
 )
 
 func ErrBadAppHashLength(seedSize int) error {
@@ -60,6 +60,31 @@ func GenerateVRFKeys(reader io.Reader) (*SecretKey, *VerificationKey, error) {
 	return (*SecretKey)(privateKey), (*VerificationKey)(publicKey), nil
 }
 
+// SecretKeyFromPrivateKey converts an ed25519 private key into a VRF secret key. The VRF verification key
+// of the resulting secret key is the ed25519 public key, so the VRF keys of a validator do not need to be
+// distributed separately from the public key it staked with.
+func SecretKeyFromPrivateKey(privKey crypto.PrivateKey) (*SecretKey, error) {
+	if privKey == nil {
+		return nil, ErrNilPrivateKey
+	}
+
+	privateKey, err := ecvrf.NewPrivateKey(privKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return (*SecretKey)(privateKey), nil
+}
+
+// VerificationKeyFromPublicKey returns the VRF verification key of the secret key derived from the
+// private key of `pubKey` by `SecretKeyFromPrivateKey`.
+func VerificationKeyFromPublicKey(pubKey crypto.PublicKey) (*VerificationKey, error) {
+	if pubKey == nil {
+		return nil, ErrNilPublicKey
+	}
+	return VerificationKeyFromBytes(pubKey.Bytes())
+}
+
 func VerificationKeyFromBytes(data []byte) (*VerificationKey, error) {
 	key, err := ecvrf.NewPublicKey(data)
 	if err != nil {
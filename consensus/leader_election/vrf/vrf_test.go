@@ -107,3 +107,36 @@ func TestVRFKeygenProveAndVerifyWithSeed(t *testing.T) {
 	require.Nil(t, err)
 	require.False(t, verified)
 }
+
+func TestVRFKeysFromEd25519Keys(t *testing.T) {
+	privKey, err := crypto.GeneratePrivateKey()
+	require.Nil(t, err)
+
+	sk, err := SecretKeyFromPrivateKey(privKey)
+	require.Nil(t, err)
+
+	// The VRF verification key is the ed25519 public key
+	vk, err := VerificationKeyFromPublicKey(privKey.PublicKey())
+	require.Nil(t, err)
+	skVerificationKey, err := sk.VerificationKey()
+	require.Nil(t, err)
+	require.Equal(t, privKey.PublicKey().Bytes(), skVerificationKey.Bytes())
+
+	msg := []byte("Validators reuse the keys they staked with")
+	vrfOut, vrfProof, err := sk.Prove(msg)
+	require.Nil(t, err)
+
+	verified, err := vk.Verify(msg, vrfProof, vrfOut)
+	require.Nil(t, err)
+	require.True(t, verified)
+
+	// The proof does not verify with the key of another validator
+	otherPrivKey, err := crypto.GeneratePrivateKey()
+	require.Nil(t, err)
+	otherVk, err := VerificationKeyFromPublicKey(otherPrivKey.PublicKey())
+	require.Nil(t, err)
+
+	verified, err = otherVk.Verify(msg, vrfProof, vrfOut)
+	require.Nil(t, err)
+	require.False(t, verified)
+}
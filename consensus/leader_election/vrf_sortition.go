@@ -0,0 +1,184 @@
+package leader_election
+
+/*
+	Stake weighted leader election with VRF sortition:
+
+	1. At the start of every round, each validator computes its VRF output and proof over
+	   `sortition.FormatSeed(height, round, prevBlockHash)` with the VRF keys derived from its ed25519 key
+	2. The VRF output is normalized and used to run sortition over the stake of the validator, so the
+	   probability of being eligible to lead the round is proportional to the stake of the validator
+	3. An eligible validator elects itself as the leader and attaches the VRF output and proof to its proposal
+	4. A replica that was not eligible accepts the proposer as the leader of the round once it verified the
+	   proof against the public key of the proposer and re-ran sortition over the stake of the proposer
+
+	The VRF output of a validator cannot be computed by anyone else, so the election is only known to the
+	other validators through the proofs carried by the proposals. If no validator is eligible, the round
+	times out and the next round is run with a new seed. If several validators are eligible, replicas vote
+	for the first valid proposal they receive and the round may time out without a quorum.
+*/
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/consensus/leader_election/sortition"
+	"github.com/pokt-network/pocket/consensus/leader_election/vrf"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/converters"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// The expected number of validators eligible to lead each round. Increasing it lowers the probability of a
+// round without an eligible leader but increases the probability of several leaders competing in a round.
+const numExpectedLeaderCandidates = 1
+
+func (m *leaderElectionModule) electSelfVRFSortition(message *typesCons.HotstuffMessage) (typesCons.NodeId, error) {
+	_, nodeId, err := m.proveVRFSortition(message)
+	return nodeId, err
+}
+
+// proveVRFSortition returns the leader election proof of this node for the round of the message, and the id of
+// this node if it is eligible to lead the round or 0 otherwise
+func (m *leaderElectionModule) proveVRFSortition(message *typesCons.HotstuffMessage) (*typesCons.LeaderElectionProof, typesCons.NodeId, error) {
+	validators, seed, err := m.getSortitionInputs(message)
+	if err != nil {
+		return nil, typesCons.NodeId(0), err
+	}
+
+	address := m.privateKey.Address().String()
+	validatorStake, networkStake, err := getStakes(validators, address)
+	if err != nil {
+		return nil, typesCons.NodeId(0), err
+	}
+
+	proof, result, err := proveLeaderEligibility(m.privateKey, seed, validatorStake, networkStake)
+	if err != nil || result == 0 {
+		return proof, typesCons.NodeId(0), err
+	}
+	return proof, typesCons.NewActorMapper(validators).GetValAddrToIdMap()[address], nil
+}
+
+func (m *leaderElectionModule) verifyLeaderVRFSortition(message *typesCons.HotstuffMessage) (typesCons.NodeId, error) {
+	proof := message.GetLeaderElectionProof()
+	if proof == nil {
+		return typesCons.NodeId(0), typesCons.ErrNilLeaderElectionProof
+	}
+
+	validators, seed, err := m.getSortitionInputs(message)
+	if err != nil {
+		return typesCons.NodeId(0), err
+	}
+
+	proposerAddr := cryptoPocket.Address(message.GetBlock().GetBlockHeader().GetProposerAddress()).String()
+	actorMapper := typesCons.NewActorMapper(validators)
+	proposer, ok := actorMapper.GetValidatorMap()[proposerAddr]
+	if !ok {
+		return typesCons.NodeId(0), typesCons.ErrMissingValidator(proposerAddr, 0)
+	}
+	pubKey, err := cryptoPocket.NewPublicKey(proposer.GetPublicKey())
+	if err != nil {
+		return typesCons.NodeId(0), err
+	}
+
+	validatorStake, networkStake, err := getStakes(validators, proposerAddr)
+	if err != nil {
+		return typesCons.NodeId(0), err
+	}
+
+	result, verified, err := verifyLeaderEligibility(pubKey, seed, proof, validatorStake, networkStake)
+	if err != nil {
+		return typesCons.NodeId(0), err
+	}
+	if !verified {
+		return typesCons.NodeId(0), typesCons.ErrInvalidLeaderElectionProof(proposerAddr, message.GetHeight(), message.GetRound())
+	}
+	if result == 0 {
+		return typesCons.NodeId(0), typesCons.ErrNotElectedLeader(proposerAddr, message.GetHeight(), message.GetRound())
+	}
+
+	return actorMapper.GetValAddrToIdMap()[proposerAddr], nil
+}
+
+// getSortitionInputs returns the validators of the epoch of the message and the VRF seed of its round
+func (m *leaderElectionModule) getSortitionInputs(message *typesCons.HotstuffMessage) ([]*coreTypes.Actor, []byte, error) {
+	height := int64(message.GetHeight())
+	readCtx, err := m.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer readCtx.Close()
+
+	validators, err := readCtx.GetAllValidators(m.getEpochSnapshotHeight(message))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The genesis block has no previous block
+	var prevBlockHash string
+	if height > 0 {
+		if prevBlockHash, err = readCtx.GetBlockHash(height - 1); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return validators, sortition.FormatSeed(message.GetHeight(), message.GetRound(), prevBlockHash), nil
+}
+
+// getStakes returns the stake of the validator with the given address, which is 0 if it is not a validator,
+// and the total stake of the validators
+func getStakes(validators []*coreTypes.Actor, address string) (validatorStake, networkStake uint64, err error) {
+	total := big.NewInt(0)
+	for _, validator := range validators {
+		stake, err := converters.StringToBigInt(validator.GetStakedAmount())
+		if err != nil {
+			return 0, 0, err
+		}
+		total.Add(total, stake)
+		if validator.GetAddress() == address {
+			validatorStake = stake.Uint64()
+		}
+	}
+	return validatorStake, total.Uint64(), nil
+}
+
+// proveLeaderEligibility computes the VRF output and proof of a validator over the seed of a round, and the
+// result of sortition over its stake, which is greater than 0 if the validator is eligible to lead the round
+func proveLeaderEligibility(privKey cryptoPocket.PrivateKey, seed []byte, validatorStake, networkStake uint64) (*typesCons.LeaderElectionProof, sortition.SortitionResult, error) {
+	sk, err := vrf.SecretKeyFromPrivateKey(privKey)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vrfOut, vrfProof, err := sk.Prove(seed)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	proof := &typesCons.LeaderElectionProof{
+		VrfOutput: vrfOut,
+		VrfProof:  vrfProof,
+	}
+	if validatorStake == 0 || networkStake == 0 {
+		return proof, 0, nil
+	}
+	return proof, sortition.Sortition(validatorStake, networkStake, numExpectedLeaderCandidates, vrfOut), nil
+}
+
+// verifyLeaderEligibility verifies that the VRF output and proof were computed by the owner of `pubKey` over
+// the seed of a round, and re-runs sortition over its stake
+func verifyLeaderEligibility(pubKey cryptoPocket.PublicKey, seed []byte, proof *typesCons.LeaderElectionProof, validatorStake, networkStake uint64) (sortition.SortitionResult, bool, error) {
+	vk, err := vrf.VerificationKeyFromPublicKey(pubKey)
+	if err != nil {
+		return 0, false, err
+	}
+
+	verified, err := vk.Verify(seed, proof.GetVrfProof(), proof.GetVrfOutput())
+	if err != nil || !verified {
+		return 0, false, err
+	}
+
+	if validatorStake == 0 || networkStake == 0 {
+		return 0, true, nil
+	}
+	return sortition.Sortition(validatorStake, networkStake, numExpectedLeaderCandidates, proof.GetVrfOutput()), true, nil
+}
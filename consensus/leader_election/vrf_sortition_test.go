@@ -0,0 +1,88 @@
+package leader_election
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/consensus/leader_election/sortition"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testValidatorStake = uint64(1000000000000)
+	testNetworkStake   = 4 * testValidatorStake
+	testPrevBlockHash  = "9f4a5d3e7c0b2a8e6f1d4c3b2a1908f7e6d5c4b3a29180f7e6d5c4b3a2918070"
+)
+
+func TestVRFSortition_ProveAndVerify(t *testing.T) {
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherPrivKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	numEligibleRounds := 0
+	for round := uint64(0); round < 100; round++ {
+		seed := sortition.FormatSeed(1, round, testPrevBlockHash)
+
+		proof, result, err := proveLeaderEligibility(privKey, seed, testValidatorStake, testNetworkStake)
+		require.NoError(t, err)
+		if result > 0 {
+			numEligibleRounds++
+		}
+
+		// Replicas re-run the same sortition after verifying the proof
+		verifiedResult, verified, err := verifyLeaderEligibility(privKey.PublicKey(), seed, proof, testValidatorStake, testNetworkStake)
+		require.NoError(t, err)
+		require.True(t, verified)
+		require.Equal(t, result, verifiedResult)
+
+		// The proof is bound to the seed of the round
+		otherSeed := sortition.FormatSeed(1, round+1, testPrevBlockHash)
+		_, verified, err = verifyLeaderEligibility(privKey.PublicKey(), otherSeed, proof, testValidatorStake, testNetworkStake)
+		require.NoError(t, err)
+		require.False(t, verified)
+
+		// The proof is bound to the key of the validator
+		_, verified, err = verifyLeaderEligibility(otherPrivKey.PublicKey(), seed, proof, testValidatorStake, testNetworkStake)
+		require.NoError(t, err)
+		require.False(t, verified)
+	}
+
+	// A validator with a quarter of the stake is expected to be eligible in roughly a quarter of the rounds
+	require.Greater(t, numEligibleRounds, 0)
+	require.Less(t, numEligibleRounds, 60)
+}
+
+func TestVRFSortition_TamperedOutputIsRejected(t *testing.T) {
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+	seed := sortition.FormatSeed(1, 0, testPrevBlockHash)
+
+	proof, _, err := proveLeaderEligibility(privKey, seed, testValidatorStake, testNetworkStake)
+	require.NoError(t, err)
+
+	// A proposer cannot pick a VRF output that makes it eligible
+	proof.VrfOutput[0] ^= 0xff
+	result, verified, err := verifyLeaderEligibility(privKey.PublicKey(), seed, proof, testValidatorStake, testNetworkStake)
+	require.NoError(t, err)
+	require.False(t, verified)
+	require.Equal(t, sortition.SortitionResult(0), result)
+}
+
+func TestVRFSortition_NoStakeIsNeverEligible(t *testing.T) {
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	for round := uint64(0); round < 10; round++ {
+		seed := sortition.FormatSeed(1, round, testPrevBlockHash)
+
+		proof, result, err := proveLeaderEligibility(privKey, seed, 0, testNetworkStake)
+		require.NoError(t, err)
+		require.Equal(t, sortition.SortitionResult(0), result)
+
+		result, verified, err := verifyLeaderEligibility(privKey.PublicKey(), seed, proof, 0, testNetworkStake)
+		require.NoError(t, err)
+		require.True(t, verified)
+		require.Equal(t, sortition.SortitionResult(0), result)
+	}
+}
@@ -0,0 +1,246 @@
+package light_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/celestiaorg/smt"
+	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// LightClient follows the chain from a trusted height by verifying the quorum certificates stored in the
+// block headers, without executing the blocks. The validator set only changes at the start of an epoch, to
+// the validators in the state committed by the last block of the previous epoch. The light client notices
+// the change from the validator set hash of the header, and takes the new validators from the state
+// committed by the latest verified header, so it follows validator set changes without trusting the
+// provider. The state hashes of the verified headers are then used to verify the state proofs of the
+// provider (e.g. the balance of an account).
+//
+// NOTE: Only the basic HotStuff mode and ed25519 threshold signatures are supported, and light clients of
+// other chains cannot be created. Chained HotStuff headers carry a QC that does not certify the state
+// hash, and BLS aggregate signatures need the BLS keys of the validators, which are not proven to light
+// clients yet.
+type LightClient struct {
+	m sync.Mutex
+
+	provider    Provider
+	epochLength uint64
+	quorumType  configs.QuorumType
+
+	trustedHeight uint64
+	// The state hashes of the headers verified by the light client, by height
+	stateHashes map[uint64]string
+	// The validator set of the epoch of the trusted height, which signs the block of the next height unless
+	// a new epoch starts
+	validators       []*coreTypes.Actor
+	validatorSetHash string
+}
+
+// NewLightClient creates a light client of a chain with the consensus config `consCfg`, which sets the length of its
+// epochs and the quorum type of its validators, trusting that `trustedValidators` are the validator set signing the
+// block at `trustedHeight+1`. This is the only assumption of the light client, so they must be obtained from a trusted
+// source (e.g. the genesis).
+func NewLightClient(provider Provider, consCfg *configs.ConsensusConfig, trustedHeight uint64, trustedValidators []*coreTypes.Actor) (*LightClient, error) {
+	if consCfg.GetHotstuffMode() != configs.HotstuffMode_BasicHotstuff {
+		return nil, fmt.Errorf("light clients do not support the %s mode", consCfg.GetHotstuffMode())
+	}
+	if consCfg.GetThresholdSignatureScheme() != configs.ThresholdSignatureScheme_Ed25519Signatures {
+		return nil, fmt.Errorf("light clients do not support the %s threshold signature scheme", consCfg.GetThresholdSignatureScheme())
+	}
+	if len(trustedValidators) == 0 {
+		return nil, fmt.Errorf("the trusted validator set is empty")
+	}
+	return &LightClient{
+		provider:         provider,
+		epochLength:      consCfg.GetEpochLength(),
+		quorumType:       consCfg.GetQuorumType(),
+		trustedHeight:    trustedHeight,
+		stateHashes:      make(map[uint64]string),
+		validators:       trustedValidators,
+		validatorSetHash: coreTypes.HashValidators(trustedValidators),
+	}, nil
+}
+
+// TrustedHeight returns the height of the latest header verified by the light client
+func (lc *LightClient) TrustedHeight() uint64 {
+	lc.m.Lock()
+	defer lc.m.Unlock()
+	return lc.trustedHeight
+}
+
+// GetStateHash returns the state hash of a header verified by the light client
+func (lc *LightClient) GetStateHash(height uint64) (string, bool) {
+	lc.m.Lock()
+	defer lc.m.Unlock()
+	stateHash, ok := lc.stateHashes[height]
+	return stateHash, ok
+}
+
+// Sync verifies the headers up to the latest height committed by the provider
+func (lc *LightClient) Sync(ctx context.Context) error {
+	latestHeight, err := lc.provider.GetLatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+	return lc.SyncTo(ctx, latestHeight)
+}
+
+// SyncTo verifies the headers from the trusted height up to `height`, one at a time, since the validators
+// signing each block are only known once the previous one is verified
+func (lc *LightClient) SyncTo(ctx context.Context, height uint64) error {
+	lc.m.Lock()
+	defer lc.m.Unlock()
+
+	for lc.trustedHeight < height {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		header, err := lc.provider.GetBlockHeader(ctx, lc.trustedHeight+1)
+		if err != nil {
+			return err
+		}
+		validators := lc.validators
+		if header.GetValidatorSetHash() != lc.validatorSetHash {
+			if validators, err = lc.getNextValidators(ctx, header); err != nil {
+				return err
+			}
+		}
+		if err := lc.verifyHeader(header, validators); err != nil {
+			return err
+		}
+		lc.trustedHeight = header.GetHeight()
+		lc.stateHashes[header.GetHeight()] = header.GetStateHash()
+		lc.validators = validators
+		lc.validatorSetHash = header.GetValidatorSetHash()
+	}
+	return nil
+}
+
+// GetAccount returns the account of the provider after verifying its proof against the state hash of the
+// header at the height of the proof, which is synced first if needed
+func (lc *LightClient) GetAccount(ctx context.Context, address string) (*coreTypes.Account, error) {
+	proof, err := lc.provider.GetAccountProof(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := lc.SyncTo(ctx, proof.GetHeight()); err != nil {
+		return nil, err
+	}
+	return lc.VerifyAccountProof(address, proof)
+}
+
+// VerifyAccountProof verifies the proof of an account against a header verified by the light client. The
+// account of an address that never held any funds is proven absent and returned with a zero balance.
+func (lc *LightClient) VerifyAccountProof(address string, proof *coreTypes.StateProof) (*coreTypes.Account, error) {
+	addressBz, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(proof.GetKey(), addressBz) {
+		return nil, fmt.Errorf("the state proof is of key %x instead of account %s", proof.GetKey(), address)
+	}
+	stateHash, ok := lc.GetStateHash(proof.GetHeight())
+	if !ok {
+		return nil, fmt.Errorf("the header at height %d is not verified", proof.GetHeight())
+	}
+	if err := proof.Verify(stateHash, coreTypes.AccountsStateTreeIndex); err != nil {
+		return nil, err
+	}
+
+	if len(proof.GetValue()) == 0 {
+		return &coreTypes.Account{Address: address, Amount: "0"}, nil
+	}
+	account := new(coreTypes.Account)
+	if err := codec.GetCodec().Unmarshal(proof.GetValue(), account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// verifyHeader checks that the header at the height following the trusted height is certified by a commit QC
+// of `validators`, following the same rules as a node validating a synced block
+func (lc *LightClient) verifyHeader(header *coreTypes.BlockHeader, validators []*coreTypes.Actor) error {
+	height := header.GetHeight()
+	if height != lc.trustedHeight+1 {
+		return fmt.Errorf("expected the header at height %d but got height %d", lc.trustedHeight+1, height)
+	}
+	qc := new(typesCons.QuorumCertificate)
+	if err := codec.GetCodec().Unmarshal(header.GetQuorumCertificate(), qc); err != nil {
+		return err
+	}
+	if qc.GetHeight() != height || qc.GetStep() != consensus.Commit {
+		return fmt.Errorf("expected a %s QC at height %d but got a %s QC at height %d",
+			typesCons.StepToString[consensus.Commit], height, typesCons.StepToString[qc.GetStep()], qc.GetHeight())
+	}
+	certifiedHeader := qc.GetBlock().GetBlockHeader()
+	if certifiedHeader.GetHeight() != height || certifiedHeader.GetStateHash() != header.GetStateHash() ||
+		certifiedHeader.GetPrevStateHash() != header.GetPrevStateHash() ||
+		certifiedHeader.GetValidatorSetHash() != header.GetValidatorSetHash() {
+		return fmt.Errorf("the block certified by the QC is not the block at height %d", height)
+	}
+	// The continuity of the chain is checked on the certified header, since the rest of the header is not signed
+	if prevStateHash, ok := lc.stateHashes[lc.trustedHeight]; ok && certifiedHeader.GetPrevStateHash() != prevStateHash {
+		return fmt.Errorf("the block certified at height %d does not extend the state hash %s", height, prevStateHash)
+	}
+	return consensus.VerifyQuorumCertificate(qc, validators, lc.quorumType)
+}
+
+// getNextValidators returns the validator set of the epoch starting at the header, which is snapshotted from the
+// state committed by the trusted height. The validators tree is rebuilt from the validators of the provider and
+// compared to its root committed by the state hash of the trusted header, and the validators must hash to the
+// validator set hash of the header.
+func (lc *LightClient) getNextValidators(ctx context.Context, header *coreTypes.BlockHeader) ([]*coreTypes.Actor, error) {
+	height := header.GetHeight()
+	if coreTypes.GetEpochStartHeight(coreTypes.GetEpoch(height, lc.epochLength), lc.epochLength) != height {
+		return nil, fmt.Errorf("the validator set changes at height %d which does not start an epoch", height)
+	}
+	stateHash, ok := lc.stateHashes[lc.trustedHeight]
+	if !ok {
+		return nil, fmt.Errorf("the validator set changes at height %d but the state of the trusted height is not verified", height)
+	}
+	roots, validatorLeaves, err := lc.provider.GetValidatorSet(ctx, lc.trustedHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) <= coreTypes.ValidatorsStateTreeIndex {
+		return nil, fmt.Errorf("the validator set at height %d has no root for the validators tree", lc.trustedHeight)
+	}
+	if err := coreTypes.VerifyStateTreeRoots(roots, stateHash); err != nil {
+		return nil, err
+	}
+
+	validatorsTree := smt.NewSparseMerkleTree(smt.NewSimpleMap(), smt.NewSimpleMap(), sha256.New())
+	validators := make([]*coreTypes.Actor, 0, len(validatorLeaves))
+	for _, leaf := range validatorLeaves {
+		validator := new(coreTypes.Actor)
+		if err := codec.GetCodec().Unmarshal(leaf, validator); err != nil {
+			return nil, err
+		}
+		address, err := hex.DecodeString(validator.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := validatorsTree.Update(address, leaf); err != nil {
+			return nil, err
+		}
+		validators = append(validators, validator)
+	}
+	if !bytes.Equal(validatorsTree.Root(), roots[coreTypes.ValidatorsStateTreeIndex]) {
+		return nil, fmt.Errorf("the validator set at height %d does not match the validators tree", lc.trustedHeight)
+	}
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("the validator set at height %d is empty", lc.trustedHeight)
+	}
+	if coreTypes.HashValidators(validators) != header.GetValidatorSetHash() {
+		return nil, fmt.Errorf("the header at height %d does not commit the validators in the state of height %d", height, lc.trustedHeight)
+	}
+	return validators, nil
+}
@@ -0,0 +1,343 @@
+package light_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/smt"
+	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testingNumValidators = 4
+	testingNumStateTrees = 15
+	testingEpochLength   = 2
+)
+
+func TestLightClient_SyncFollowsValidatorSetChanges(t *testing.T) {
+	genesisValidators, genesisKeys := newTestingValidators(t)
+	nextValidators, nextKeys := newTestingValidators(t)
+
+	// With epochs of 2 blocks, the validators joining and leaving at height 1 only sign from height 3 onwards
+	provider := newTestingProvider()
+	provider.addBlock(t, genesisKeys, genesisValidators, nextValidators, testingNumValidators)
+	provider.addBlock(t, genesisKeys, genesisValidators, nextValidators, testingNumValidators)
+	provider.addBlock(t, nextKeys, nextValidators, nextValidators, testingNumValidators)
+	provider.addBlock(t, nextKeys, nextValidators, nextValidators, testingNumValidators)
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, genesisValidators)
+	require.NoError(t, err)
+	require.NoError(t, lc.Sync(context.Background()))
+	require.Equal(t, uint64(4), lc.TrustedHeight())
+}
+
+func TestLightClient_RejectsValidatorSetChangeWithinEpoch(t *testing.T) {
+	genesisValidators, genesisKeys := newTestingValidators(t)
+	nextValidators, nextKeys := newTestingValidators(t)
+
+	// The validators joining at height 1 cannot sign the block at height 2, which is still in the first epoch
+	provider := newTestingProvider()
+	provider.addBlock(t, genesisKeys, genesisValidators, nextValidators, testingNumValidators)
+	provider.addBlock(t, nextKeys, nextValidators, nextValidators, testingNumValidators)
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, genesisValidators)
+	require.NoError(t, err)
+	require.Error(t, lc.SyncTo(context.Background(), 2))
+	require.Equal(t, uint64(1), lc.TrustedHeight())
+}
+
+func TestLightClient_RejectsHeaderNotCommittingItsValidatorSet(t *testing.T) {
+	validators, privKeys := newTestingValidators(t)
+	nextValidators, _ := newTestingValidators(t)
+
+	// The header starting the second epoch claims a validator set that is not in the state of the previous height
+	provider := newTestingProvider()
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+	provider.addBlock(t, privKeys, nextValidators, validators, testingNumValidators)
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, validators)
+	require.NoError(t, err)
+	require.Error(t, lc.SyncTo(context.Background(), 3))
+	require.Equal(t, uint64(2), lc.TrustedHeight())
+}
+
+func TestLightClient_RejectsQCWithoutSupermajority(t *testing.T) {
+	validators, privKeys := newTestingValidators(t)
+
+	provider := newTestingProvider()
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+	provider.addBlock(t, privKeys, validators, validators, 2)
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, validators)
+	require.NoError(t, err)
+	require.Error(t, lc.SyncTo(context.Background(), 2))
+	require.Equal(t, uint64(1), lc.TrustedHeight())
+}
+
+func TestLightClient_RejectsValidatorSetNotCommittedByHeader(t *testing.T) {
+	validators, privKeys := newTestingValidators(t)
+	forgedValidators, forgedKeys := newTestingValidators(t)
+
+	provider := newTestingProvider()
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+	provider.addBlock(t, forgedKeys, forgedValidators, forgedValidators, testingNumValidators)
+
+	// The provider claims the forged validators are in the state of height 2, so they can start the second epoch
+	provider.validatorLeaves[2] = provider.validatorLeaves[3]
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, validators)
+	require.NoError(t, err)
+	require.Error(t, lc.SyncTo(context.Background(), 3))
+	require.Equal(t, uint64(2), lc.TrustedHeight())
+}
+
+func TestLightClient_RejectsCertifiedBlockNotExtendingTrustedHeader(t *testing.T) {
+	validators, privKeys := newTestingValidators(t)
+
+	provider := newTestingProvider()
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+
+	// The validators certified a block of another chain, which the provider serves with the header of this chain
+	header := provider.headers[2]
+	forkHeader := proto.Clone(header).(*coreTypes.BlockHeader)
+	forkHeader.PrevStateHash = hex.EncodeToString(make([]byte, sha256.Size))
+	header.QuorumCertificate = newTestingCommitQC(t, forkHeader, privKeys)
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, validators)
+	require.NoError(t, err)
+	require.Error(t, lc.SyncTo(context.Background(), 2))
+	require.Equal(t, uint64(1), lc.TrustedHeight())
+
+	// The QC must certify the header of the provider as well, so the header cannot be forged to match it
+	header.PrevStateHash = forkHeader.PrevStateHash
+	require.Error(t, lc.SyncTo(context.Background(), 2))
+	require.Equal(t, uint64(1), lc.TrustedHeight())
+}
+
+func TestLightClient_VerifiesAccountProof(t *testing.T) {
+	validators, privKeys := newTestingValidators(t)
+	address, err := cryptoPocket.GenerateAddress()
+	require.NoError(t, err)
+	missingAddress, err := cryptoPocket.GenerateAddress()
+	require.NoError(t, err)
+
+	provider := newTestingProvider()
+	account := &coreTypes.Account{Address: address.String(), Amount: "1000"}
+	provider.setAccount(t, account)
+	provider.addBlock(t, privKeys, validators, validators, testingNumValidators)
+
+	lc, err := NewLightClient(provider, newTestingConsensusConfig(), 0, validators)
+	require.NoError(t, err)
+
+	provenAccount, err := lc.GetAccount(context.Background(), address.String())
+	require.NoError(t, err)
+	require.Equal(t, account.GetAmount(), provenAccount.GetAmount())
+
+	missingAccount, err := lc.GetAccount(context.Background(), missingAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, "0", missingAccount.GetAmount())
+
+	// A proof of a balance that is not committed by the header is rejected
+	proof, err := provider.GetAccountProof(context.Background(), address.String())
+	require.NoError(t, err)
+	proof.Value, err = codec.GetCodec().Marshal(&coreTypes.Account{Address: address.String(), Amount: "1000000"})
+	require.NoError(t, err)
+	_, err = lc.VerifyAccountProof(address.String(), proof)
+	require.Error(t, err)
+}
+
+func TestLightClient_RejectsUnsupportedChains(t *testing.T) {
+	validators, _ := newTestingValidators(t)
+	provider := newTestingProvider()
+
+	chainedCfg := newTestingConsensusConfig()
+	chainedCfg.HotstuffMode = configs.HotstuffMode_ChainedHotstuff
+	_, err := NewLightClient(provider, chainedCfg, 0, validators)
+	require.Error(t, err)
+
+	blsCfg := newTestingConsensusConfig()
+	blsCfg.ThresholdSignatureScheme = configs.ThresholdSignatureScheme_BLSAggregateSignature
+	_, err = NewLightClient(provider, blsCfg, 0, validators)
+	require.Error(t, err)
+}
+
+// testingProvider builds a chain whose state only contains the validators and accounts trees
+type testingProvider struct {
+	headers         map[uint64]*coreTypes.BlockHeader
+	roots           map[uint64][][]byte
+	validatorLeaves map[uint64][][]byte
+	accountsTree    *smt.SparseMerkleTree
+}
+
+func newTestingProvider() *testingProvider {
+	return &testingProvider{
+		headers:         make(map[uint64]*coreTypes.BlockHeader),
+		roots:           make(map[uint64][][]byte),
+		validatorLeaves: make(map[uint64][][]byte),
+		accountsTree:    smt.NewSparseMerkleTree(smt.NewSimpleMap(), smt.NewSimpleMap(), sha256.New()),
+	}
+}
+
+// addBlock commits a block of the epoch of `validators` changing the validators in the state to `nextValidators`,
+// certified by `numSigners` of the keys
+func (p *testingProvider) addBlock(t *testing.T, privKeys []cryptoPocket.PrivateKey, validators, nextValidators []*coreTypes.Actor, numSigners int) {
+	height := uint64(len(p.headers) + 1)
+
+	validatorsTree := smt.NewSparseMerkleTree(smt.NewSimpleMap(), smt.NewSimpleMap(), sha256.New())
+	leaves := make([][]byte, 0, len(nextValidators))
+	for _, validator := range nextValidators {
+		leaf, err := codec.GetCodec().Marshal(validator)
+		require.NoError(t, err)
+		address, err := hex.DecodeString(validator.GetAddress())
+		require.NoError(t, err)
+		_, err = validatorsTree.Update(address, leaf)
+		require.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+
+	roots := make([][]byte, testingNumStateTrees)
+	for i := range roots {
+		roots[i] = make([]byte, sha256.Size)
+	}
+	roots[coreTypes.ValidatorsStateTreeIndex] = validatorsTree.Root()
+	roots[coreTypes.AccountsStateTreeIndex] = p.accountsTree.Root()
+	stateHash := sha256.Sum256(bytes.Join(roots, []byte{}))
+
+	header := &coreTypes.BlockHeader{
+		Height:           height,
+		StateHash:        hex.EncodeToString(stateHash[:]),
+		ValidatorSetHash: coreTypes.HashValidators(validators),
+	}
+	if prevHeader, ok := p.headers[height-1]; ok {
+		header.PrevStateHash = prevHeader.GetStateHash()
+	}
+	header.QuorumCertificate = newTestingCommitQC(t, header, privKeys[:numSigners])
+
+	p.headers[height] = header
+	p.roots[height] = roots
+	p.validatorLeaves[height] = leaves
+}
+
+func (p *testingProvider) setAccount(t *testing.T, account *coreTypes.Account) {
+	accBz, err := codec.GetCodec().Marshal(account)
+	require.NoError(t, err)
+	address, err := hex.DecodeString(account.GetAddress())
+	require.NoError(t, err)
+	_, err = p.accountsTree.Update(address, accBz)
+	require.NoError(t, err)
+}
+
+func (p *testingProvider) GetLatestHeight(_ context.Context) (uint64, error) {
+	return uint64(len(p.headers)), nil
+}
+
+func (p *testingProvider) GetBlockHeader(_ context.Context, height uint64) (*coreTypes.BlockHeader, error) {
+	header, ok := p.headers[height]
+	if !ok {
+		return nil, fmt.Errorf("no header at height %d", height)
+	}
+	return header, nil
+}
+
+func (p *testingProvider) GetValidatorSet(_ context.Context, height uint64) (roots, validators [][]byte, err error) {
+	return p.roots[height], p.validatorLeaves[height], nil
+}
+
+func (p *testingProvider) GetAccountProof(_ context.Context, address string) (*coreTypes.StateProof, error) {
+	height := uint64(len(p.headers))
+	addressBz, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := p.accountsTree.Prove(addressBz)
+	if err != nil {
+		return nil, err
+	}
+	value, err := p.accountsTree.Get(addressBz)
+	if err != nil {
+		return nil, err
+	}
+	return &coreTypes.StateProof{
+		Height:                height,
+		StateTreeRoots:        p.roots[height],
+		Key:                   addressBz,
+		Value:                 value,
+		SideNodes:             proof.SideNodes,
+		NonMembershipLeafData: proof.NonMembershipLeafData,
+		SiblingData:           proof.SiblingData,
+	}, nil
+}
+
+func newTestingConsensusConfig() *configs.ConsensusConfig {
+	return &configs.ConsensusConfig{
+		EpochLength: testingEpochLength,
+		QuorumType:  configs.QuorumType_StakeWeightedQuorum,
+	}
+}
+
+func newTestingValidators(t *testing.T) ([]*coreTypes.Actor, []cryptoPocket.PrivateKey) {
+	validators := make([]*coreTypes.Actor, 0, testingNumValidators)
+	privKeys := make([]cryptoPocket.PrivateKey, 0, testingNumValidators)
+	for i := 0; i < testingNumValidators; i++ {
+		privKey, err := cryptoPocket.GeneratePrivateKey()
+		require.NoError(t, err)
+		validators = append(validators, &coreTypes.Actor{
+			ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+			Address:      privKey.Address().String(),
+			PublicKey:    privKey.PublicKey().String(),
+			StakedAmount: "1000000000000",
+			Output:       privKey.Address().String(),
+		})
+		privKeys = append(privKeys, privKey)
+	}
+	return validators, privKeys
+}
+
+// newTestingCommitQC signs the commit vote of the header the same way the validators sign their votes
+func newTestingCommitQC(t *testing.T, header *coreTypes.BlockHeader, privKeys []cryptoPocket.PrivateKey) []byte {
+	block := &coreTypes.Block{BlockHeader: &coreTypes.BlockHeader{
+		Height:           header.GetHeight(),
+		StateHash:        header.GetStateHash(),
+		PrevStateHash:    header.GetPrevStateHash(),
+		ValidatorSetHash: header.GetValidatorSetHash(),
+	}}
+	signableBz, err := codec.GetCodec().Marshal(&typesCons.SignableHotstuffMessage{
+		Type:      consensus.Vote,
+		Height:    header.GetHeight(),
+		Step:      consensus.Commit,
+		BlockHash: consensus.GetBlockHash(block),
+	})
+	require.NoError(t, err)
+
+	thresholdSig := new(typesCons.ThresholdSignature)
+	for _, privKey := range privKeys {
+		signature, err := privKey.Sign(signableBz)
+		require.NoError(t, err)
+		thresholdSig.Signatures = append(thresholdSig.Signatures, &typesCons.PartialSignature{
+			Signature: signature,
+			Address:   privKey.Address().String(),
+		})
+	}
+	qcBz, err := codec.GetCodec().Marshal(&typesCons.QuorumCertificate{
+		Height:             header.GetHeight(),
+		Step:               consensus.Commit,
+		Block:              block,
+		ThresholdSignature: thresholdSig,
+	})
+	require.NoError(t, err)
+	return qcBz
+}
@@ -0,0 +1,132 @@
+package light_client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pokt-network/pocket/rpc"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Provider serves the data a light client verifies; it is not trusted, since everything it returns is checked
+// against the quorum certificates of the validators the light client follows.
+type Provider interface {
+	GetLatestHeight(ctx context.Context) (uint64, error)
+	GetBlockHeader(ctx context.Context, height uint64) (*coreTypes.BlockHeader, error)
+	// Returns the roots of the state trees committed at the height and the leaves of the validators state tree
+	GetValidatorSet(ctx context.Context, height uint64) (roots, validators [][]byte, err error)
+	// Returns the proof of the account at the latest committed height
+	GetAccountProof(ctx context.Context, address string) (*coreTypes.StateProof, error)
+}
+
+var _ Provider = &rpcProvider{}
+
+// rpcProvider fetches the data of the light client from the RPC of a single full node
+type rpcProvider struct {
+	client *rpc.ClientWithResponses
+}
+
+func NewRPCProvider(remoteURL string) (Provider, error) {
+	client, err := rpc.NewClientWithResponses(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcProvider{client: client}, nil
+}
+
+func (p *rpcProvider) GetLatestHeight(ctx context.Context) (uint64, error) {
+	response, err := p.client.GetV1ConsensusStateWithResponse(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if response.JSON200 == nil {
+		return 0, fmt.Errorf("unable to get the current height: HTTP %d", response.StatusCode())
+	}
+	// The node is working on the current height, so the latest committed block is the previous one
+	if response.JSON200.Height == 0 {
+		return 0, nil
+	}
+	return uint64(response.JSON200.Height - 1), nil
+}
+
+func (p *rpcProvider) GetBlockHeader(ctx context.Context, height uint64) (*coreTypes.BlockHeader, error) {
+	response, err := p.client.PostV1QueryBlockHeaderWithResponse(ctx, rpc.HeightRequest{Height: int64(height)})
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, fmt.Errorf("unable to get the block header at height %d: HTTP %d: %s", height, response.StatusCode(), response.Body)
+	}
+	headerBz, err := hex.DecodeString(response.JSON200.Header)
+	if err != nil {
+		return nil, err
+	}
+	header := new(coreTypes.BlockHeader)
+	if err := codec.GetCodec().Unmarshal(headerBz, header); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (p *rpcProvider) GetValidatorSet(ctx context.Context, height uint64) (roots, validators [][]byte, err error) {
+	response, err := p.client.PostV1QueryValidatorSetWithResponse(ctx, rpc.HeightRequest{Height: int64(height)})
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, nil, fmt.Errorf("unable to get the validator set at height %d: HTTP %d: %s", height, response.StatusCode(), response.Body)
+	}
+	if roots, err = fromHexStrings(response.JSON200.StateTreeRoots); err != nil {
+		return nil, nil, err
+	}
+	if validators, err = fromHexStrings(response.JSON200.Validators); err != nil {
+		return nil, nil, err
+	}
+	return roots, validators, nil
+}
+
+func (p *rpcProvider) GetAccountProof(ctx context.Context, address string) (*coreTypes.StateProof, error) {
+	response, err := p.client.PostV1QueryAccountProofWithResponse(ctx, rpc.AccountRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, fmt.Errorf("unable to get the proof of account %s: HTTP %d: %s", address, response.StatusCode(), response.Body)
+	}
+	rpcProof := response.JSON200
+
+	proof := &coreTypes.StateProof{Height: uint64(rpcProof.Height)}
+	if proof.StateTreeRoots, err = fromHexStrings(rpcProof.StateTreeRoots); err != nil {
+		return nil, err
+	}
+	if proof.SideNodes, err = fromHexStrings(rpcProof.SideNodes); err != nil {
+		return nil, err
+	}
+	if proof.Key, err = hex.DecodeString(rpcProof.Key); err != nil {
+		return nil, err
+	}
+	if proof.Value, err = hex.DecodeString(rpcProof.Value); err != nil {
+		return nil, err
+	}
+	if proof.NonMembershipLeafData, err = hex.DecodeString(rpcProof.NonMembershipLeafData); err != nil {
+		return nil, err
+	}
+	if proof.SiblingData, err = hex.DecodeString(rpcProof.SiblingData); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+func fromHexStrings(hexStrings []string) ([][]byte, error) {
+	bzs := make([][]byte, 0, len(hexStrings))
+	for _, hexString := range hexStrings {
+		bz, err := hex.DecodeString(hexString)
+		if err != nil {
+			return nil, err
+		}
+		bzs = append(bzs, bz)
+	}
+	return bzs, nil
+}
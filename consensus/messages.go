@@ -75,6 +75,38 @@ func CreateVoteMessage(
 	return msg, nil
 }
 
+// CreateTimeoutMessage signs that the node gives up on a round. Timeouts are not bound to a step or a block, so the
+// timeouts of all the validators in a round can be aggregated into a timeout certificate.
+func CreateTimeoutMessage(
+	height uint64,
+	round uint64,
+	privKey crypto.PrivateKey, // used to sign the timeout
+) *typesCons.HotstuffMessage {
+	msg := &typesCons.HotstuffMessage{
+		Type:   Timeout,
+		Height: height,
+		Round:  round,
+	}
+
+	msg.Justification = &typesCons.HotstuffMessage_PartialSignature{
+		PartialSignature: &typesCons.PartialSignature{
+			Signature: getMessageSignature(msg, privKey),
+			Address:   privKey.PublicKey().Address().String(),
+		},
+	}
+
+	return msg
+}
+
+// SignNewRoundMessage signs the NEWROUND message a node starts a view with, so the leader can count the voting power
+// of its sender. The justification of a NEWROUND message is the highQC of the node, so the signature has its own field.
+func SignNewRoundMessage(msg *typesCons.HotstuffMessage, privKey crypto.PrivateKey) {
+	msg.NewRoundSignature = &typesCons.PartialSignature{
+		Signature: getMessageSignature(msg, privKey),
+		Address:   privKey.PublicKey().Address().String(),
+	}
+}
+
 // Returns "partial" signature of the hotstuff message from one of the validators.
 // If there is an error signing the bytes, nil is returned instead.
 func getMessageSignature(msg *typesCons.HotstuffMessage, privKey crypto.PrivateKey) []byte {
@@ -96,12 +128,19 @@ func getMessageSignature(msg *typesCons.HotstuffMessage, privKey crypto.PrivateK
 // Signature only over subset of fields in HotstuffMessage
 // For reference, see section 4.3 of the the hotstuff whitepaper, partial signatures are
 // computed over `tsignr(hm.type, m.viewNumber , m.nodei)`. https://arxiv.org/pdf/1803.05069.pdf
+// Only votes, timeouts and NEWROUND messages are signed, so any other message (e.g. a QC being verified) is signed as a
+// vote. A NEWROUND message is signed as a vote of the NEWROUND step, which no vote is cast at.
 func getSignableBytes(msg *typesCons.HotstuffMessage) ([]byte, error) {
-	msgToSign := &typesCons.HotstuffMessage{
-		Height: msg.GetHeight(),
-		Step:   msg.GetStep(),
-		Round:  msg.GetRound(),
-		Block:  msg.GetBlock(),
+	msgToSign := &typesCons.SignableHotstuffMessage{
+		Type:      Vote,
+		Height:    msg.GetHeight(),
+		Step:      msg.GetStep(),
+		Round:     msg.GetRound(),
+		BlockHash: GetBlockHash(msg.GetBlock()),
+	}
+	// The type is signed so that a timeout cannot be mistaken for a vote
+	if msg.GetType() == Timeout {
+		msgToSign.Type = Timeout
 	}
 	return codec.GetCodec().Marshal(msgToSign)
 }
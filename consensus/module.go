@@ -6,10 +6,13 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/benbjohnson/clock"
 	"github.com/pokt-network/pocket/consensus/leader_election"
 	"github.com/pokt-network/pocket/consensus/pacemaker"
+	"github.com/pokt-network/pocket/consensus/state_sync"
 	consensusTelemetry "github.com/pokt-network/pocket/consensus/telemetry"
 	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/consensus/wal"
 	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/runtime/genesis"
 	"github.com/pokt-network/pocket/shared/codec"
@@ -31,6 +34,8 @@ var (
 type consensusModule struct {
 	bus        modules.Bus
 	privateKey cryptoPocket.Ed25519PrivateKey
+	// Signs the votes aggregated into the threshold signatures of QCs; only set with BLS aggregate signatures
+	blsPrivateKey *cryptoPocket.BLSPrivateKey
 
 	consCfg      *configs.ConsensusConfig
 	genesisState *genesis.GenesisState
@@ -65,6 +70,7 @@ type consensusModule struct {
 	utilityContext    modules.UtilityContext
 	paceMaker         pacemaker.Pacemaker
 	leaderElectionMod leader_election.LeaderElectionModule
+	stateSync         state_sync.StateSyncModule
 
 	// DEPRECATE: Remove later when we build a shared/proper/injected logger
 	logPrefix string
@@ -72,6 +78,28 @@ type consensusModule struct {
 	// TECHDEBT: Rename this to `consensusMessagePool` or something similar
 	//           and reconsider if an in-memory map is the best approach
 	messagePool map[typesCons.HotstuffStep][]*typesCons.HotstuffMessage
+
+	// The TIMEOUT messages of the current height by round, aggregated by every validator into timeout certificates
+	timeoutPool map[uint64][]*typesCons.HotstuffMessage
+
+	// The double sign evidence detected by this node, proposed in the next block it leads
+	evidencePool []*coreTypes.DoubleSignEvidence
+
+	// Write-ahead log of the QCs and messages this node must not forget when it restarts mid-height
+	wal          wal.WAL
+	sentMessages map[sentMessageKey]*typesCons.HotstuffMessage // The votes and proposals sent at the current height
+
+	// The certified blocks that are not committed yet; only used by chained HotStuff
+	chained chainedState
+
+	// Fires when the leader waited long enough since the parent block to propose the next one
+	proposalTimer *clock.Timer
+
+	// The validator sets of the recent epochs, by epoch
+	validatorSets map[uint64]*coreTypes.ValidatorSet
+
+	// Set once the node halted for an upgrade its binary does not implement; every message is rejected with it
+	haltErr error
 }
 
 // Functions exposed by the debug interface should only be used for testing puposes.
@@ -91,6 +119,7 @@ func (m *consensusModule) SetHeight(height uint64) {
 
 func (m *consensusModule) SetRound(round uint64) {
 	m.round = round
+	m.pruneTimeoutPool()
 }
 
 func (m *consensusModule) SetStep(step uint8) {
@@ -131,10 +160,16 @@ func (*consensusModule) Create(bus modules.Bus) (modules.Module, error) {
 		return nil, err
 	}
 
+	stateSyncMod, err := state_sync.CreateStateSync(bus)
+	if err != nil {
+		return nil, err
+	}
+
 	pacemaker := paceMakerMod.(pacemaker.Pacemaker)
 	m := &consensusModule{
 		paceMaker:         pacemaker,
 		leaderElectionMod: leaderElectionMod.(leader_election.LeaderElectionModule),
+		stateSync:         stateSyncMod.(state_sync.StateSyncModule),
 
 		height: 0,
 		round:  0,
@@ -151,6 +186,11 @@ func (*consensusModule) Create(bus modules.Bus) (modules.Module, error) {
 		logPrefix: DefaultLogPrefix,
 
 		messagePool: make(map[typesCons.HotstuffStep][]*typesCons.HotstuffMessage),
+		timeoutPool: make(map[uint64][]*typesCons.HotstuffMessage),
+
+		validatorSets: make(map[uint64]*coreTypes.ValidatorSet),
+
+		chained: newChainedState(),
 	}
 	bus.RegisterModule(m)
 
@@ -158,6 +198,11 @@ func (*consensusModule) Create(bus modules.Bus) (modules.Module, error) {
 
 	consensusCfg := runtimeMgr.GetConfig().Consensus
 
+	if consensusCfg.GetHotstuffMode() == configs.HotstuffMode_ChainedHotstuff &&
+		consensusCfg.GetLeaderElectionStrategy() == configs.LeaderElectionStrategy_VRFSortition {
+		return nil, typesCons.ErrChainedHotstuffLeaderElection
+	}
+
 	genesisState := runtimeMgr.GetGenesis()
 	if err := m.ValidateGenesis(genesisState); err != nil {
 		return nil, fmt.Errorf("genesis validation failed: %w", err)
@@ -178,10 +223,22 @@ func (*consensusModule) Create(bus modules.Bus) (modules.Module, error) {
 
 	m.privateKey = privateKey.(cryptoPocket.Ed25519PrivateKey)
 	m.consCfg = consensusCfg
+
+	if consensusCfg.GetThresholdSignatureScheme() == configs.ThresholdSignatureScheme_BLSAggregateSignature {
+		blsPrivateKey, err := cryptoPocket.NewBLSPrivateKeyFromPrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		m.blsPrivateKey = blsPrivateKey
+	}
 	m.genesisState = genesisState
 
 	m.nodeId = valAddrToIdMap[address]
 
+	if err := m.initWAL(); err != nil {
+		return nil, err
+	}
+
 	return m, nil
 }
 
@@ -198,6 +255,10 @@ func (m *consensusModule) Start() error {
 		return err
 	}
 
+	if err := m.replayWAL(); err != nil {
+		return err
+	}
+
 	if err := m.paceMaker.Start(); err != nil {
 		return err
 	}
@@ -206,11 +267,18 @@ func (m *consensusModule) Start() error {
 		return err
 	}
 
+	if err := m.stateSync.Start(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (m *consensusModule) Stop() error {
-	return nil
+	if err := m.paceMaker.Stop(); err != nil {
+		return err
+	}
+	return m.wal.Stop()
 }
 
 func (m *consensusModule) GetModuleName() string {
@@ -232,6 +300,9 @@ func (m *consensusModule) SetBus(pocketBus modules.Bus) {
 	if m.leaderElectionMod != nil {
 		m.leaderElectionMod.SetBus(pocketBus)
 	}
+	if m.stateSync != nil {
+		m.stateSync.SetBus(pocketBus)
+	}
 }
 
 func (*consensusModule) ValidateGenesis(genesis *genesis.GenesisState) error {
@@ -267,24 +338,64 @@ func (m *consensusModule) HandleMessage(message *anypb.Any) error {
 	m.m.Lock()
 	defer m.m.Unlock()
 
+	if m.haltErr != nil {
+		return m.haltErr
+	}
+
 	switch message.MessageName() {
 	case HotstuffMessageContentType:
 		msg, err := codec.GetCodec().FromAny(message)
 		if err != nil {
+			m.GetBus().GetP2PModule().ReportInvalidMessage(message)
 			return err
 		}
 		hotstuffMessage, ok := msg.(*typesCons.HotstuffMessage)
 		if !ok {
+			m.GetBus().GetP2PModule().ReportInvalidMessage(message)
 			return fmt.Errorf("failed to cast message to HotstuffMessage")
 		}
 		if err := m.handleHotstuffMessage(hotstuffMessage); err != nil {
 			return err
 		}
+	case StateSyncMessageContentType:
+		if err := m.handleStateSyncMessage(message, ""); err != nil {
+			return err
+		}
 	default:
 		return typesCons.ErrUnknownConsensusMessageType(message.MessageName())
 	}
 
-	return nil
+	// Handling the message may have halted the node
+	return m.haltErr
+}
+
+func (m *consensusModule) HandleStateSyncMessage(message *anypb.Any, sender string) error {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if m.haltErr != nil {
+		return m.haltErr
+	}
+	if err := m.handleStateSyncMessage(message, sender); err != nil {
+		return err
+	}
+
+	// Applying the synced blocks may have halted the node
+	return m.haltErr
+}
+
+func (m *consensusModule) handleStateSyncMessage(message *anypb.Any, sender string) error {
+	msg, err := codec.GetCodec().FromAny(message)
+	if err != nil {
+		m.GetBus().GetP2PModule().ReportInvalidMessage(message)
+		return err
+	}
+	stateSyncMessage, ok := msg.(*typesCons.StateSyncMessage)
+	if !ok {
+		m.GetBus().GetP2PModule().ReportInvalidMessage(message)
+		return fmt.Errorf("failed to cast message to StateSyncMessage")
+	}
+	return m.stateSync.HandleStateSyncMessage(stateSyncMessage, sender)
 }
 
 func (m *consensusModule) CurrentHeight() uint64 {
@@ -313,6 +424,7 @@ func (m *consensusModule) loadPersistedState() error {
 		return nil
 	}
 
+	m.chained.committedHeight = uint64(latestHeight)
 	m.height = uint64(latestHeight) + 1 // +1 because the height of the consensus module is where it is actively participating in consensus
 
 	m.nodeLog(fmt.Sprintf("Starting consensus module at height %d", latestHeight))
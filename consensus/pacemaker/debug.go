@@ -17,6 +17,7 @@ type pacemakerDebug struct {
 	manualMode                bool
 	debugTimeBetweenStepsMsec uint64
 	quorumCertificate         *typesCons.QuorumCertificate
+	timeoutCertificate        *typesCons.TimeoutCertificate
 }
 
 func (m *pacemaker) IsManualMode() bool {
@@ -28,5 +29,5 @@ func (m *pacemaker) SetManualMode(manualMode bool) {
 }
 
 func (m *pacemaker) ForceNextView() {
-	m.startNextView(m.debug.quorumCertificate, true)
+	m.startNextView(m.debug.quorumCertificate, m.debug.timeoutCertificate, true)
 }
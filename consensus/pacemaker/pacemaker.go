@@ -21,6 +21,9 @@ const (
 	// A buffer around the pacemaker timeout to avoid race condition; 30ms was arbitrarily chosen
 	timeoutBuffer = 30 * time.Millisecond
 
+	// The step timeout doubles with every consecutive round that timed out, up to 2^6 times the configured timeout
+	maxTimeoutBackoffExponent = 6
+
 	newRound = typesCons.HotstuffStep_HOTSTUFF_STEP_NEWROUND
 	propose  = typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_PROPOSE
 )
@@ -41,6 +44,9 @@ type Pacemaker interface {
 	RestartTimer()
 	NewHeight()
 	InterruptRound(reason string)
+	AdvanceRound(tc *typesCons.TimeoutCertificate)
+	ResetBackoff()
+	DelayNewRoundTimeout(until time.Time)
 }
 
 type pacemaker struct {
@@ -48,6 +54,11 @@ type pacemaker struct {
 	pacemakerCfg   *configs.PacemakerConfig
 	stepCancelFunc context.CancelFunc
 
+	// The number of consecutive rounds that timed out since the last committed block
+	numFailedRounds uint64
+	// The leader of the next height may wait until then before proposing a block, so validators do not time out before
+	newRoundDeadline time.Time
+
 	// Only used for development and debugging.
 	debug pacemakerDebug
 
@@ -74,16 +85,30 @@ func (*pacemaker) Create(bus modules.Bus) (modules.Module, error) {
 		manualMode:                m.pacemakerCfg.GetManual(),
 		debugTimeBetweenStepsMsec: m.pacemakerCfg.GetDebugTimeBetweenStepsMsec(),
 		quorumCertificate:         nil,
+		timeoutCertificate:        nil,
 	}
 
 	return m, nil
 }
 
 func (m *pacemaker) Start() error {
+	timeSeriesAgent := m.GetBus().GetTelemetryModule().GetTimeSeriesAgent()
+	timeSeriesAgent.CounterRegister(
+		consensusTelemetry.CONSENSUS_PACEMAKER_TIMEOUT_COUNTER_NAME,
+		consensusTelemetry.CONSENSUS_PACEMAKER_TIMEOUT_COUNTER_DESCRIPTION,
+	)
+	timeSeriesAgent.GaugeRegister(
+		consensusTelemetry.CONSENSUS_PACEMAKER_FAILED_ROUNDS_GAUGE_NAME,
+		consensusTelemetry.CONSENSUS_PACEMAKER_FAILED_ROUNDS_GAUGE_DESCRIPTION,
+	)
+
 	m.RestartTimer()
 	return nil
 }
-func (*pacemaker) Stop() error {
+func (m *pacemaker) Stop() error {
+	if m.stepCancelFunc != nil {
+		m.stepCancelFunc()
+	}
 	return nil
 }
 
@@ -175,8 +200,7 @@ func (m *pacemaker) RestartTimer() {
 	}
 
 	// NOTE: Not defering a cancel call because this function is asynchronous.
-	consensusMod := m.GetBus().GetConsensusModule()
-	stepTimeout := m.getStepTimeout(consensusMod.CurrentRound())
+	stepTimeout := m.getStepTimeout()
 	clock := m.GetBus().GetRuntimeMgr().GetClock()
 
 	ctx, cancel := clock.WithTimeout(context.TODO(), stepTimeout)
@@ -194,34 +218,43 @@ func (m *pacemaker) RestartTimer() {
 	}()
 }
 
+// InterruptRound gives up on the current round: the node broadcasts a TIMEOUT message and only moves to the next
+// round once 2/3+ of the validators timed out in the round (see `AdvanceRound`). The timer is restarted so the TIMEOUT
+// message is broadcast again if the round does not change in the meantime.
 func (m *pacemaker) InterruptRound(reason string) {
 	consensusMod := m.GetBus().GetConsensusModule()
 	m.nodeLog(typesCons.PacemakerInterrupt(reason, consensusMod.CurrentHeight(), typesCons.HotstuffStep(consensusMod.CurrentStep()), consensusMod.CurrentRound()))
+	m.emitTimeoutTelemetry(reason)
 
-	consensusMod.SetRound(consensusMod.CurrentRound() + 1)
-
-	//ADDTEST: check if this is indeed ensured after a successful round
-	if m.GetBus().GetConsensusModule().IsPrepareQCNil() {
-		m.startNextView(nil, false)
-		return
+	m.RestartTimer()
+	if err := consensusMod.BroadcastTimeoutMessage(); err != nil {
+		m.nodeLog(fmt.Sprintf("[WARN] Failed to broadcast timeout message: %v", err))
 	}
+}
 
-	msgAny, err := consensusMod.GetPrepareQC()
-	if err != nil {
-		return
-	}
+// AdvanceRound moves the node to the round after the round certified by the timeout certificate. The NEWROUND
+// message of the node carries the certificate, so the validators that did not time out yet move along.
+func (m *pacemaker) AdvanceRound(tc *typesCons.TimeoutCertificate) {
+	consensusMod := m.GetBus().GetConsensusModule()
 
-	msg, err := codec.GetCodec().FromAny(msgAny)
-	if err != nil {
-		return
-	}
+	m.numFailedRounds += tc.GetRound() + 1 - consensusMod.CurrentRound()
+	m.nodeLog(typesCons.PacemakerTimeoutCertificate(tc.GetHeight(), tc.GetRound(), m.numFailedRounds))
+	m.setFailedRoundsGauge()
 
-	quorumCertificate, ok := msg.(*typesCons.QuorumCertificate)
-	if !ok {
-		return
-	}
+	consensusMod.SetRound(tc.GetRound() + 1)
+	m.startNextView(m.getPrepareQC(), tc, false)
+}
+
+// ResetBackoff resets the step timeout to the configured timeout once a block is committed
+func (m *pacemaker) ResetBackoff() {
+	m.numFailedRounds = 0
+	m.setFailedRoundsGauge()
+}
 
-	m.startNextView(quorumCertificate, false)
+// DelayNewRoundTimeout extends the timeout of the NEWROUND step until the given time, at which the leader proposes
+// the next block at the latest
+func (m *pacemaker) DelayNewRoundTimeout(until time.Time) {
+	m.newRoundDeadline = until
 }
 
 func (m *pacemaker) NewHeight() {
@@ -231,7 +264,7 @@ func (m *pacemaker) NewHeight() {
 	consensusMod.SetHeight(consensusMod.CurrentHeight() + 1)
 	consensusMod.ResetForNewHeight()
 
-	m.startNextView(nil, false) // TODO(design): We are omitting CommitQC and TimeoutQC here.
+	m.startNextView(nil, nil, false) // TODO(design): We are omitting the CommitQC here.
 
 	m.GetBus().
 		GetTelemetryModule().
@@ -241,7 +274,7 @@ func (m *pacemaker) NewHeight() {
 		)
 }
 
-func (m *pacemaker) startNextView(qc *typesCons.QuorumCertificate, forceNextView bool) {
+func (m *pacemaker) startNextView(qc *typesCons.QuorumCertificate, tc *typesCons.TimeoutCertificate, forceNextView bool) {
 	// DISCUSS: Should we lock the consensus module here?
 	consensusMod := m.GetBus().GetConsensusModule()
 	consensusMod.SetStep(uint8(newRound))
@@ -251,16 +284,18 @@ func (m *pacemaker) startNextView(qc *typesCons.QuorumCertificate, forceNextView
 	// TECHDEBT: This if structure for debug purposes only; think of a way to externalize it from the main consensus flow...
 	if m.debug.manualMode && !forceNextView {
 		m.debug.quorumCertificate = qc
+		m.debug.timeoutCertificate = tc
 		return
 	}
 
 	hotstuffMessage := &typesCons.HotstuffMessage{
-		Type:          propose,
-		Height:        consensusMod.CurrentHeight(),
-		Step:          newRound,
-		Round:         consensusMod.CurrentRound(),
-		Block:         nil,
-		Justification: nil, // Set below if qc is not nil
+		Type:               propose,
+		Height:             consensusMod.CurrentHeight(),
+		Step:               newRound,
+		Round:              consensusMod.CurrentRound(),
+		Block:              nil,
+		Justification:      nil, // Set below if qc is not nil
+		TimeoutCertificate: tc,
 	}
 
 	if qc != nil {
@@ -279,10 +314,83 @@ func (m *pacemaker) startNextView(qc *typesCons.QuorumCertificate, forceNextView
 	consensusMod.BroadcastMessageToValidators(anyProto)
 }
 
-// TODO: Increase timeout using exponential backoff.
-func (m *pacemaker) getStepTimeout(round uint64) time.Duration {
+// getStepTimeout doubles the configured timeout for every consecutive round that timed out since the last commit. The
+// NEWROUND step also waits until the leader of the next height is due to propose (see `DelayNewRoundTimeout`).
+func (m *pacemaker) getStepTimeout() time.Duration {
 	baseTimeout := time.Duration(int64(time.Millisecond) * int64(m.pacemakerCfg.TimeoutMsec))
-	return baseTimeout
+	backoffExponent := m.numFailedRounds
+	if backoffExponent > maxTimeoutBackoffExponent {
+		backoffExponent = maxTimeoutBackoffExponent
+	}
+	stepTimeout := baseTimeout << backoffExponent
+
+	if typesCons.HotstuffStep(m.GetBus().GetConsensusModule().CurrentStep()) == newRound {
+		if untilDeadline := m.GetBus().GetRuntimeMgr().GetClock().Until(m.newRoundDeadline); untilDeadline > 0 {
+			stepTimeout += untilDeadline
+		}
+	}
+	return stepTimeout
+}
+
+// getPrepareQC returns the highQC of the node, which is carried by its NEWROUND messages
+func (m *pacemaker) getPrepareQC() *typesCons.QuorumCertificate {
+	consensusMod := m.GetBus().GetConsensusModule()
+
+	//ADDTEST: check if this is indeed ensured after a successful round
+	if consensusMod.IsPrepareQCNil() {
+		return nil
+	}
+
+	msgAny, err := consensusMod.GetPrepareQC()
+	if err != nil {
+		return nil
+	}
+
+	msg, err := codec.GetCodec().FromAny(msgAny)
+	if err != nil {
+		return nil
+	}
+
+	quorumCertificate, ok := msg.(*typesCons.QuorumCertificate)
+	if !ok {
+		return nil
+	}
+	return quorumCertificate
+}
+
+func (m *pacemaker) emitTimeoutTelemetry(reason string) {
+	consensusMod := m.GetBus().GetConsensusModule()
+	telemetryMod := m.GetBus().GetTelemetryModule()
+
+	telemetryMod.
+		GetTimeSeriesAgent().
+		CounterIncrement(
+			consensusTelemetry.CONSENSUS_PACEMAKER_TIMEOUT_COUNTER_NAME,
+		)
+
+	telemetryMod.
+		GetEventMetricsAgent().
+		EmitEvent(
+			consensusTelemetry.CONSENSUS_EVENT_METRICS_NAMESPACE,
+			consensusTelemetry.PACEMAKER_TIMEOUT_EVENT_METRIC_NAME,
+			consensusTelemetry.PACEMAKER_TIMEOUT_EVENT_METRIC_LABEL_HEIGHT,
+			consensusMod.CurrentHeight(),
+			consensusTelemetry.PACEMAKER_TIMEOUT_EVENT_METRIC_LABEL_ROUND,
+			consensusMod.CurrentRound(),
+			reason,
+		)
+}
+
+func (m *pacemaker) setFailedRoundsGauge() {
+	if _, err := m.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		GaugeSet(
+			consensusTelemetry.CONSENSUS_PACEMAKER_FAILED_ROUNDS_GAUGE_NAME,
+			float64(m.numFailedRounds),
+		); err != nil {
+		m.nodeLog(fmt.Sprintf("[WARN] Failed to set the failed rounds gauge: %v", err))
+	}
 }
 
 // TODO: Remove once we have a proper logging system.
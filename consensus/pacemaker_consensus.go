@@ -22,6 +22,8 @@ func (m *consensusModule) ResetForNewHeight() {
 	m.block = nil
 	m.prepareQC = nil
 	m.lockedQC = nil
+	m.clearTimeoutPool()
+	m.refreshNodeId()
 }
 
 // This function releases consensus module's utility context, called by pacemaker module
@@ -47,6 +49,10 @@ func (m *consensusModule) BroadcastMessageToValidators(msg *anypb.Any) error {
 	if !ok {
 		return fmt.Errorf("failed to cast message to HotstuffMessage")
 	}
+	// The pacemaker does not hold the key of the node, so the NEWROUND messages it starts the views with are signed here
+	if broadcastMessage.GetStep() == NewRound {
+		SignNewRoundMessage(broadcastMessage, m.privateKey)
+	}
 	m.broadcastToValidators(broadcastMessage)
 
 	return nil
@@ -0,0 +1,150 @@
+package consensus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/codec"
+	"github.com/pokt-network/pocket/shared/converters"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// isQuorumMet checks that the signers hold more than 2/3 of the voting power of the validators, following the quorum
+// type of the node
+func (m *consensusModule) isQuorumMet(signers []string, validators []*coreTypes.Actor) error {
+	return IsOptimisticThresholdMet(signers, validators, m.consCfg.GetQuorumType())
+}
+
+// IsOptimisticThresholdMet checks that the signers hold more than 2/3 of the voting power of the validators. The
+// voting power of a validator is its stake with a stake weighted quorum, and a single vote otherwise. Signers that are
+// not validators or appear more than once are not counted.
+// It is exported so light clients verify quorum certificates with the same rule as the validators.
+func IsOptimisticThresholdMet(signers []string, validators []*coreTypes.Actor, quorumType configs.QuorumType) error {
+	validatorPowers := make(map[string]*big.Int, len(validators))
+	totalPower := big.NewInt(0)
+	for _, validator := range validators {
+		power, err := getVotingPower(validator, quorumType)
+		if err != nil {
+			return err
+		}
+		validatorPowers[validator.GetAddress()] = power
+		totalPower.Add(totalPower, power)
+	}
+
+	signersPower := big.NewInt(0)
+	for _, signer := range signers {
+		power, ok := validatorPowers[signer]
+		if !ok {
+			continue
+		}
+		signersPower.Add(signersPower, power)
+		delete(validatorPowers, signer)
+	}
+
+	// signersPower > 2/3 * totalPower, without rounding
+	if new(big.Int).Mul(signersPower, big.NewInt(3)).Cmp(new(big.Int).Mul(totalPower, big.NewInt(2))) <= 0 {
+		return typesCons.ErrByzantineThresholdCheck(signersPower, totalPower)
+	}
+	return nil
+}
+
+func getVotingPower(validator *coreTypes.Actor, quorumType configs.QuorumType) (*big.Int, error) {
+	if quorumType == configs.QuorumType_ValidatorCountQuorum {
+		return big.NewInt(1), nil
+	}
+	return converters.StringToBigInt(validator.GetStakedAmount())
+}
+
+// getPartialSignatureSigners returns the addresses of the signers of the partial signatures
+func getPartialSignatureSigners(partialSigs []*typesCons.PartialSignature) []string {
+	signers := make([]string, 0, len(partialSigs))
+	for _, partialSig := range partialSigs {
+		signers = append(signers, partialSig.GetAddress())
+	}
+	return signers
+}
+
+// getMessagePartialSignature returns the partial signature of a vote, timeout or NEWROUND message
+func getMessagePartialSignature(msg *typesCons.HotstuffMessage) *typesCons.PartialSignature {
+	if msg.GetStep() == NewRound {
+		return msg.GetNewRoundSignature()
+	}
+	return msg.GetPartialSignature()
+}
+
+// getMessageSigners returns the addresses of the signers of the partial signatures of the messages
+func getMessageSigners(msgs []*typesCons.HotstuffMessage) []string {
+	signers := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		if partialSig := getMessagePartialSignature(msg); partialSig != nil {
+			signers = append(signers, partialSig.GetAddress())
+		}
+	}
+	return signers
+}
+
+// getMissingSigners returns the addresses of the validators of the committed block at `height` whose signatures are
+// missing from its commit quorum certificate, sorted so that every node hands the same signers to utility. Only the
+// valid signatures of the certificate are counted, so the leader cannot cover a validator that did not sign.
+func (m *consensusModule) getMissingSigners(height uint64) ([][]byte, error) {
+	// The genesis block is not certified
+	if height == 0 {
+		return nil, nil
+	}
+	block, err := m.GetBus().GetPersistenceModule().GetBlock(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	qc := new(typesCons.QuorumCertificate)
+	if err := codec.GetCodec().Unmarshal(block.GetBlockHeader().GetQuorumCertificate(), qc); err != nil {
+		return nil, err
+	}
+	thresholdSig := qc.GetThresholdSignature()
+	if thresholdSig == nil {
+		return nil, nil
+	}
+	validators, err := m.getValidatorsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// The aggregate signature of the signers was verified when the block was committed
+	var signers []string
+	if len(thresholdSig.GetAggregateSignature()) > 0 {
+		if signers, err = getSigners(thresholdSig.GetSignerBitmap(), validators); err != nil {
+			return nil, err
+		}
+	} else {
+		msgToJustify := qcToHotstuffMessage(qc)
+		validatorMap := typesCons.NewActorMapper(validators).GetValidatorMap()
+		for _, partialSig := range thresholdSig.GetSignatures() {
+			validator, ok := validatorMap[partialSig.GetAddress()]
+			if !ok || !isSignatureValid(msgToJustify, validator.GetPublicKey(), partialSig.GetSignature()) {
+				continue
+			}
+			signers = append(signers, partialSig.GetAddress())
+		}
+	}
+
+	signed := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		signed[signer] = struct{}{}
+	}
+	missingSigners := make([][]byte, 0)
+	for _, validator := range validators {
+		if _, ok := signed[validator.GetAddress()]; ok {
+			continue
+		}
+		address, err := hex.DecodeString(validator.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		missingSigners = append(missingSigners, address)
+	}
+	sort.Slice(missingSigners, func(i, j int) bool { return bytes.Compare(missingSigners[i], missingSigners[j]) < 0 })
+	return missingSigners, nil
+}
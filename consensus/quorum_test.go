@@ -0,0 +1,40 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsOptimisticThresholdMet(t *testing.T) {
+	whale, valA, valB, valC := newTestingActor("whale"), newTestingActor("a"), newTestingActor("b"), newTestingActor("c")
+	whale.StakedAmount = "6000"
+	for _, validator := range []*coreTypes.Actor{valA, valB, valC} {
+		validator.StakedAmount = "1000"
+	}
+	validators := []*coreTypes.Actor{whale, valA, valB, valC}
+
+	tests := []struct {
+		name              string
+		signers           []string
+		stakeWeightedMet  bool
+		validatorCountMet bool
+	}{
+		{"exactly 2/3 of the stake is not enough", []string{whale.GetAddress()}, false, false},
+		{"whale and a single validator", []string{whale.GetAddress(), valA.GetAddress()}, true, false},
+		{"every validator but the whale", []string{valA.GetAddress(), valB.GetAddress(), valC.GetAddress()}, false, true},
+		{"duplicate signers are counted once", []string{valA.GetAddress(), valA.GetAddress(), valA.GetAddress()}, false, false},
+		{"unknown signers are ignored", []string{"unknown", "unknown2", "unknown3", valA.GetAddress()}, false, false},
+		{"every validator", []string{whale.GetAddress(), valA.GetAddress(), valB.GetAddress(), valC.GetAddress()}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := IsOptimisticThresholdMet(tt.signers, validators, configs.QuorumType_StakeWeightedQuorum)
+			require.Equal(t, tt.stakeWeightedMet, err == nil, err)
+			err = IsOptimisticThresholdMet(tt.signers, validators, configs.QuorumType_ValidatorCountQuorum)
+			require.Equal(t, tt.validatorCountMet, err == nil, err)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package state_sync
+
+import (
+	"sort"
+	"time"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+)
+
+/*
+	The client side of state sync catches a lagging node up with its peers:
+
+	1. Consensus calls `TriggerSync` when it receives a message for a height ahead of the node, which asks
+	   the peers for the range of blocks in their block store
+	2. The missing heights, starting at the current height of consensus, are requested in parallel from
+	   random eligible peers (i.e. `MinHeight <= height <= MaxHeight`), with at most `maxInFlightBlockRequests`
+	   requests in flight; unanswered requests are sent to another peer after `blockRequestTimeout`, by a timer of
+	   the runtime clock if no other response is received meanwhile
+	3. The received blocks are applied sequentially by consensus, which validates the commit quorum certificate
+	   of each block against the validator set at its height before applying it through utility
+	4. A peer that does not answer a request in time, or answers with an invalid block, is not trusted with
+	   that height and the heights above it until the end of the sync, whatever it advertises. Peers are
+	   identified by the address they authenticated with to the P2P module rather than by the peer id they
+	   claim, and only the peer a block was requested from can answer the request.
+	5. Once the node is past the highest block its peers are trusted with, control is handed back to HotStuff
+*/
+
+func (m *stateSync) IsSyncing() bool {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	return m.syncing
+}
+
+func (m *stateSync) TriggerSync() error {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	now := m.now()
+	if m.syncing && now.Sub(m.lastMetadataRequest) < metadataRequestInterval {
+		m.requestMissingBlocks()
+		return nil
+	}
+
+	consensusMod := m.GetBus().GetConsensusModule()
+	if !m.syncing {
+		m.nodeLog(typesCons.StateSyncStarted(consensusMod.GetSyncHeight()))
+		m.peersFailedHeight = make(map[string]uint64)
+	}
+	m.syncing = true
+	m.lastMetadataRequest = now
+
+	return m.broadcast(&typesCons.StateSyncMessage{
+		Message: &typesCons.StateSyncMessage_MetadataReq{
+			MetadataReq: &typesCons.StateSyncMetadataRequest{
+				PeerId: consensusMod.GetNodeAddress(),
+			},
+		},
+	})
+}
+
+func (m *stateSync) HandleStateSyncMetadataResponse(res *typesCons.StateSyncMetadataResponse) error {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if res.GetMinHeight() > res.GetMaxHeight() {
+		return typesCons.ErrInvalidStateSyncMetadata(res.GetPeerId(), res.GetMinHeight(), res.GetMaxHeight())
+	}
+	m.peersMetadata[res.GetPeerId()] = res
+
+	if m.syncing {
+		m.requestMissingBlocks()
+	}
+	return nil
+}
+
+func (m *stateSync) HandleGetBlockResponse(res *typesCons.GetBlockResponse) error {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	blockHeader := res.GetBlock().GetBlockHeader()
+	if blockHeader == nil {
+		return typesCons.ErrNilBlock
+	}
+
+	// The block was not requested from the peer or was already applied
+	height := blockHeader.GetHeight()
+	if !m.syncing || height < m.GetBus().GetConsensusModule().GetSyncHeight() {
+		return nil
+	}
+	if request, ok := m.blockRequests[height]; !ok || request.peerId != res.GetPeerId() {
+		return nil
+	}
+
+	delete(m.blockRequests, height)
+	m.pendingBlocks[height] = res
+
+	// The heights of invalid blocks are requested again from other peers
+	err := m.applyPendingBlocks()
+	m.requestMissingBlocks()
+	return err
+}
+
+// applyPendingBlocks applies the received blocks in order, starting at the current height of consensus, and
+// hands control back to HotStuff once the node caught up with its peers
+func (m *stateSync) applyPendingBlocks() error {
+	consensusMod := m.GetBus().GetConsensusModule()
+	for {
+		height := consensusMod.GetSyncHeight()
+		res, ok := m.pendingBlocks[height]
+		if !ok {
+			break
+		}
+		delete(m.pendingBlocks, height)
+
+		if err := consensusMod.ApplySyncedBlock(res.GetBlock()); err != nil {
+			m.peerFailedToServe(res.GetPeerId(), height)
+			return err
+		}
+		m.nodeLog(typesCons.StateSyncAppliedBlock(height, res.GetPeerId()))
+	}
+
+	if consensusMod.GetSyncHeight() > m.maxPeerHeight() {
+		m.syncing = false
+		m.stopRetryTimer()
+		m.blockRequests = make(map[uint64]blockRequest)
+		m.peersFailedHeight = make(map[string]uint64)
+		m.pendingBlocks = make(map[uint64]*typesCons.GetBlockResponse)
+		m.nodeLog(typesCons.StateSyncCompleted(consensusMod.GetSyncHeight()))
+		consensusMod.ResumeHotstuff()
+	}
+	return nil
+}
+
+// requestMissingBlocks requests the lowest missing heights, each from a random eligible peer, so that at most
+// `maxInFlightBlockRequests` requests are in flight
+func (m *stateSync) requestMissingBlocks() {
+	consensusMod := m.GetBus().GetConsensusModule()
+	now := m.now()
+	numInFlight := 0
+	for height := consensusMod.GetSyncHeight(); height <= m.maxPeerHeight() && numInFlight < maxInFlightBlockRequests; height++ {
+		if _, ok := m.pendingBlocks[height]; ok {
+			continue
+		}
+		if request, ok := m.blockRequests[height]; ok {
+			if now.Sub(request.requestedAt) < blockRequestTimeout {
+				numInFlight++
+				continue
+			}
+			m.peerFailedToServe(request.peerId, height)
+			delete(m.blockRequests, height)
+		}
+		peerId, ok := m.getRandomEligiblePeer(height)
+		if !ok {
+			continue
+		}
+		m.blockRequests[height] = blockRequest{peerId: peerId, requestedAt: now}
+		numInFlight++
+
+		m.nodeLog(typesCons.StateSyncRequestingBlock(height, peerId))
+		if err := m.sendToPeer(peerId, &typesCons.StateSyncMessage{
+			Message: &typesCons.StateSyncMessage_GetBlockReq{
+				GetBlockReq: &typesCons.GetBlockRequest{
+					PeerId: consensusMod.GetNodeAddress(),
+					Height: height,
+				},
+			},
+		}); err != nil {
+			m.nodeLogError(typesCons.ErrSendMessage.Error(), err)
+		}
+	}
+	m.scheduleRetry()
+}
+
+func (m *stateSync) RetryBlockRequests() {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	m.retryTimer = nil
+	if m.syncing {
+		m.requestMissingBlocks()
+	}
+}
+
+// scheduleRetry arms the retry timer for when the first block request in flight times out. The timer goes through
+// the consensus module, which holds its lock before state sync's like its other entrypoints.
+func (m *stateSync) scheduleRetry() {
+	m.stopRetryTimer()
+	var firstRequestedAt time.Time
+	for _, request := range m.blockRequests {
+		if firstRequestedAt.IsZero() || request.requestedAt.Before(firstRequestedAt) {
+			firstRequestedAt = request.requestedAt
+		}
+	}
+	if firstRequestedAt.IsZero() {
+		return
+	}
+	delay := firstRequestedAt.Add(blockRequestTimeout).Sub(m.now())
+	m.retryTimer = m.GetBus().GetRuntimeMgr().GetClock().AfterFunc(delay, func() {
+		m.GetBus().GetConsensusModule().RetryBlockRequests()
+	})
+}
+
+func (m *stateSync) stopRetryTimer() {
+	if m.retryTimer != nil {
+		m.retryTimer.Stop()
+		m.retryTimer = nil
+	}
+}
+
+// maxPeerHeight returns the highest height any peer is trusted to serve
+func (m *stateSync) maxPeerHeight() (maxHeight uint64) {
+	for peerId, metadata := range m.peersMetadata {
+		peerMaxHeight, ok := m.getPeerMaxHeight(peerId, metadata)
+		if ok && peerMaxHeight > maxHeight {
+			maxHeight = peerMaxHeight
+		}
+	}
+	return
+}
+
+// getPeerMaxHeight returns the highest height the peer advertised, below the lowest height it failed to serve.
+// It returns false if the peer is not trusted with any height.
+func (m *stateSync) getPeerMaxHeight(peerId string, metadata *typesCons.StateSyncMetadataResponse) (uint64, bool) {
+	maxHeight := metadata.GetMaxHeight()
+	if failedHeight, ok := m.peersFailedHeight[peerId]; ok && failedHeight <= maxHeight {
+		if failedHeight <= metadata.GetMinHeight() {
+			return 0, false
+		}
+		maxHeight = failedHeight - 1
+	}
+	return maxHeight, true
+}
+
+// peerFailedToServe stops trusting the peer with `height` and the heights above it until the end of the sync
+func (m *stateSync) peerFailedToServe(peerId string, height uint64) {
+	if failedHeight, ok := m.peersFailedHeight[peerId]; ok && failedHeight <= height {
+		return
+	}
+	m.peersFailedHeight[peerId] = height
+	m.nodeLog(typesCons.StateSyncPeerFailedToServe(height, peerId))
+}
+
+// Random selection of eligible peers enables a fair distribution of block requests over time via the law of
+// large numbers. The generator of the runtime manager is used so the selection can be seeded while testing.
+func (m *stateSync) getRandomEligiblePeer(height uint64) (string, bool) {
+	eligiblePeers := make([]string, 0, len(m.peersMetadata))
+	for peerId, metadata := range m.peersMetadata {
+		peerMaxHeight, ok := m.getPeerMaxHeight(peerId, metadata)
+		if ok && metadata.GetMinHeight() <= height && height <= peerMaxHeight {
+			eligiblePeers = append(eligiblePeers, peerId)
+		}
+	}
+	if len(eligiblePeers) == 0 {
+		return "", false
+	}
+	sort.Strings(eligiblePeers)
+	return eligiblePeers[m.GetBus().GetRuntimeMgr().GetRand().Intn(len(eligiblePeers))], true
+}
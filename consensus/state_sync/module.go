@@ -1,12 +1,32 @@
 package state_sync
 
 import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
 	typesCons "github.com/pokt-network/pocket/consensus/types"
-	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/codec"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 )
 
+const (
+	// The maximum number of blocks requested from peers at the same time
+	maxInFlightBlockRequests = 16
+	// A block request that is not answered within this duration is sent to another eligible peer
+	blockRequestTimeout = 5 * time.Second
+	// While syncing, the peers' metadata is not requested more often than this
+	metadataRequestInterval = 5 * time.Second
+)
+
+var (
+	_ modules.Module        = &stateSync{}
+	_ StateSyncServerModule = &stateSync{}
+	_ StateSyncModule       = &stateSync{}
+)
+
 // This module is responsible for handling requests and business logic that advertises and shares
 // local state metadata with other peers synching to the latest block.
 type StateSyncServerModule interface {
@@ -21,106 +41,151 @@ type StateSyncServerModule interface {
 
 type StateSyncModule interface {
 	modules.Module
+	StateSyncServerModule
+
+	// Dispatches a state sync message received from the peer at `sender` to the server or client handler. The peer id
+	// of the responses is set to `sender`, unless it is empty because the transport does not authenticate its peers.
+	HandleStateSyncMessage(msg *typesCons.StateSyncMessage, sender string) error
 
 	// Handle a metadata response from a peer so this node can update its local view of the state
 	// sync metadata available from its peers
 	HandleStateSyncMetadataResponse(*typesCons.StateSyncMetadataResponse) error
 
-	// Handle a block response from a peer so this node can update apply it to its local state
+	// Handle a block response from a peer so this node can apply it to its local state
 	// and catch up to the global world state
-	HandleStateSyncBlockResponse(*typesCons.StateSyncMetadataResponse) error
-}
-
-// ~~~~~ TODO(#362): The interface below is only meant to be used as a guideline and not implemented explicitly. It will updated & removed over time. ~~~~~
-type StateSyncModuleLEGACY interface {
-	// -- Constructor Setter Functions --
-
-	// `HandleStateSync` function:
-	// - Create a Utility Context
-	// - Block.ValidateBasic()
-	// - Consensus Module Replica Path
-	//   - Prepare Block:  utilityContext.SetProposalBlock(block)
-	//   - Apply Block:    utilityContext.ApplyBlock(block)
-	//   - Validate Block: utilityContext.AppHash == Block.AppHash
-	//   - Store Block:    consensusModule.CommitBlock()
-	HandleStateSyncMessage(msg BlockResponseMessage)
-
-	// `GetPeerSyncMeta` function:
-	// - Retrieve a list of active peers with their metadata (identified and retrieved through P2P's `Churn Management`)
-	GetPeerMetadata(GetPeerSyncMeta func() (peers []PeerSyncMeta, err error))
-
-	// `NetworkSend` function contract:
-	// - sends data to an address via P2P network
-	NetworkSend(NetworkSend func(data []byte, address cryptoPocket.Address) error)
-
-	// -- Sync modes --
-
-	// In the StateSync protocol, the Node fields valid BlockRequests from its peers to help them CatchUp to be Synced.
-	// This sub-protocol is continuous throughout the lifecycle of StateSync.
-	RunServerMode()
-
-	// In SyncedMode, the Node is caught up to the latest block and is listening & waiting for the latest block to be passed
-	// to maintain a synchronous state with the global SyncState.
-	// - UpdatePeerMetadata from P2P module
-	// - UpdateSyncState
-	// - Rely on new blocks to be propagated via the P2P network after Validators reach consensus
-	// - If `localSyncState.Height < globalNetworkSyncState.Height` -> RunSyncMode() // careful about race-conditions
-	RunSyncedMode()
-
-	// Runs sync mode 'service' that continuously runs while `localSyncState.Height < globalNetworkSyncState.Height`
-	// - UpdatePeerMetadata from P2P module
-	// - Retrieve missing blocks from peers
-	// - Process retrieved blocks
-	// - UpdateSyncState
-	// - If `localSyncState.Height == globalNetworkSyncState.Height` -> RunSyncedMode()
-	RunSyncMode()
-
-	// Returns the `highest priority aka lowest height` missing block heights up to `max` heights
-	GetMissingBlockHeights(state SyncState, max int) (blockHeights []int64, err error)
-
-	// Random selection of eligilbe peers enables a fair distribution of blockRequests over time via law of large numbers
-	// An eligible peer is when `PeerMeta.MinHeight <= blockHeight <= PeerMeta.MaxHeight`
-	GetRandomEligiblePeersForHeight(blockHeight int64) (eligiblePeer PeerSyncMeta, err error)
-
-	// Uses `NetworkSend` to send a `BlockRequestMessage` to a specific peer
-	SendBlockRequest(peerId string) error
-
-	// Uses 'NetworkSend' to send a `BlockResponseMessage` to a specific peer
-	// This function is used in 'ServerMode()'
-	HandleBlockRequest(message BlockRequestMessage) error
-
-	// Uses `HandleBlock` to process retrieved blocks from peers
-	// Must update sync state using `SetMissingBlockHeight`
-	ProcessBlock(block *coreTypes.Block) error
-}
-
-type SyncState interface {
-	// latest local height
-	LatestHeight() int64
-	// latest network height (from the aggregation of Peer Sync Meta)
-	LatestNetworkHeight() int64
-	// retrieve peer meta (actively updated through churn management)
-	GetPeers() []PeerSyncMeta
-	// returns ordered array of missing block heights
-	GetMissingBlockHeights() []int64
-}
-
-type BlockRequestMessage interface {
-	// the height the peer wants from the block store
-	GetHeight() int64
-}
-
-type BlockResponseMessage interface {
-	// the bytes of the requested block from the block store
-	GetBlockBytes() []byte
-}
-
-// TODO: needs to be shared between P2P as the Churn Management Process updates this information
-type PeerSyncMeta interface {
-	// the unique identifier associated with the peer
-	GetPeerID() string
-	// the maximum height the peer has in the block store
-	GetMaxHeight() int64
-	// the minimum height the peer has in the block store
-	GetMinHeight() int64
+	HandleGetBlockResponse(*typesCons.GetBlockResponse) error
+
+	// Called when a consensus message shows that the network is ahead of this node; asks the peers for
+	// the blocks they have so the node can catch up before participating in consensus again
+	TriggerSync() error
+
+	// Returns true while the node is catching up with its peers
+	IsSyncing() bool
+
+	// Requests the blocks whose requests timed out from other peers
+	RetryBlockRequests()
+}
+
+type stateSync struct {
+	bus modules.Bus
+
+	// m protects the client state below, which is updated by the responses of the peers
+	m sync.Mutex
+
+	syncing             bool
+	lastMetadataRequest time.Time
+
+	// The latest metadata advertised by each peer, indexed by peer id
+	peersMetadata map[string]*typesCons.StateSyncMetadataResponse
+	// The lowest height each peer failed to serve during the current sync, indexed by peer id. It caps the heights
+	// the peer advertises, so a peer cannot keep the node syncing by advertising blocks it does not have.
+	peersFailedHeight map[string]uint64
+	// The peer and time at which each missing block was requested, indexed by height
+	blockRequests map[uint64]blockRequest
+	// The blocks received from peers that cannot be applied yet because a lower height is missing
+	pendingBlocks map[uint64]*typesCons.GetBlockResponse
+	// Fires when the first block request in flight times out, so it is sent to another peer even if no other
+	// response is received
+	retryTimer *clock.Timer
+
+	// REFACTOR: this should be removed, when we build a shared and proper logger
+	logPrefix string
+}
+
+type blockRequest struct {
+	peerId      string
+	requestedAt time.Time
+}
+
+func CreateStateSync(bus modules.Bus) (modules.Module, error) {
+	return new(stateSync).Create(bus)
+}
+
+func (*stateSync) Create(bus modules.Bus) (modules.Module, error) {
+	m := &stateSync{
+		peersMetadata:     make(map[string]*typesCons.StateSyncMetadataResponse),
+		peersFailedHeight: make(map[string]uint64),
+		blockRequests:     make(map[uint64]blockRequest),
+		pendingBlocks:     make(map[uint64]*typesCons.GetBlockResponse),
+		logPrefix:         "STATE_SYNC",
+	}
+	bus.RegisterModule(m)
+	return m, nil
+}
+
+func (m *stateSync) Start() error {
+	return nil
+}
+
+func (m *stateSync) Stop() error {
+	return nil
+}
+
+func (m *stateSync) GetModuleName() string {
+	return modules.StateSyncModuleName
+}
+
+func (m *stateSync) SetBus(pocketBus modules.Bus) {
+	m.bus = pocketBus
+}
+
+func (m *stateSync) GetBus() modules.Bus {
+	if m.bus == nil {
+		log.Fatalf("PocketBus is not initialized")
+	}
+	return m.bus
+}
+
+func (m *stateSync) HandleStateSyncMessage(msg *typesCons.StateSyncMessage, sender string) error {
+	switch msg.GetMessage().(type) {
+	case *typesCons.StateSyncMessage_MetadataReq:
+		return m.HandleStateSyncMetadataRequest(msg.GetMetadataReq())
+	case *typesCons.StateSyncMessage_MetadataRes:
+		res := msg.GetMetadataRes()
+		if sender != "" {
+			res.PeerId = sender
+		}
+		return m.HandleStateSyncMetadataResponse(res)
+	case *typesCons.StateSyncMessage_GetBlockReq:
+		return m.HandleGetBlockRequest(msg.GetGetBlockReq())
+	case *typesCons.StateSyncMessage_GetBlockRes:
+		res := msg.GetGetBlockRes()
+		if sender != "" {
+			res.PeerId = sender
+		}
+		return m.HandleGetBlockResponse(res)
+	default:
+		return typesCons.ErrUnknownStateSyncMessageType(msg.GetMessage())
+	}
+}
+
+// CONSIDERATION(#347): The peer id is the address of the peer until the P2P module populates it
+func (m *stateSync) sendToPeer(peerId string, msg *typesCons.StateSyncMessage) error {
+	anyMsg, err := codec.GetCodec().ToAny(msg)
+	if err != nil {
+		return err
+	}
+	return m.GetBus().GetP2PModule().Send(cryptoPocket.AddressFromString(peerId), anyMsg)
+}
+
+func (m *stateSync) broadcast(msg *typesCons.StateSyncMessage) error {
+	anyMsg, err := codec.GetCodec().ToAny(msg)
+	if err != nil {
+		return err
+	}
+	return m.GetBus().GetP2PModule().Broadcast(anyMsg)
+}
+
+// TODO(#164): Remove this once we have a proper logging system.
+func (m *stateSync) nodeLog(s string) {
+	log.Printf("[%s] %s\n", m.logPrefix, s)
+}
+
+// TODO(#164): Remove this once we have a proper logging system.
+func (m *stateSync) nodeLogError(s string, err error) {
+	log.Printf("🐞[ERROR][%s] %s: %v\n", m.logPrefix, s, err)
+}
+
+func (m *stateSync) now() time.Time {
+	return m.GetBus().GetRuntimeMgr().GetClock().Now()
 }
@@ -0,0 +1,49 @@
+package state_sync
+
+import (
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+)
+
+// HandleStateSyncMetadataRequest advertises the range of blocks available in the local block store to the
+// requesting peer
+func (m *stateSync) HandleStateSyncMetadataRequest(req *typesCons.StateSyncMetadataRequest) error {
+	readCtx, err := m.GetBus().GetPersistenceModule().NewReadContext(-1) // Unknown height
+	if err != nil {
+		return err
+	}
+	defer readCtx.Close()
+
+	maxHeight, err := readCtx.GetLatestBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	// The block store is never pruned, so every block since genesis is available
+	return m.sendToPeer(req.GetPeerId(), &typesCons.StateSyncMessage{
+		Message: &typesCons.StateSyncMessage_MetadataRes{
+			MetadataRes: &typesCons.StateSyncMetadataResponse{
+				PeerId:    m.GetBus().GetConsensusModule().GetNodeAddress(),
+				MinHeight: 0,
+				MaxHeight: maxHeight,
+			},
+		},
+	})
+}
+
+// HandleGetBlockRequest sends the committed block at the requested height, along with the quorum certificate
+// in its header, to the requesting peer
+func (m *stateSync) HandleGetBlockRequest(req *typesCons.GetBlockRequest) error {
+	block, err := m.GetBus().GetPersistenceModule().GetBlock(int64(req.GetHeight()))
+	if err != nil {
+		return typesCons.ErrGetSyncedBlock(req.GetHeight(), err)
+	}
+
+	return m.sendToPeer(req.GetPeerId(), &typesCons.StateSyncMessage{
+		Message: &typesCons.StateSyncMessage_GetBlockRes{
+			GetBlockRes: &typesCons.GetBlockResponse{
+				PeerId: m.GetBus().GetConsensusModule().GetNodeAddress(),
+				Block:  block,
+			},
+		},
+	})
+}
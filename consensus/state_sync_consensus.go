@@ -0,0 +1,110 @@
+package consensus
+
+import (
+	"fmt"
+
+	consensusTelemetry "github.com/pokt-network/pocket/consensus/telemetry"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Implementations of the type ConsensusStateSync interface, called by the state sync submodule
+
+func (m *consensusModule) GetNodeAddress() string {
+	return m.privateKey.Address().String()
+}
+
+func (m *consensusModule) GetSyncHeight() uint64 {
+	if m.isChainedHotstuff() && m.height > m.chained.committedHeight+1 {
+		return m.chained.committedHeight + 1
+	}
+	return m.height
+}
+
+// ApplySyncedBlock applies a block committed by the network while this node was behind. The block is only
+// applied if its commit QC is signed by 2/3+ of the validators at its height, in which case the block
+// certified by the QC (i.e. the proposal including its transactions and evidence) is applied and committed.
+// With chained HotStuff, the QC stored in the block is the QC of the block itself, and the node may already be
+// deciding the later heights.
+func (m *consensusModule) ApplySyncedBlock(block *coreTypes.Block) error {
+	height := block.GetBlockHeader().GetHeight()
+	if height != m.GetSyncHeight() {
+		return typesCons.ErrInvalidSyncedBlock(height, fmt.Errorf("node is at height %d", m.GetSyncHeight()))
+	}
+	isChained := m.isChainedHotstuff()
+	commitStep := Commit
+	if isChained {
+		commitStep = Prepare
+	}
+
+	commitQC := new(typesCons.QuorumCertificate)
+	if err := codec.GetCodec().Unmarshal(block.GetBlockHeader().GetQuorumCertificate(), commitQC); err != nil {
+		return typesCons.ErrInvalidSyncedBlock(height, err)
+	}
+	if commitQC.GetHeight() != height || commitQC.GetStep() != commitStep {
+		return typesCons.ErrInvalidSyncedBlock(height, fmt.Errorf("expected a %s QC at height %d but got a %s QC at height %d",
+			typesCons.StepToString[commitStep], height, typesCons.StepToString[commitQC.GetStep()], commitQC.GetHeight()))
+	}
+
+	// Chained proposals do not carry the state hash of the block, which is only known once it is applied
+	certifiedBlock := commitQC.GetBlock()
+	if certifiedBlock.GetBlockHeader().GetHeight() != height ||
+		(!isChained && certifiedBlock.GetBlockHeader().GetStateHash() != block.GetBlockHeader().GetStateHash()) {
+		return typesCons.ErrInvalidSyncedBlock(height, fmt.Errorf("the block certified by the QC is not the synced block"))
+	}
+
+	// The node has committed every block before this one, so the QC can be validated against the validator set at its height
+	if err := m.validateQuorumCertificate(commitQC); err != nil {
+		return typesCons.ErrInvalidSyncedBlock(height, err)
+	}
+
+	if err := m.refreshUtilityContextAtHeight(height); err != nil {
+		return err
+	}
+	if err := m.applyBlock(certifiedBlock); err != nil {
+		if releaseErr := m.ReleaseUtilityContext(); releaseErr != nil {
+			m.nodeLogError("Failed to release utility context", releaseErr)
+		}
+		return typesCons.ErrInvalidSyncedBlock(height, err)
+	}
+	if err := m.commitBlock(certifiedBlock, commitQC); err != nil {
+		return err
+	}
+
+	if isChained {
+		m.chained.prune(height)
+	}
+	if m.height <= height {
+		m.height = height + 1
+		m.ResetForNewHeight()
+		m.clearLeader()
+		m.clearMessagesPool()
+	}
+
+	m.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		CounterIncrement(
+			consensusTelemetry.CONSENSUS_BLOCKCHAIN_HEIGHT_COUNTER_NAME,
+		)
+
+	return nil
+}
+
+// ResumeHotstuff starts a new round at the height the node caught up to. If no message from the current view
+// reaches the node before the pacemaker times out, the node moves to the next view like the other replicas.
+func (m *consensusModule) ResumeHotstuff() {
+	m.step = NewRound
+	m.paceMaker.RestartTimer()
+}
+
+func (m *consensusModule) RetryBlockRequests() {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if m.haltErr != nil {
+		return
+	}
+	m.stateSync.RetryBlockRequests()
+}
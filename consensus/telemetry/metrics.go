@@ -9,6 +9,12 @@ const (
 	CONSENSUS_BLOCKCHAIN_HEIGHT_COUNTER_NAME        = "consensus_blockchain_height_counter"
 	CONSENSUS_BLOCKCHAIN_HEIGHT_COUNTER_DESCRIPTION = "the counter to track the height of the blockchain"
 
+	CONSENSUS_PACEMAKER_TIMEOUT_COUNTER_NAME        = "consensus_pacemaker_timeout_counter"
+	CONSENSUS_PACEMAKER_TIMEOUT_COUNTER_DESCRIPTION = "the counter to track the rounds the node gave up on"
+
+	CONSENSUS_PACEMAKER_FAILED_ROUNDS_GAUGE_NAME        = "consensus_pacemaker_failed_rounds_gauge"
+	CONSENSUS_PACEMAKER_FAILED_ROUNDS_GAUGE_DESCRIPTION = "the number of consecutive rounds that timed out since the last committed block"
+
 	// Event Metrics
 	CONSENSUS_EVENT_METRICS_NAMESPACE = "event_metrics_namespace_consensus"
 
@@ -16,4 +22,9 @@ const (
 	HOTPOKT_MESSAGE_EVENT_METRIC_LABEL_HEIGHT                 = "HEIGHT"
 	HOTPOKT_MESSAGE_EVENT_METRIC_LABEL_VALIDATOR_TYPE_LEADER  = "VALIDATOR_TYPE_LEADER"
 	HOTPOKT_MESSAGE_EVENT_METRIC_LABEL_VALIDATOR_TYPE_REPLICA = "VALIDATOR_TYPE_REPLICA"
+
+	// The timeouts of the node, labeled with the height and round it gave up on and the reason
+	PACEMAKER_TIMEOUT_EVENT_METRIC_NAME         = "pacemaker_timeout_event_metric"
+	PACEMAKER_TIMEOUT_EVENT_METRIC_LABEL_HEIGHT = "HEIGHT"
+	PACEMAKER_TIMEOUT_EVENT_METRIC_LABEL_ROUND  = "ROUND"
 )
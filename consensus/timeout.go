@@ -0,0 +1,142 @@
+package consensus
+
+/*
+	Timeout certificates (a.k.a. TimeoutQCs):
+
+	1. A node that gives up on a round, because its pacemaker timed out or the round cannot succeed anymore, broadcasts
+	   a TIMEOUT message signed over (height, round) to all the validators. The TIMEOUT message is broadcast again
+	   every time the pacemaker times out, until the node moves to another round.
+	2. Every node aggregates the TIMEOUT messages of its current round and of the next few rounds at its height. Once
+	   2/3+ of the validators timed out in a round, their signatures form a timeout certificate (TC) for the round and
+	   the node moves to the next round.
+	3. The NEWROUND messages of the next round carry the TC, so the validators that missed the TIMEOUT messages move
+	   to the next round together with the others.
+*/
+
+import (
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+)
+
+// The TIMEOUT messages of the rounds further ahead of the current round are discarded, so a validator cannot make the
+// node store its TIMEOUT messages for arbitrarily many rounds. A node that is further behind moves along with the
+// TC carried by the NEWROUND messages of the next round.
+const maxTimeoutRoundsAhead = 3
+
+// BroadcastTimeoutMessage signs a TIMEOUT message for the current round and broadcasts it to the validators. The
+// timeout of the node counts towards the TC of the round even if the message does not loop back to the node.
+func (m *consensusModule) BroadcastTimeoutMessage() error {
+	timeoutMessage := CreateTimeoutMessage(m.height, m.round, m.privateKey)
+	if m.blsPrivateKey != nil {
+		if err := SignVoteBLS(timeoutMessage, m.blsPrivateKey); err != nil {
+			return err
+		}
+	}
+	m.broadcastToValidators(timeoutMessage)
+	m.handleTimeoutMessage(timeoutMessage)
+	return nil
+}
+
+// handleTimeoutMessage aggregates the TIMEOUT messages of the current height and moves the node to the next round
+// once the TIMEOUT messages of a round form a TC. Timeouts are handled before the pacemaker checks since the node may
+// not have given up on the round yet.
+func (m *consensusModule) handleTimeoutMessage(msg *typesCons.HotstuffMessage) {
+	round := msg.GetRound()
+	if msg.GetHeight() != m.height || round < m.round {
+		m.nodeLog(typesCons.WarnDiscardHotstuffMessage(msg, "timeout is from another height or a past round"))
+		return
+	}
+	if round > m.round+maxTimeoutRoundsAhead {
+		m.nodeLog(typesCons.WarnDiscardHotstuffMessage(msg, "timeout is too far ahead of the current round"))
+		return
+	}
+
+	if err := m.validateMessageSignature(msg); err != nil {
+		m.nodeLogError(typesCons.ErrHotstuffValidation.Error(), err)
+		return
+	}
+
+	address := msg.GetPartialSignature().GetAddress()
+	for _, timeoutMessage := range m.timeoutPool[round] {
+		if timeoutMessage.GetPartialSignature().GetAddress() == address {
+			return
+		}
+	}
+	m.timeoutPool[round] = append(m.timeoutPool[round], msg)
+
+	tc, err := m.getTimeoutCertificate(round)
+	if err != nil {
+		m.nodeLog(typesCons.TimeoutCountWaiting(round, err.Error()))
+		return
+	}
+
+	m.paceMaker.AdvanceRound(tc)
+}
+
+// adoptTimeoutCertificate moves the node to the round after the round certified by a TC of its height, unless the
+// node already moved past it
+func (m *consensusModule) adoptTimeoutCertificate(tc *typesCons.TimeoutCertificate) {
+	if tc.GetHeight() != m.height || tc.GetRound() < m.round {
+		return
+	}
+	if err := m.validateTimeoutCertificate(tc); err != nil {
+		m.nodeLogError(typesCons.ErrInvalidTimeoutCertificate(tc.GetHeight(), tc.GetRound()).Error(), err)
+		return
+	}
+
+	m.paceMaker.AdvanceRound(tc)
+}
+
+func (m *consensusModule) getTimeoutCertificate(round uint64) (*typesCons.TimeoutCertificate, error) {
+	pss := make([]*typesCons.PartialSignature, 0, len(m.timeoutPool[round]))
+	for _, timeoutMessage := range m.timeoutPool[round] {
+		pss = append(pss, timeoutMessage.GetPartialSignature())
+	}
+
+	validators, err := m.getValidatorsAtHeight(m.height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.isQuorumMet(getPartialSignatureSigners(pss), validators); err != nil {
+		return nil, err
+	}
+
+	thresholdSig, err := getThresholdSignature(m.consCfg.GetThresholdSignatureScheme(), pss, validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typesCons.TimeoutCertificate{
+		Height:             m.height,
+		Round:              round,
+		ThresholdSignature: thresholdSig,
+	}, nil
+}
+
+func (m *consensusModule) validateTimeoutCertificate(tc *typesCons.TimeoutCertificate) error {
+	if tc.GetThresholdSignature() == nil {
+		return typesCons.ErrNilThresholdSigInTC
+	}
+	return m.validateThresholdSignature(tcToHotstuffMessage(tc), tc.GetThresholdSignature())
+}
+
+// pruneTimeoutPool drops the TIMEOUT messages of the rounds before the current one; called whenever the round changes
+func (m *consensusModule) pruneTimeoutPool() {
+	for round := range m.timeoutPool {
+		if round < m.round {
+			delete(m.timeoutPool, round)
+		}
+	}
+}
+
+func (m *consensusModule) clearTimeoutPool() {
+	m.timeoutPool = make(map[uint64][]*typesCons.HotstuffMessage)
+}
+
+func tcToHotstuffMessage(tc *typesCons.TimeoutCertificate) *typesCons.HotstuffMessage {
+	return &typesCons.HotstuffMessage{
+		Type:   Timeout,
+		Height: tc.GetHeight(),
+		Round:  tc.GetRound(),
+	}
+}
@@ -0,0 +1,45 @@
+package consensus
+
+import (
+	"fmt"
+	"testing"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTimeoutMessage_BoundedRounds(t *testing.T) {
+	privKeys := make([]cryptoPocket.PrivateKey, 4)
+	validators := make([]*coreTypes.Actor, 0, len(privKeys))
+	for i := range privKeys {
+		privKey, err := cryptoPocket.GeneratePrivateKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		validator := newTestingActor(fmt.Sprintf("val%d", i))
+		validator.Address = privKey.Address().String()
+		validator.PublicKey = privKey.PublicKey().String()
+		validators = append(validators, validator)
+	}
+	m := newTestingEpochModule(t, map[uint64][]*coreTypes.Actor{0: validators}, 1)
+	m.timeoutPool = make(map[uint64][]*typesCons.HotstuffMessage)
+	m.round = 2
+
+	// the timeouts of the current round and of the next few rounds are aggregated
+	m.handleTimeoutMessage(CreateTimeoutMessage(1, 2, privKeys[0]))
+	m.handleTimeoutMessage(CreateTimeoutMessage(1, 2+maxTimeoutRoundsAhead, privKeys[0]))
+	require.Len(t, m.timeoutPool[2], 1)
+	require.Len(t, m.timeoutPool[2+maxTimeoutRoundsAhead], 1)
+
+	// the timeouts of past rounds and of the rounds too far ahead are discarded
+	m.handleTimeoutMessage(CreateTimeoutMessage(1, 1, privKeys[1]))
+	m.handleTimeoutMessage(CreateTimeoutMessage(1, 3+maxTimeoutRoundsAhead, privKeys[1]))
+	m.handleTimeoutMessage(CreateTimeoutMessage(1, 1<<62, privKeys[1]))
+	require.Len(t, m.timeoutPool, 2)
+
+	// the timeouts of the rounds before the current one are pruned when the round changes
+	m.SetRound(3)
+	require.NotContains(t, m.timeoutPool, uint64(2))
+	require.Len(t, m.timeoutPool[2+maxTimeoutRoundsAhead], 1)
+}
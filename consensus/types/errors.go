@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/big"
+	"time"
 
 	"github.com/pokt-network/pocket/shared/codec"
 	"google.golang.org/protobuf/proto"
@@ -50,6 +52,10 @@ func PacemakerTimeout(height uint64, step HotstuffStep, round uint64) string {
 	return fmt.Sprintf("⌛ Timed out ⌛ at (height, step, round) (%d, %s, %d)!", height, StepToString[step], round)
 }
 
+func PacemakerTimeoutCertificate(height, round, numFailedRounds uint64) string {
+	return fmt.Sprintf("🚦 Timeout certificate 🚦 for (height, round) (%d, %d); %d consecutive rounds failed", height, round, numFailedRounds)
+}
+
 func PacemakerNewHeight(height uint64) string {
 	return fmt.Sprintf("🏁 Starting 1st round 🏁 for height: %d", height)
 }
@@ -62,6 +68,10 @@ func OptimisticVoteCountWaiting(step HotstuffStep, status string) string {
 	return fmt.Sprintf("⏳ Waiting ⏳for more %s messages; %s", StepToString[step], status)
 }
 
+func TimeoutCountWaiting(round uint64, status string) string {
+	return fmt.Sprintf("⏳ Waiting ⏳for more TIMEOUT messages for round %d; %s", round, status)
+}
+
 func OptimisticVoteCountPassed(height uint64, step HotstuffStep, round uint64) string {
 	return fmt.Sprintf("📬 Received enough 📬 votes at (height, step, round) (%d, %s, %d)", height, StepToString[step], round)
 }
@@ -78,6 +88,10 @@ func ElectedSelfAsNewLeader(address string, nodeId NodeId, height, round uint64)
 	return fmt.Sprintf("👑 I am the leader 👑 for height/round %d/%d: [%d] (%s)", height, round, nodeId, address)
 }
 
+func NotElectedLeader(height, round uint64) string {
+	return fmt.Sprintf("🙇 Not eligible to lead 🙇 height/round %d/%d; waiting for the proposal of the elected leader", height, round)
+}
+
 func SendingMessage(msg *HotstuffMessage, nodeId NodeId) string {
 	return fmt.Sprintf("✉️ Sending message ✉️ to %d at (height, step, round) (%d, %d, %d)", nodeId, msg.Height, msg.Step, msg.Round)
 }
@@ -118,6 +132,34 @@ func DebugNodeState(state ConsensusNodeState) string {
 	return fmt.Sprintf("🔎 [DEBUG] Node %d is at (Height, Step, Round): (%d, %d, %d)", state.NodeId, state.Height, state.Step, state.Round)
 }
 
+func StateSyncStarted(height uint64) string {
+	return fmt.Sprintf("🔄 Starting state sync 🔄 at height %d", height)
+}
+
+func StateSyncRequestingBlock(height uint64, peerId string) string {
+	return fmt.Sprintf("🔄 Requesting block 🔄 at height %d from %s", height, peerId)
+}
+
+func StateSyncAppliedBlock(height uint64, peerId string) string {
+	return fmt.Sprintf("🔄 Applied synced block 🔄 at height %d from %s", height, peerId)
+}
+
+func StateSyncPeerFailedToServe(height uint64, peerId string) string {
+	return fmt.Sprintf("🔄 Peer %s failed to serve block 🔄 at height %d", peerId, height)
+}
+
+func StateSyncCompleted(height uint64) string {
+	return fmt.Sprintf("🔄 State sync completed 🔄; resuming consensus at height %d", height)
+}
+
+func ReplayedWAL(numEntries int, height, round uint64) string {
+	return fmt.Sprintf("📜 Replayed %d write-ahead log entries 📜; resuming consensus at height %d round %d", numEntries, height, round)
+}
+
+func DelayingProposal(height, round uint64, delay time.Duration) string {
+	return fmt.Sprintf("⏳ Delaying the proposal of the block ⏳ at height %d round %d by %s", height, round, delay)
+}
+
 // TODO(olshansky): Add source and destination NodeId of message here
 func DebugReceivedHandlingHotstuffMessage(msg *HotstuffMessage) string {
 	return fmt.Sprintf("🔎 [DEBUG] Received hotstuff msg at (Height, Step, Round): (%d, %d, %d)", msg.Height, msg.GetStep(), msg.Round)
@@ -143,7 +185,7 @@ const (
 	nodeIsLockedOnPastHeightQCError             = "node is locked on a QC from a past height"
 	nodeIsLockedOnPastRoundQCError              = "node is locked on a QC from a past round"
 	unhandledProposalCaseError                  = "unhandled proposal validation check"
-	unnecessaryPartialSigForNewRoundError       = "newRound messages do not need a partial signature"
+	unnecessaryPartialSigForNewRoundError       = "newRound messages carry their signature outside of their justification"
 	unnecessaryPartialSigForLeaderProposalError = "leader proposals do not need a partial signature"
 	nilPartialSigError                          = "partial signature cannot be nil"
 	nilPartialSigOrSourceNotSpecifiedError      = "partial signature is either nil or source is not specified"
@@ -169,6 +211,37 @@ const (
 	nilLeaderIdError                            = "attempting to send a message to leader when LeaderId is nil"
 	newPersistenceReadContextError              = "error creating new persistence read context"
 	persistenceGetAllValidatorsError            = "error getting all validators from persistence"
+	doubleSignDetectedError                     = "validator signed two different blocks for the same height, step and round"
+	invalidDoubleSignEvidenceError              = "double sign evidence is invalid"
+	invalidSyncedBlockError                     = "block synced from a peer is invalid"
+	getSyncedBlockError                         = "could not get the block requested by a peer"
+	nilLeaderElectionProofError                 = "proposal does not carry a leader election proof"
+	invalidLeaderElectionProofError             = "leader election proof is invalid"
+	notElectedLeaderError                       = "proposer was not elected to lead the round"
+	nilBLSSignatureError                        = "partial signature does not carry a BLS signature"
+	invalidBLSSignatureError                    = "BLS signature on message is invalid"
+	invalidSignerBitmapError                    = "signer bitmap of the threshold signature does not match the validator set"
+	invalidAggregateSignatureError              = "aggregate signature of the threshold signature is invalid"
+	missingBLSKeyError                          = "validator did not register a BLS key"
+	nilJustifyQCWhileLockedError                = "proposal without a QC cannot justify a block that does not extend the locked QC"
+	conflictingSentMessageError                 = "message conflicts with a message the node already sent for the same height, step and round"
+	writeAheadLogError                          = "consensus write-ahead log failure"
+	chainedHotstuffLeaderElectionError          = "chained HotStuff requires the round robin leader election"
+	invalidChainedProposalError                 = "chained proposal is invalid"
+	unsafeChainedProposalError                  = "chained proposal neither extends the locked block nor is justified by a QC from a later view"
+	missingChainedBlockError                    = "an uncommitted ancestor of the block to commit is unknown"
+	unexpectedProposerError                     = "proposer is not the leader of the round"
+	nilThresholdSigInTCError                    = "timeout certificate must contain a non nil threshold signature"
+	invalidTimeoutCertificateError              = "timeout certificate is invalid"
+	nilBlockTimeError                           = "block does not have a time"
+	blockTimeBeforeParentError                  = "block time is earlier than the time of its parent"
+	blockTimeTooFarInFutureError                = "block time is too far ahead of the local time"
+	proposalDelayError                          = "could not compute the delay before proposing a block"
+	invalidValidatorSetHashError                = "block does not commit the validator set of its epoch"
+	invalidValidatorSetSnapshotError            = "validator set snapshot of the block is invalid"
+	validatorSetSnapshotNotCommittedError       = "the state the validator set of the epoch is snapshotted from is not committed yet"
+	upgradeRequiredError                        = "the node halted because its binary does not implement an active upgrade"
+	nilUtilityContextError                      = "utility context is nil"
 )
 
 var (
@@ -206,18 +279,34 @@ var (
 	ErrNilLeaderId                            = errors.New(nilLeaderIdError)
 	ErrNewPersistenceReadContext              = errors.New(newPersistenceReadContextError)
 	ErrPersistenceGetAllValidators            = errors.New(persistenceGetAllValidatorsError)
+	ErrNilLeaderElectionProof                 = errors.New(nilLeaderElectionProofError)
+	ErrNilBLSSignature                        = errors.New(nilBLSSignatureError)
+	ErrInvalidSignerBitmap                    = errors.New(invalidSignerBitmapError)
+	ErrInvalidAggregateSignature              = errors.New(invalidAggregateSignatureError)
+	ErrNilJustifyQCWhileLocked                = errors.New(nilJustifyQCWhileLockedError)
+	ErrChainedHotstuffLeaderElection          = errors.New(chainedHotstuffLeaderElectionError)
+	ErrUnsafeChainedProposal                  = errors.New(unsafeChainedProposalError)
+	ErrNilThresholdSigInTC                    = errors.New(nilThresholdSigInTCError)
+	ErrNilBlockTime                           = errors.New(nilBlockTimeError)
+	ErrProposalDelay                          = errors.New(proposalDelayError)
+	ErrUpgradeRequired                        = errors.New(upgradeRequiredError)
+	ErrNilUtilityContext                      = errors.New(nilUtilityContextError)
 )
 
 func ErrInvalidBlockSize(blockSize, maxSize uint64) error {
 	return fmt.Errorf("%s: %d bytes VS max of %d bytes", blockSizeTooLargeError, blockSize, maxSize)
 }
 
+func ErrHaltedForUpgrade(height uint64, err error) error {
+	return fmt.Errorf("%w at height %d: %v", ErrUpgradeRequired, height, err)
+}
+
 func ErrInvalidAppHash(blockHeaderHash, appHash string) error {
 	return fmt.Errorf("%s: %s != %s", invalidAppHashError, blockHeaderHash, appHash)
 }
 
-func ErrByzantineThresholdCheck(n int, threshold float64) error {
-	return fmt.Errorf("%s: (%d > %.2f?)", byzantineOptimisticThresholdError, n, threshold)
+func ErrByzantineThresholdCheck(votingPower, totalVotingPower *big.Int) error {
+	return fmt.Errorf("%s: (%s > 2/3 * %s?)", byzantineOptimisticThresholdError, votingPower, totalVotingPower)
 }
 
 func ErrMissingValidator(address string, nodeId NodeId) error {
@@ -229,6 +318,59 @@ func ErrValidatingPartialSig(senderAddr string, senderNodeId NodeId, msg *Hotstu
 		invalidPartialSignatureError, senderAddr, senderNodeId, msg.Height, StepToString[msg.GetStep()], msg.Round, string(msg.GetPartialSignature().Signature), protoHash(msg.Block), pubKey)
 }
 
+func ErrValidatingBLSSig(senderAddr string, senderNodeId NodeId, msg *HotstuffMessage) error {
+	return fmt.Errorf("%s: Sender: %s (%d); Height: %d; Step: %s; Round: %d; BlockHash: %s",
+		invalidBLSSignatureError, senderAddr, senderNodeId, msg.Height, StepToString[msg.GetStep()], msg.Round, protoHash(msg.Block))
+}
+
+func ErrMissingBLSKey(address string) error {
+	return fmt.Errorf("%s: %s", missingBLSKeyError, address)
+}
+
+func ErrConflictingSentMessage(msg *HotstuffMessage) error {
+	return fmt.Errorf("%s: Height: %d; Step: %s; Round: %d; BlockHash: %s", conflictingSentMessageError, msg.Height, StepToString[msg.GetStep()], msg.Round, protoHash(msg.Block))
+}
+
+func ErrWriteAheadLog(err error) error {
+	return fmt.Errorf("%s: %w", writeAheadLogError, err)
+}
+
+func ErrInvalidChainedProposal(reason string) error {
+	return fmt.Errorf("%s: %s", invalidChainedProposalError, reason)
+}
+
+func ErrMissingChainedBlock(height uint64) error {
+	return fmt.Errorf("%s: height %d", missingChainedBlockError, height)
+}
+
+func ErrUnexpectedProposer(address string, height, round uint64) error {
+	return fmt.Errorf("%s: %s at height %d round %d", unexpectedProposerError, address, height, round)
+}
+
+func ErrInvalidTimeoutCertificate(height, round uint64) error {
+	return fmt.Errorf("%s: height %d round %d", invalidTimeoutCertificateError, height, round)
+}
+
+func ErrBlockTimeBeforeParent(blockTime, parentTime time.Time) error {
+	return fmt.Errorf("%s: %s VS parent time %s", blockTimeBeforeParentError, blockTime.Format(time.RFC3339Nano), parentTime.Format(time.RFC3339Nano))
+}
+
+func ErrBlockTimeTooFarInFuture(blockTime, maxTime time.Time) error {
+	return fmt.Errorf("%s: %s VS max of %s", blockTimeTooFarInFutureError, blockTime.Format(time.RFC3339Nano), maxTime.Format(time.RFC3339Nano))
+}
+
+func ErrInvalidValidatorSetHash(height uint64, blockHash, expectedHash string) error {
+	return fmt.Errorf("%s: height %d; block hash %s VS expected %s", invalidValidatorSetHashError, height, blockHash, expectedHash)
+}
+
+func ErrInvalidValidatorSetSnapshot(height uint64, reason string) error {
+	return fmt.Errorf("%s: height %d; %s", invalidValidatorSetSnapshotError, height, reason)
+}
+
+func ErrValidatorSetSnapshotNotCommitted(height, snapshotHeight, committedHeight uint64) error {
+	return fmt.Errorf("%s: height %d; snapshot height %d; committed height %d", validatorSetSnapshotNotCommittedError, height, snapshotHeight, committedHeight)
+}
+
 func ErrPacemakerUnexpectedMessageHeight(err error, heightCurrent, heightMessage uint64) error {
 	return fmt.Errorf("%s: Current: %d; Message: %d ", err, heightCurrent, heightMessage)
 }
@@ -253,10 +395,42 @@ func ErrQCInvalid(step HotstuffStep) error {
 	return fmt.Errorf("invalid QC in step %s", StepToString[step])
 }
 
+func ErrDoubleSignDetected(address string, msg *HotstuffMessage) error {
+	return fmt.Errorf("%s: Validator: %s; Height: %d; Step: %s; Round: %d", doubleSignDetectedError, address, msg.Height, StepToString[msg.GetStep()], msg.Round)
+}
+
+func ErrInvalidDoubleSignEvidence(err error) error {
+	return fmt.Errorf("%s: %w", invalidDoubleSignEvidenceError, err)
+}
+
+func ErrUnknownStateSyncMessageType(msg any) error {
+	return fmt.Errorf("unknown state sync message type: %T", msg)
+}
+
+func ErrInvalidStateSyncMetadata(peerId string, minHeight, maxHeight uint64) error {
+	return fmt.Errorf("invalid state sync metadata from %s: min height %d is greater than max height %d", peerId, minHeight, maxHeight)
+}
+
+func ErrGetSyncedBlock(height uint64, err error) error {
+	return fmt.Errorf("%s at height %d: %w", getSyncedBlockError, height, err)
+}
+
+func ErrInvalidSyncedBlock(height uint64, err error) error {
+	return fmt.Errorf("%s at height %d: %w", invalidSyncedBlockError, height, err)
+}
+
 func ErrLeaderElection(msg *HotstuffMessage) error {
 	return fmt.Errorf("leader election failed: Validator cannot take part in consensus at height %d round %d", msg.Height, msg.Round)
 }
 
+func ErrInvalidLeaderElectionProof(address string, height, round uint64) error {
+	return fmt.Errorf("%s: proposer %s at height %d round %d", invalidLeaderElectionProofError, address, height, round)
+}
+
+func ErrNotElectedLeader(address string, height, round uint64) error {
+	return fmt.Errorf("%s: proposer %s at height %d round %d", notElectedLeaderError, address, height, round)
+}
+
 func protoHash(m proto.Message) string {
 	b, err := codec.GetCodec().Marshal(m)
 	if err != nil {
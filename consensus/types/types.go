@@ -19,6 +19,9 @@ type ConsensusNodeState struct {
 
 	LeaderId NodeId
 	IsLeader bool
+
+	PrepareQC *QuorumCertificate
+	LockedQC  *QuorumCertificate
 }
 
 func ActorListToValidatorMap(actors []*coreTypes.Actor) (m ValidatorMap) {
@@ -0,0 +1,51 @@
+package consensus
+
+import (
+	"log"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// haltForUpgrade stops the node before it applies a block with the rules of an upgrade its binary
+// does not implement. The uncommitted context is released so the state is left at the last committed
+// height, allowing the node to resume consensus once it is restarted with an upgraded binary.
+//
+// The returned error is also returned for every message handled afterwards, so the node can stop its
+// modules cleanly (e.g. flush the WAL) rather than exiting from within consensus.
+func (m *consensusModule) haltForUpgrade(utilityContext modules.UtilityContext, height uint64, err error) error {
+	if releaseErr := utilityContext.Release(); releaseErr != nil {
+		log.Printf("[WARN] Error releasing utility context: %v\n", releaseErr)
+	}
+	m.haltErr = typesCons.ErrHaltedForUpgrade(height, err)
+	m.nodeLogError("[UPGRADE REQUIRED] Restart the node with a binary that implements the upgrade", m.haltErr)
+	return m.haltErr
+}
+
+// isFeatureActive returns whether the upgrade is active at the height of the utility context of the node
+func (m *consensusModule) isFeatureActive(featureName string) (bool, error) {
+	if m.utilityContext == nil {
+		return false, typesCons.ErrNilUtilityContext
+	}
+	return m.utilityContext.IsFeatureActive(featureName)
+}
+
+// validateBlockSize checks the serialized size of the block against the max block size once the
+// `block_size_limit` upgrade is active. Before it, only the size of the block struct is checked by
+// `isValidMessageBlock`, which does not depend on the content of the block.
+func (m *consensusModule) validateBlockSize(block *coreTypes.Block) error {
+	active, err := m.isFeatureActive(typesUtil.BlockSizeLimitUpgrade)
+	if err != nil {
+		return err
+	}
+	if !active {
+		return nil
+	}
+	if blockSize := uint64(proto.Size(block)); blockSize > m.genesisState.GetMaxBlockBytes() {
+		return typesCons.ErrInvalidBlockSize(blockSize, m.genesisState.GetMaxBlockBytes())
+	}
+	return nil
+}
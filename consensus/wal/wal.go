@@ -0,0 +1,250 @@
+package wal
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// WAL is an append-only log of records that must survive a restart of the node. Every record is synced to disk
+// before `Append` returns, so a node that crashes right after sending a message remembers it.
+type WAL interface {
+	// Appends the record to the log and syncs it to disk
+	Append(record []byte) error
+	// Returns the records in the order they were appended. A record that was only partially written when the
+	// node crashed is discarded.
+	ReadAll() ([][]byte, error)
+	// Removes all the records from the log
+	Clear() error
+	// Atomically replaces the records of the log, so the log is never left empty if the node crashes meanwhile
+	Reset(records [][]byte) error
+
+	// Lifecycle methods
+	Stop() error
+}
+
+const (
+	// Every record is prefixed by its length and the CRC-32 (Castagnoli) checksum of its content
+	recordHeaderLen = 8
+	// The file permissions of the log, which only the node needs to read
+	walFileMode = 0o600
+)
+
+var (
+	ErrCorruptRecord = errors.New("the write-ahead log contains a corrupt record")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+var (
+	_ WAL = &fileWAL{}
+	_ WAL = &memWAL{}
+)
+
+type fileWAL struct {
+	m    sync.Mutex
+	file *os.File
+}
+
+// NewWAL opens, or creates, the write-ahead log at the given path
+func NewWAL(path string) (WAL, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, walFileMode)
+	if err != nil {
+		return nil, err
+	}
+	return &fileWAL{file: file}, nil
+}
+
+func (w *fileWAL) Append(record []byte) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if _, err := w.file.Write(encodeRecord(record)); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+func (w *fileWAL) ReadAll() ([][]byte, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	bz, err := os.ReadFile(w.file.Name())
+	if err != nil {
+		return nil, err
+	}
+	records, validLen, err := decodeRecords(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the record that was being written when the node crashed so new records are not appended after it
+	if validLen < len(bz) {
+		if err := w.file.Truncate(int64(validLen)); err != nil {
+			return nil, err
+		}
+		if err := w.file.Sync(); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func (w *fileWAL) Clear() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if err := w.file.Truncate(0); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+func (w *fileWAL) Reset(records [][]byte) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	// The records are written to a temporary file that is renamed over the log once it is synced to disk
+	path := w.file.Name()
+	tmpFile, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, walFileMode)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if _, err := tmpFile.Write(encodeRecord(record)); err != nil {
+			tmpFile.Close()
+			return err
+		}
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, walFileMode)
+	if err != nil {
+		return err
+	}
+	w.file.Close()
+	w.file = file
+	return nil
+}
+
+func (w *fileWAL) Stop() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return w.file.Close()
+}
+
+// memWAL keeps the records in memory. It is used when the node is not configured with a WAL path, in which case
+// the node does not sign conflicting messages while it is running but forgets what it signed when it restarts.
+type memWAL struct {
+	m       sync.Mutex
+	records [][]byte
+}
+
+func NewMemWAL() WAL {
+	return &memWAL{}
+}
+
+func (w *memWAL) Append(record []byte) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.records = append(w.records, append([]byte{}, record...))
+	return nil
+}
+
+func (w *memWAL) ReadAll() ([][]byte, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return append([][]byte{}, w.records...), nil
+}
+
+func (w *memWAL) Clear() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.records = nil
+	return nil
+}
+
+func (w *memWAL) Reset(records [][]byte) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.records = make([][]byte, 0, len(records))
+	for _, record := range records {
+		w.records = append(w.records, append([]byte{}, record...))
+	}
+	return nil
+}
+
+func (w *memWAL) Stop() error {
+	return nil
+}
+
+// syncDir syncs the directory of the log to disk so that the renaming of the log survives a crash
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func encodeRecord(record []byte) []byte {
+	bz := make([]byte, recordHeaderLen+len(record))
+	binary.BigEndian.PutUint32(bz[0:4], uint32(len(record)))
+	binary.BigEndian.PutUint32(bz[4:8], crc32.Checksum(record, crcTable))
+	copy(bz[recordHeaderLen:], record)
+	return bz
+}
+
+// decodeRecords returns the records encoded in `bz` and the length of the bytes they were decoded from. A torn
+// record at the end of the log is the result of a crash while it was being appended and is ignored, but a corrupt
+// record followed by other records means that the log cannot be trusted.
+func decodeRecords(bz []byte) (records [][]byte, validLen int, err error) {
+	records = make([][]byte, 0)
+	offset := 0
+	for offset < len(bz) {
+		if len(bz)-offset < recordHeaderLen {
+			break
+		}
+		recordLen := int(binary.BigEndian.Uint32(bz[offset : offset+4]))
+		checksum := binary.BigEndian.Uint32(bz[offset+4 : offset+8])
+		end := offset + recordHeaderLen + recordLen
+		if end > len(bz) {
+			break
+		}
+		record := bz[offset+recordHeaderLen : end]
+		if crc32.Checksum(record, crcTable) != checksum {
+			if end < len(bz) {
+				return nil, 0, ErrCorruptRecord
+			}
+			break
+		}
+		records = append(records, record)
+		offset = end
+	}
+	return records, offset, nil
+}
@@ -0,0 +1,128 @@
+package wal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAL_RecordsSurviveRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "consensus", "wal")
+
+	wal, err := NewWAL(path)
+	require.NoError(t, err)
+	require.NoError(t, wal.Append([]byte("record1")))
+	require.NoError(t, wal.Append([]byte("record2")))
+	require.NoError(t, wal.Stop())
+
+	wal, err = NewWAL(path)
+	require.NoError(t, err)
+	records, err := wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record1"), []byte("record2")}, records)
+
+	require.NoError(t, wal.Clear())
+	require.NoError(t, wal.Append([]byte("record3")))
+	records, err = wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record3")}, records)
+	require.NoError(t, wal.Stop())
+}
+
+func TestWAL_TornRecordIsDiscarded(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+
+	wal, err := NewWAL(path)
+	require.NoError(t, err)
+	require.NoError(t, wal.Append([]byte("record1")))
+	require.NoError(t, wal.Stop())
+
+	// The node crashed while appending the second record
+	tornRecord := encodeRecord([]byte("record2"))
+	appendToFile(t, path, tornRecord[:len(tornRecord)-3])
+
+	wal, err = NewWAL(path)
+	require.NoError(t, err)
+	records, err := wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record1")}, records)
+
+	// New records are not appended after the torn record
+	require.NoError(t, wal.Append([]byte("record3")))
+	records, err = wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record1"), []byte("record3")}, records)
+	require.NoError(t, wal.Stop())
+}
+
+func TestWAL_CorruptRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+
+	wal, err := NewWAL(path)
+	require.NoError(t, err)
+	require.NoError(t, wal.Append([]byte("record1")))
+	require.NoError(t, wal.Append([]byte("record2")))
+	require.NoError(t, wal.Stop())
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[recordHeaderLen] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, walFileMode))
+
+	wal, err = NewWAL(path)
+	require.NoError(t, err)
+	_, err = wal.ReadAll()
+	require.ErrorIs(t, err, ErrCorruptRecord)
+	require.NoError(t, wal.Stop())
+}
+
+func TestWAL_Reset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+
+	wal, err := NewWAL(path)
+	require.NoError(t, err)
+	require.NoError(t, wal.Append([]byte("record1")))
+	require.NoError(t, wal.Append([]byte("record2")))
+
+	require.NoError(t, wal.Reset([][]byte{[]byte("record2")}))
+	require.NoError(t, wal.Append([]byte("record3")))
+	require.NoError(t, wal.Stop())
+
+	wal, err = NewWAL(path)
+	require.NoError(t, err)
+	records, err := wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record2"), []byte("record3")}, records)
+	require.NoError(t, wal.Stop())
+}
+
+func TestMemWAL(t *testing.T) {
+	wal := NewMemWAL()
+	record := []byte("record1")
+	require.NoError(t, wal.Append(record))
+	record[0] = 'x'
+
+	records, err := wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record1")}, records)
+
+	require.NoError(t, wal.Reset([][]byte{[]byte("record2")}))
+	records, err = wal.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("record2")}, records)
+
+	require.NoError(t, wal.Clear())
+	records, err = wal.ReadAll()
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func appendToFile(t *testing.T, path string, bz []byte) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, walFileMode)
+	require.NoError(t, err)
+	_, err = file.Write(bz)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+}
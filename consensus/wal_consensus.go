@@ -0,0 +1,255 @@
+package consensus
+
+/*
+	Consensus write-ahead log (WAL):
+
+	1. The node writes the prepareQC and lockedQC it sets, and every vote and proposal it sends, to the WAL before
+	   sending the messages that depend on them; the WAL syncs every entry to disk
+	2. The node refuses to send a message that conflicts with a message it already sent for the same (height, round,
+	   step), i.e. a message for a different block, which would make it an equivocating validator
+	3. On startup, the entries of the height the node is deciding are replayed: the node resumes the round it was in,
+	   locked on the same QC and remembering the messages it already sent
+	4. The entries of a height are removed from the WAL once its block is committed
+*/
+
+import (
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/consensus/wal"
+	"github.com/pokt-network/pocket/shared/codec"
+)
+
+// The messages sent by the node are identified by their (height, round, step, type)
+type sentMessageKey struct {
+	height  uint64
+	round   uint64
+	step    typesCons.HotstuffStep
+	msgType typesCons.HotstuffMessageType
+}
+
+func newSentMessageKey(msg *typesCons.HotstuffMessage) sentMessageKey {
+	return sentMessageKey{
+		height:  msg.GetHeight(),
+		round:   msg.GetRound(),
+		step:    msg.GetStep(),
+		msgType: msg.GetType(),
+	}
+}
+
+// initWAL opens the WAL at the path in the consensus config, or keeps it in memory if no path is configured
+func (m *consensusModule) initWAL() error {
+	m.sentMessages = make(map[sentMessageKey]*typesCons.HotstuffMessage)
+
+	walPath := m.consCfg.GetWalPath()
+	if walPath == "" {
+		m.wal = wal.NewMemWAL()
+		return nil
+	}
+
+	consensusWAL, err := wal.NewWAL(walPath)
+	if err != nil {
+		return err
+	}
+	m.wal = consensusWAL
+	return nil
+}
+
+// setPrepareQC writes the prepareQC to the WAL before the node votes on it
+func (m *consensusModule) setPrepareQC(qc *typesCons.QuorumCertificate) error {
+	if err := m.appendWALEntry(&typesCons.WALEntry{
+		Entry: &typesCons.WALEntry_PrepareQc{PrepareQc: qc},
+	}); err != nil {
+		return err
+	}
+	m.prepareQC = qc
+	return nil
+}
+
+// setLockedQC writes the lockedQC to the WAL before the node votes on it
+func (m *consensusModule) setLockedQC(qc *typesCons.QuorumCertificate) error {
+	if err := m.appendWALEntry(&typesCons.WALEntry{
+		Entry: &typesCons.WALEntry_LockedQc{LockedQc: qc},
+	}); err != nil {
+		return err
+	}
+	m.lockedQC = qc
+	return nil
+}
+
+// writeSentMessage writes a vote or proposal to the WAL before it is sent, unless it conflicts with a message the
+// node already sent. Sending the same message again is safe.
+func (m *consensusModule) writeSentMessage(msg *typesCons.HotstuffMessage) error {
+	// NewRound and TIMEOUT messages do not commit the node to a block; the prepareQC they carry is written when it is set
+	if msg.GetStep() == NewRound || msg.GetType() == Timeout {
+		return nil
+	}
+
+	key := newSentMessageKey(msg)
+	if sentMsg, ok := m.sentMessages[key]; ok {
+		if protoHash(sentMsg.GetBlock()) != protoHash(msg.GetBlock()) {
+			return typesCons.ErrConflictingSentMessage(msg)
+		}
+		return nil
+	}
+
+	if err := m.appendWALEntry(newSentMessageWALEntry(msg)); err != nil {
+		return err
+	}
+	m.sentMessages[key] = msg
+	return nil
+}
+
+func newSentMessageWALEntry(msg *typesCons.HotstuffMessage) *typesCons.WALEntry {
+	if msg.GetType() == Vote {
+		return &typesCons.WALEntry{Entry: &typesCons.WALEntry_Vote{Vote: msg}}
+	}
+	return &typesCons.WALEntry{Entry: &typesCons.WALEntry_Proposal{Proposal: msg}}
+}
+
+func (m *consensusModule) appendWALEntry(entry *typesCons.WALEntry) error {
+	entryBz, err := codec.GetCodec().Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := m.wal.Append(entryBz); err != nil {
+		return typesCons.ErrWriteAheadLog(err)
+	}
+	return nil
+}
+
+// clearWAL removes all the entries, e.g. when the node resets to genesis
+func (m *consensusModule) clearWAL() {
+	m.sentMessages = make(map[sentMessageKey]*typesCons.HotstuffMessage)
+	if err := m.wal.Clear(); err != nil {
+		m.nodeLogError(typesCons.ErrWriteAheadLog(err).Error(), nil)
+	}
+}
+
+// pruneWAL removes the entries of the heights up to the height that was just committed, which are not needed to
+// stay safe anymore. Only chained HotStuff has QCs and messages at later heights, which are kept.
+func (m *consensusModule) pruneWAL(committedHeight uint64) {
+	entries := make([]*typesCons.WALEntry, 0)
+	if m.prepareQC.GetHeight() > committedHeight {
+		entries = append(entries, &typesCons.WALEntry{Entry: &typesCons.WALEntry_PrepareQc{PrepareQc: m.prepareQC}})
+	}
+	if m.lockedQC.GetHeight() > committedHeight {
+		entries = append(entries, &typesCons.WALEntry{Entry: &typesCons.WALEntry_LockedQc{LockedQc: m.lockedQC}})
+	}
+	for key, msg := range m.sentMessages {
+		if key.height <= committedHeight {
+			delete(m.sentMessages, key)
+			continue
+		}
+		entries = append(entries, newSentMessageWALEntry(msg))
+	}
+
+	records := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		entryBz, err := codec.GetCodec().Marshal(entry)
+		if err != nil {
+			m.nodeLogError(typesCons.ErrWriteAheadLog(err).Error(), nil)
+			return
+		}
+		records = append(records, entryBz)
+	}
+	if err := m.wal.Reset(records); err != nil {
+		m.nodeLogError(typesCons.ErrWriteAheadLog(err).Error(), nil)
+	}
+}
+
+// replayWAL restores the QCs and the sent messages of the height the node was deciding before it restarted. The node
+// resumes the latest round it took part in from the NewRound step, so it catches up with the rest of the validators
+// through the pacemaker without being able to sign anything that conflicts with what it signed before. With chained
+// HotStuff, the node decides all the heights after the last committed block and resumes after its highest QC.
+func (m *consensusModule) replayWAL() error {
+	records, err := m.wal.ReadAll()
+	if err != nil {
+		return typesCons.ErrWriteAheadLog(err)
+	}
+
+	entries := make([]*typesCons.WALEntry, 0, len(records))
+	for _, record := range records {
+		entry := &typesCons.WALEntry{}
+		if err := codec.GetCodec().Unmarshal(record, entry); err != nil {
+			return typesCons.ErrWriteAheadLog(err)
+		}
+		entries = append(entries, entry)
+	}
+
+	// The entries of committed heights are stale. Until the first block is committed, the node is at height 0 but the
+	// height it decides is 1.
+	height := m.height
+	if height == 0 {
+		height = 1
+	}
+	isChained := m.isChainedHotstuff()
+
+	numReplayed := 0
+	rounds := make(map[uint64]uint64) // The latest round the node took part in at each height
+	for _, entry := range entries {
+		entryHeight := getWALEntryHeight(entry)
+		if entryHeight < height || (entryHeight > height && !isChained) {
+			continue
+		}
+		numReplayed++
+		switch walEntry := entry.Entry.(type) {
+		case *typesCons.WALEntry_PrepareQc:
+			m.prepareQC = walEntry.PrepareQc
+			if isChained {
+				m.chained.addQC(walEntry.PrepareQc)
+			}
+		case *typesCons.WALEntry_LockedQc:
+			m.lockedQC = walEntry.LockedQc
+			if isChained {
+				m.chained.addQC(walEntry.LockedQc)
+			}
+		case *typesCons.WALEntry_Vote:
+			m.sentMessages[newSentMessageKey(walEntry.Vote)] = walEntry.Vote
+		case *typesCons.WALEntry_Proposal:
+			m.sentMessages[newSentMessageKey(walEntry.Proposal)] = walEntry.Proposal
+		}
+		if entryRound := getWALEntryRound(entry); entryRound > rounds[entryHeight] {
+			rounds[entryHeight] = entryRound
+		}
+	}
+	if numReplayed == 0 {
+		return nil
+	}
+
+	// The block certified by the highest QC is extended at the next height
+	if isChained && m.prepareQC.GetHeight() >= height {
+		height = m.prepareQC.GetHeight() + 1
+	}
+
+	m.height = height
+	m.round = rounds[height]
+	m.step = NewRound
+	m.nodeLog(typesCons.ReplayedWAL(numReplayed, m.height, m.round))
+
+	return nil
+}
+
+func getWALEntryHeight(entry *typesCons.WALEntry) uint64 {
+	switch {
+	case entry.GetPrepareQc() != nil:
+		return entry.GetPrepareQc().GetHeight()
+	case entry.GetLockedQc() != nil:
+		return entry.GetLockedQc().GetHeight()
+	case entry.GetVote() != nil:
+		return entry.GetVote().GetHeight()
+	default:
+		return entry.GetProposal().GetHeight()
+	}
+}
+
+func getWALEntryRound(entry *typesCons.WALEntry) uint64 {
+	switch {
+	case entry.GetPrepareQc() != nil:
+		return entry.GetPrepareQc().GetRound()
+	case entry.GetLockedQc() != nil:
+		return entry.GetLockedQc().GetRound()
+	case entry.GetVote() != nil:
+		return entry.GetVote().GetRound()
+	default:
+		return entry.GetProposal().GetRound()
+	}
+}
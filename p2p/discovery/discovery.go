@@ -0,0 +1,347 @@
+package discovery
+
+import (
+	"log"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/pokt-network/pocket/p2p/providers"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"github.com/pokt-network/pocket/shared/modules"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DiscoveryMessageContentType = "p2p.DiscoveryMessage"
+
+	// The maximum number of peers exchanged in a PEERS message
+	maxPeersPerExchange = 32
+	// The number of peers asked for their peers at every discovery round
+	numPeersAskedPerRound = 2
+)
+
+var _ modules.IntegratableModule = &Discovery{}
+
+// Discovery lets a node find peers beyond the staked validators, and keeps its address book free of dead peers:
+//   - The bootstrap peers of the config and the peers stored by a previous run are added to the address book at startup
+//   - A node advertising a service url pings the peers of its address book and asks a few of them for their peers
+//     at every round; a peer that does not answer `max_missed_pings` consecutive pings is removed from the address book,
+//     unless it is a validator staked at the current height, which the network must keep sending its messages to
+//   - The sender of every discovery message is added to the address book, so peers that join are known to the network
+//
+// The peers are added and removed through the `AddPeerToAddrBook` and `RemovePeerToAddrBook` helpers of the network,
+// and the peers added by discovery are persisted to the peerstore.
+//
+// The sender of a discovery message is authenticated by the secure transport, but the peers it exchanges are not; a peer
+// already in the address book is therefore never replaced by a discovered one, so its service url cannot be hijacked.
+type Discovery struct {
+	bus modules.Bus
+
+	network               typesP2P.Network
+	connFactory           typesP2P.ConnectionFactory
+	addrBookProvider      providers.AddrBookProvider
+	currentHeightProvider providers.CurrentHeightProvider
+	p2pCfg                *configs.P2PConfig
+
+	selfAddr cryptoPocket.Address
+	self     *typesP2P.PeerInfo
+
+	peerstore *peerstore
+
+	m sync.Mutex
+	// The number of consecutive pings each peer did not answer, by address
+	missedPings    map[string]uint32
+	maxMissedPings uint32
+
+	ticker *clock.Ticker
+	quit   chan struct{}
+}
+
+func NewDiscovery(bus modules.Bus, network typesP2P.Network, addrBookProvider providers.AddrBookProvider, currentHeightProvider providers.CurrentHeightProvider, publicKey cryptoPocket.PublicKey) (*Discovery, error) {
+	p2pCfg := bus.GetRuntimeMgr().GetConfig().P2P
+
+	peerstore, err := newPeerstore(p2pCfg.GetPeerstorePath())
+	if err != nil {
+		return nil, err
+	}
+
+	maxMissedPings := p2pCfg.GetMaxMissedPings()
+	if maxMissedPings == 0 {
+		maxMissedPings = 1
+	}
+
+	return &Discovery{
+		bus:                   bus,
+		network:               network,
+		connFactory:           addrBookProvider.GetConnFactory(),
+		addrBookProvider:      addrBookProvider,
+		currentHeightProvider: currentHeightProvider,
+		p2pCfg:                p2pCfg,
+		selfAddr:              publicKey.Address(),
+		self:                  &typesP2P.PeerInfo{PublicKey: publicKey.String(), ServiceUrl: p2pCfg.GetServiceUrl()},
+		peerstore:             peerstore,
+		missedPings:           make(map[string]uint32),
+		maxMissedPings:        maxMissedPings,
+		quit:                  make(chan struct{}),
+	}, nil
+}
+
+// Start adds the stored and bootstrap peers to the address book, asks the bootstrap peers for their peers and starts
+// the discovery rounds
+func (d *Discovery) Start() error {
+	for _, peerInfo := range d.peerstore.getPeers() {
+		if _, err := d.addPeer(peerInfo); err != nil {
+			log.Println("[WARN] Error adding stored peer to addrBook: ", err)
+		}
+	}
+
+	for _, bootstrapPeer := range d.p2pCfg.GetBootstrapPeers() {
+		peerInfo, err := ParseBootstrapPeer(bootstrapPeer)
+		if err != nil {
+			return err
+		}
+		peer, err := d.addPeer(peerInfo)
+		if err != nil {
+			log.Println("[WARN] Error adding bootstrap peer to addrBook: ", err)
+			continue
+		}
+		if peer != nil && d.isAdvertised() {
+			if err := d.send(typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_GET_PEERS, nil, peer.Address); err != nil {
+				log.Println("[WARN] Error asking bootstrap peer for its peers: ", err)
+			}
+		}
+	}
+
+	// Peers cannot answer the pings of a node that does not advertise where it is reachable
+	if d.p2pCfg.GetDiscoveryIntervalMsec() == 0 || !d.isAdvertised() {
+		return d.peerstore.save()
+	}
+
+	interval := time.Duration(d.p2pCfg.GetDiscoveryIntervalMsec()) * time.Millisecond
+	d.ticker = d.GetBus().GetRuntimeMgr().GetClock().Ticker(interval)
+	go func() {
+		for {
+			select {
+			case <-d.ticker.C:
+				d.runRound()
+			case <-d.quit:
+				return
+			}
+		}
+	}()
+	return d.peerstore.save()
+}
+
+func (d *Discovery) Stop() error {
+	if d.ticker != nil {
+		d.ticker.Stop()
+		close(d.quit)
+	}
+	return d.peerstore.save()
+}
+
+// HandleDiscoveryMessage adds the sender of the message to the address book and answers its requests
+func (d *Discovery) HandleDiscoveryMessage(msg *typesP2P.DiscoveryMessage) error {
+	sender, err := d.addPeer(msg.GetSender())
+	if err != nil {
+		return err
+	}
+	if sender != nil {
+		d.m.Lock()
+		delete(d.missedPings, sender.Address.String())
+		d.m.Unlock()
+	}
+
+	switch msg.GetType() {
+	case typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PING:
+		if sender == nil {
+			return nil
+		}
+		return d.send(typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PONG, nil, sender.Address)
+	case typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PONG:
+		return nil
+	case typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_GET_PEERS:
+		if sender == nil {
+			return nil
+		}
+		return d.send(typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PEERS, d.getExchangedPeers(sender.Address), sender.Address)
+	case typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PEERS:
+		peers := msg.GetPeers()
+		if len(peers) > maxPeersPerExchange {
+			peers = peers[:maxPeersPerExchange]
+		}
+		for _, peerInfo := range peers {
+			if _, err := d.addPeer(peerInfo); err != nil {
+				log.Println("[WARN] Error adding exchanged peer to addrBook: ", err)
+			}
+		}
+		return d.peerstore.save()
+	default:
+		return typesP2P.ErrUnknownDiscoveryMessageType(msg.GetType())
+	}
+}
+
+// runRound removes the peers that missed too many pings, pings the others and asks a few of them for their peers.
+// No peer is removed if the staked validators cannot be read, since they must not be removed.
+func (d *Discovery) runRound() {
+	stakedAddrBook, err := d.addrBookProvider.GetStakedAddrBookAtHeight(d.currentHeightProvider.CurrentHeight())
+	if err != nil {
+		log.Println("[WARN] Error getting the staked addrBook, no peer is removed this round: ", err)
+	}
+	stakedAddrs := make(map[string]struct{}, len(stakedAddrBook))
+	for _, peer := range stakedAddrBook {
+		stakedAddrs[peer.Address.String()] = struct{}{}
+	}
+
+	var alivePeers, deadPeers typesP2P.AddrBook
+	d.m.Lock()
+	for _, peer := range d.network.GetAddrBook() {
+		if peer.Address.Equals(d.selfAddr) {
+			continue
+		}
+		address := peer.Address.String()
+		_, isStaked := stakedAddrs[address]
+		if err == nil && !isStaked && d.missedPings[address] >= d.maxMissedPings {
+			deadPeers = append(deadPeers, peer)
+			delete(d.missedPings, address)
+			continue
+		}
+		d.missedPings[address]++
+		alivePeers = append(alivePeers, peer)
+	}
+	d.m.Unlock()
+
+	for _, peer := range deadPeers {
+		log.Printf("[INFO] Removing peer %s (%s) that did not answer %d pings\n", peer.Address, peer.ServiceUrl, d.maxMissedPings)
+		if err := d.network.RemovePeerToAddrBook(peer); err != nil {
+			log.Println("[WARN] Error removing dead peer from addrBook: ", err)
+		}
+		d.peerstore.removePeer(peer.Address.String())
+	}
+
+	for _, peer := range alivePeers {
+		if err := d.send(typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PING, nil, peer.Address); err != nil {
+			log.Println("[WARN] Error pinging peer: ", err)
+		}
+	}
+
+	rand.Shuffle(len(alivePeers), func(i, j int) { alivePeers[i], alivePeers[j] = alivePeers[j], alivePeers[i] })
+	for i := 0; i < len(alivePeers) && i < numPeersAskedPerRound; i++ {
+		if err := d.send(typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_GET_PEERS, nil, alivePeers[i].Address); err != nil {
+			log.Println("[WARN] Error asking peer for its peers: ", err)
+		}
+	}
+
+	if err := d.peerstore.save(); err != nil {
+		log.Println("[WARN] Error saving the peerstore: ", err)
+	}
+}
+
+// addPeer adds the peer to the address book and the peerstore unless it is already known, and returns the peer of the
+// address book. Nothing is added for this node or for peers that do not advertise a service url.
+func (d *Discovery) addPeer(peerInfo *typesP2P.PeerInfo) (*typesP2P.NetworkPeer, error) {
+	if peerInfo.GetServiceUrl() == "" {
+		return nil, nil
+	}
+	publicKey, err := cryptoPocket.NewPublicKey(peerInfo.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	if publicKey.Address().Equals(d.selfAddr) {
+		return nil, nil
+	}
+
+	address := publicKey.Address().String()
+	for _, peer := range d.network.GetAddrBook() {
+		if peer.Address.String() == address {
+			return peer, nil
+		}
+	}
+
+	conn, err := d.connFactory(d.p2pCfg, peerInfo.GetServiceUrl(), publicKey)
+	if err != nil {
+		return nil, err
+	}
+	peer := &typesP2P.NetworkPeer{
+		Dialer:     conn,
+		PublicKey:  publicKey,
+		Address:    publicKey.Address(),
+		ServiceUrl: peerInfo.GetServiceUrl(),
+	}
+	if err := d.network.AddPeerToAddrBook(peer); err != nil {
+		return nil, err
+	}
+	d.peerstore.addPeer(address, peerInfo)
+	return peer, nil
+}
+
+// getExchangedPeers returns a random subset of the address book to send to `receiver`
+func (d *Discovery) getExchangedPeers(receiver cryptoPocket.Address) []*typesP2P.PeerInfo {
+	addrBook := d.network.GetAddrBook()
+	peers := make([]*typesP2P.PeerInfo, 0, len(addrBook))
+	for _, peer := range addrBook {
+		if peer.Address.Equals(receiver) || peer.Address.Equals(d.selfAddr) || peer.ServiceUrl == "" {
+			continue
+		}
+		peers = append(peers, &typesP2P.PeerInfo{PublicKey: peer.PublicKey.String(), ServiceUrl: peer.ServiceUrl})
+	}
+	rand.Shuffle(len(peers), func(i, j int) { peers[i], peers[j] = peers[j], peers[i] })
+	if len(peers) > maxPeersPerExchange {
+		peers = peers[:maxPeersPerExchange]
+	}
+	return peers
+}
+
+func (d *Discovery) send(msgType typesP2P.DiscoveryMessageType, peers []*typesP2P.PeerInfo, address cryptoPocket.Address) error {
+	envelope, err := messaging.PackMessage(&typesP2P.DiscoveryMessage{
+		Type:   msgType,
+		Sender: d.self,
+		Peers:  peers,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	return d.network.NetworkSend(data, address)
+}
+
+func (d *Discovery) isAdvertised() bool {
+	return d.self.GetServiceUrl() != ""
+}
+
+func (d *Discovery) SetBus(bus modules.Bus) {
+	d.bus = bus
+}
+
+func (d *Discovery) GetBus() modules.Bus {
+	return d.bus
+}
+
+// ParseBootstrapPeer parses a bootstrap peer formatted as `<hex public key>@<host>:<port>`
+func ParseBootstrapPeer(bootstrapPeer string) (*typesP2P.PeerInfo, error) {
+	publicKey, serviceUrl, ok := strings.Cut(bootstrapPeer, "@")
+	if !ok || serviceUrl == "" {
+		return nil, typesP2P.ErrInvalidBootstrapPeer(bootstrapPeer, nil)
+	}
+	if _, err := cryptoPocket.NewPublicKey(publicKey); err != nil {
+		return nil, typesP2P.ErrInvalidBootstrapPeer(bootstrapPeer, err)
+	}
+	return &typesP2P.PeerInfo{PublicKey: publicKey, ServiceUrl: serviceUrl}, nil
+}
+
+func getPeerInfoAddress(peerInfo *typesP2P.PeerInfo) (string, error) {
+	publicKey, err := cryptoPocket.NewPublicKey(peerInfo.GetPublicKey())
+	if err != nil {
+		return "", err
+	}
+	return publicKey.Address().String(), nil
+}
@@ -0,0 +1,234 @@
+package discovery
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	mocksP2P "github.com/pokt-network/pocket/p2p/types/mocks"
+	"github.com/pokt-network/pocket/runtime/configs"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPeerstore_PersistsPeersAcrossRestarts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "p2p", "peerstore.json")
+	peerInfos := []*typesP2P.PeerInfo{newTestingPeerInfo(t, "node1.consensus:8080"), newTestingPeerInfo(t, "node2.consensus:8080")}
+
+	ps, err := newPeerstore(path)
+	require.NoError(t, err)
+	require.Empty(t, ps.getPeers())
+	for _, peerInfo := range peerInfos {
+		address, err := getPeerInfoAddress(peerInfo)
+		require.NoError(t, err)
+		ps.addPeer(address, peerInfo)
+	}
+	require.NoError(t, ps.save())
+
+	restartedPs, err := newPeerstore(path)
+	require.NoError(t, err)
+	require.Len(t, restartedPs.getPeers(), 2)
+	for _, peerInfo := range restartedPs.getPeers() {
+		require.Contains(t, []string{"node1.consensus:8080", "node2.consensus:8080"}, peerInfo.GetServiceUrl())
+	}
+
+	address, err := getPeerInfoAddress(peerInfos[0])
+	require.NoError(t, err)
+	restartedPs.removePeer(address)
+	require.NoError(t, restartedPs.save())
+
+	restartedPs, err = newPeerstore(path)
+	require.NoError(t, err)
+	require.Len(t, restartedPs.getPeers(), 1)
+	require.Equal(t, "node2.consensus:8080", restartedPs.getPeers()[0].GetServiceUrl())
+}
+
+func TestParseBootstrapPeer(t *testing.T) {
+	peerInfo := newTestingPeerInfo(t, "node1.consensus:8080")
+
+	parsedPeerInfo, err := ParseBootstrapPeer(peerInfo.GetPublicKey() + "@node1.consensus:8080")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(peerInfo, parsedPeerInfo))
+
+	for _, bootstrapPeer := range []string{"node1.consensus:8080", peerInfo.GetPublicKey() + "@", "notakey@node1.consensus:8080"} {
+		_, err := ParseBootstrapPeer(bootstrapPeer)
+		require.Error(t, err, bootstrapPeer)
+	}
+}
+
+func TestDiscovery_AnswersPingsAndAddsExchangedPeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	d, network := newTestingDiscovery(t, ctrl, 3, nil)
+
+	sender := newTestingPeerInfo(t, "node2.consensus:8080")
+	require.NoError(t, d.HandleDiscoveryMessage(&typesP2P.DiscoveryMessage{
+		Type:   typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PING,
+		Sender: sender,
+	}))
+	require.Len(t, network.GetAddrBook(), 1)
+	require.Equal(t, []typesP2P.DiscoveryMessageType{typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PONG}, network.sentTypes(t))
+
+	exchangedPeers := []*typesP2P.PeerInfo{
+		newTestingPeerInfo(t, "node3.consensus:8080"),
+		newTestingPeerInfo(t, "node4.consensus:8080"),
+		{PublicKey: newTestingPeerInfo(t, "").GetPublicKey()}, // not reachable
+		d.self, // this node
+	}
+	require.NoError(t, d.HandleDiscoveryMessage(&typesP2P.DiscoveryMessage{
+		Type:   typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PEERS,
+		Sender: sender,
+		Peers:  exchangedPeers,
+	}))
+	require.Len(t, network.GetAddrBook(), 3)
+	require.Len(t, d.peerstore.getPeers(), 3)
+
+	require.Error(t, d.HandleDiscoveryMessage(&typesP2P.DiscoveryMessage{Sender: sender}))
+}
+
+func TestDiscovery_RemovesPeersMissingPings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	d, network := newTestingDiscovery(t, ctrl, 2, nil)
+
+	alive, dead := newTestingPeerInfo(t, "node2.consensus:8080"), newTestingPeerInfo(t, "node3.consensus:8080")
+	for _, peerInfo := range []*typesP2P.PeerInfo{alive, dead} {
+		_, err := d.addPeer(peerInfo)
+		require.NoError(t, err)
+	}
+
+	for round := 0; round < 3; round++ {
+		d.runRound()
+		require.NoError(t, d.HandleDiscoveryMessage(&typesP2P.DiscoveryMessage{
+			Type:   typesP2P.DiscoveryMessageType_DISCOVERY_MESSAGE_TYPE_PONG,
+			Sender: alive,
+		}))
+	}
+
+	require.Len(t, network.GetAddrBook(), 1)
+	require.Equal(t, "node2.consensus:8080", network.GetAddrBook()[0].ServiceUrl)
+	require.Len(t, d.peerstore.getPeers(), 1)
+}
+
+func TestDiscovery_KeepsStakedValidatorsMissingPings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	validator := newTestingPeerInfo(t, "node2.consensus:8080")
+	validatorPublicKey, err := cryptoPocket.NewPublicKey(validator.GetPublicKey())
+	require.NoError(t, err)
+	stakedAddrBook := typesP2P.AddrBook{{
+		PublicKey:  validatorPublicKey,
+		Address:    validatorPublicKey.Address(),
+		ServiceUrl: validator.GetServiceUrl(),
+	}}
+	d, network := newTestingDiscovery(t, ctrl, 2, stakedAddrBook)
+
+	// The validator is in the address book the network started with, and the other peer is discovered
+	require.NoError(t, network.AddPeerToAddrBook(stakedAddrBook[0]))
+	_, err = d.addPeer(newTestingPeerInfo(t, "node3.consensus:8080"))
+	require.NoError(t, err)
+
+	for round := 0; round < 3; round++ {
+		d.runRound()
+	}
+
+	// Neither peer answered the pings, but only the discovered peer is removed
+	require.Len(t, network.GetAddrBook(), 1)
+	require.Equal(t, "node2.consensus:8080", network.GetAddrBook()[0].ServiceUrl)
+	require.Empty(t, d.peerstore.getPeers())
+}
+
+// testingNetwork is a network keeping an in-memory address book and recording the messages sent
+type testingNetwork struct {
+	*mocksP2P.MockNetwork
+
+	m        sync.Mutex
+	addrBook typesP2P.AddrBook
+	sent     [][]byte
+}
+
+func newTestingNetwork(ctrl *gomock.Controller) *testingNetwork {
+	network := &testingNetwork{MockNetwork: mocksP2P.NewMockNetwork(ctrl)}
+	network.EXPECT().GetAddrBook().DoAndReturn(func() typesP2P.AddrBook {
+		network.m.Lock()
+		defer network.m.Unlock()
+		return append(typesP2P.AddrBook{}, network.addrBook...)
+	}).AnyTimes()
+	network.EXPECT().AddPeerToAddrBook(gomock.Any()).DoAndReturn(func(peer *typesP2P.NetworkPeer) error {
+		network.m.Lock()
+		defer network.m.Unlock()
+		network.addrBook = append(network.addrBook, peer)
+		return nil
+	}).AnyTimes()
+	network.EXPECT().RemovePeerToAddrBook(gomock.Any()).DoAndReturn(func(peer *typesP2P.NetworkPeer) error {
+		network.m.Lock()
+		defer network.m.Unlock()
+		for i, p := range network.addrBook {
+			if p.Address.Equals(peer.Address) {
+				network.addrBook = append(network.addrBook[:i], network.addrBook[i+1:]...)
+				break
+			}
+		}
+		return nil
+	}).AnyTimes()
+	network.EXPECT().NetworkSend(gomock.Any(), gomock.Any()).DoAndReturn(func(data []byte, _ cryptoPocket.Address) error {
+		network.m.Lock()
+		defer network.m.Unlock()
+		network.sent = append(network.sent, data)
+		return nil
+	}).AnyTimes()
+	return network
+}
+
+// sentTypes returns the types of the discovery messages sent since it was last called
+func (n *testingNetwork) sentTypes(t *testing.T) []typesP2P.DiscoveryMessageType {
+	n.m.Lock()
+	defer n.m.Unlock()
+	msgTypes := make([]typesP2P.DiscoveryMessageType, 0, len(n.sent))
+	for _, data := range n.sent {
+		envelope := &messaging.PocketEnvelope{}
+		require.NoError(t, proto.Unmarshal(data, envelope))
+		msg, err := messaging.UnpackMessage[*typesP2P.DiscoveryMessage](envelope)
+		require.NoError(t, err)
+		msgTypes = append(msgTypes, msg.GetType())
+	}
+	n.sent = nil
+	return msgTypes
+}
+
+func newTestingDiscovery(t *testing.T, ctrl *gomock.Controller, maxMissedPings uint32, stakedAddrBook typesP2P.AddrBook) (*Discovery, *testingNetwork) {
+	privateKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	cfg := &configs.Config{P2P: &configs.P2PConfig{
+		PrivateKey:     privateKey.String(),
+		ServiceUrl:     "node1.consensus:8080",
+		PeerstorePath:  filepath.Join(t.TempDir(), "peerstore.json"),
+		MaxMissedPings: maxMissedPings,
+	}}
+	runtimeMgrMock := mockModules.NewMockRuntimeMgr(ctrl)
+	runtimeMgrMock.EXPECT().GetConfig().Return(cfg).AnyTimes()
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetRuntimeMgr().Return(runtimeMgrMock).AnyTimes()
+
+	addrBookProviderMock := mocksP2P.NewMockAddrBookProvider(ctrl)
+	addrBookProviderMock.EXPECT().GetConnFactory().Return(func(_ *configs.P2PConfig, _ string, _ cryptoPocket.PublicKey) (typesP2P.Transport, error) {
+		return mocksP2P.NewMockTransport(ctrl), nil
+	}).AnyTimes()
+	addrBookProviderMock.EXPECT().GetStakedAddrBookAtHeight(gomock.Any()).Return(stakedAddrBook, nil).AnyTimes()
+	currentHeightProviderMock := mocksP2P.NewMockCurrentHeightProvider(ctrl)
+	currentHeightProviderMock.EXPECT().CurrentHeight().Return(uint64(0)).AnyTimes()
+
+	network := newTestingNetwork(ctrl)
+	d, err := NewDiscovery(busMock, network, addrBookProviderMock, currentHeightProviderMock, privateKey.PublicKey())
+	require.NoError(t, err)
+	return d, network
+}
+
+func newTestingPeerInfo(t *testing.T, serviceUrl string) *typesP2P.PeerInfo {
+	privateKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+	return &typesP2P.PeerInfo{PublicKey: privateKey.PublicKey().String(), ServiceUrl: serviceUrl}
+}
@@ -0,0 +1,132 @@
+package discovery
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+)
+
+const peerstoreFilePerm = 0600
+
+// peerstore keeps the peers added by discovery, so that a restarted node reconnects to the network it knew without
+// relying on its bootstrap peers. The peers are persisted to a JSON file, and only kept in memory if the path is empty.
+type peerstore struct {
+	m sync.Mutex
+
+	path  string
+	peers map[string]*typesP2P.PeerInfo // by address
+	dirty bool
+}
+
+type storedPeer struct {
+	PublicKey  string `json:"public_key"`
+	ServiceUrl string `json:"service_url"`
+}
+
+// newPeerstore loads the peers persisted to `path`, if any
+func newPeerstore(path string) (*peerstore, error) {
+	ps := &peerstore{
+		path:  path,
+		peers: make(map[string]*typesP2P.PeerInfo),
+	}
+	if path == "" {
+		return ps, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ps, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var storedPeers []storedPeer
+	if err := json.Unmarshal(data, &storedPeers); err != nil {
+		return nil, err
+	}
+	for _, storedPeer := range storedPeers {
+		peerInfo := &typesP2P.PeerInfo{PublicKey: storedPeer.PublicKey, ServiceUrl: storedPeer.ServiceUrl}
+		address, err := getPeerInfoAddress(peerInfo)
+		if err != nil {
+			return nil, err
+		}
+		ps.peers[address] = peerInfo
+	}
+	return ps, nil
+}
+
+func (ps *peerstore) addPeer(address string, peerInfo *typesP2P.PeerInfo) {
+	ps.m.Lock()
+	defer ps.m.Unlock()
+	ps.peers[address] = peerInfo
+	ps.dirty = true
+}
+
+func (ps *peerstore) removePeer(address string) {
+	ps.m.Lock()
+	defer ps.m.Unlock()
+	if _, ok := ps.peers[address]; ok {
+		delete(ps.peers, address)
+		ps.dirty = true
+	}
+}
+
+// getPeers returns the stored peers sorted by address
+func (ps *peerstore) getPeers() []*typesP2P.PeerInfo {
+	ps.m.Lock()
+	defer ps.m.Unlock()
+	return ps.sortedPeers()
+}
+
+func (ps *peerstore) sortedPeers() []*typesP2P.PeerInfo {
+	addresses := make([]string, 0, len(ps.peers))
+	for address := range ps.peers {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	peers := make([]*typesP2P.PeerInfo, 0, len(addresses))
+	for _, address := range addresses {
+		peers = append(peers, ps.peers[address])
+	}
+	return peers
+}
+
+// save persists the peers if they changed since they were last saved. The file is replaced atomically so a crash
+// while saving does not lose the previous peers.
+func (ps *peerstore) save() error {
+	if ps.path == "" {
+		return nil
+	}
+	ps.m.Lock()
+	defer ps.m.Unlock()
+	if !ps.dirty {
+		return nil
+	}
+
+	storedPeers := make([]storedPeer, 0, len(ps.peers))
+	for _, peerInfo := range ps.sortedPeers() {
+		storedPeers = append(storedPeers, storedPeer{PublicKey: peerInfo.GetPublicKey(), ServiceUrl: peerInfo.GetServiceUrl()})
+	}
+	data, err := json.MarshalIndent(storedPeers, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ps.path), 0700); err != nil {
+		return err
+	}
+	tmpPath := ps.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, peerstoreFilePerm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, ps.path); err != nil {
+		return err
+	}
+	ps.dirty = false
+	return nil
+}
@@ -2,10 +2,14 @@ package p2p
 
 import (
 	"log"
+	"sync"
+	"time"
 
+	"github.com/pokt-network/pocket/p2p/discovery"
 	"github.com/pokt-network/pocket/p2p/providers"
 	persABP "github.com/pokt-network/pocket/p2p/providers/addrbook_provider/persistence"
 	"github.com/pokt-network/pocket/p2p/raintree"
+	"github.com/pokt-network/pocket/p2p/reputation"
 	"github.com/pokt-network/pocket/p2p/stdnetwork"
 	"github.com/pokt-network/pocket/p2p/transport"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
@@ -22,10 +26,16 @@ var _ modules.P2PModule = &p2pModule{}
 type p2pModule struct {
 	bus modules.Bus
 
-	listener typesP2P.Transport
-	address  cryptoPocket.Address
+	listener  typesP2P.Transport
+	address   cryptoPocket.Address
+	publicKey cryptoPocket.PublicKey
 
-	network typesP2P.Network
+	// The listener authorizes the peers against the address book of the network, which is created once the module starts
+	networkMu   sync.RWMutex
+	network     typesP2P.Network
+	discovery   *discovery.Discovery
+	connManager *transport.ConnManager
+	reputation  *reputation.Reputation
 
 	injectedAddrBookProvider      providers.AddrBookProvider
 	injectedCurrentHeightProvider providers.CurrentHeightProvider
@@ -50,11 +60,12 @@ func CreateWithProviders(bus modules.Bus, addrBookProvider providers.AddrBookPro
 		return nil, err
 	}
 	m.address = privateKey.Address()
+	m.publicKey = privateKey.PublicKey()
 	m.injectedAddrBookProvider = addrBookProvider
 	m.injectedCurrentHeightProvider = currentHeightProvider
 
 	if !cfg.ClientDebugMode {
-		l, err := transport.CreateListener(p2pCfg)
+		l, err := transport.CreateListener(p2pCfg, m.authorizePeer)
 		if err != nil {
 			return nil, err
 		}
@@ -78,9 +89,10 @@ func (*p2pModule) Create(bus modules.Bus) (modules.Module, error) {
 		return nil, err
 	}
 	m.address = privateKey.Address()
+	m.publicKey = privateKey.PublicKey()
 
 	if !cfg.ClientDebugMode {
-		l, err := transport.CreateListener(p2pCfg)
+		l, err := transport.CreateListener(p2pCfg, m.authorizePeer)
 		if err != nil {
 			return nil, err
 		}
@@ -111,16 +123,19 @@ func (m *p2pModule) GetModuleName() string {
 func (m *p2pModule) Start() error {
 	log.Println("Starting network module")
 
+	m.connManager = transport.NewConnManager(m.GetBus())
 	addrbookProvider := getAddrBookProvider(m)
 	currentHeightProvider := getCurrentHeightProvider(m)
 
 	cfg := m.GetBus().GetRuntimeMgr().GetConfig()
 
+	m.networkMu.Lock()
 	if cfg.P2P.UseRainTree {
 		m.network = raintree.NewRainTreeNetwork(m.address, m.GetBus(), addrbookProvider, currentHeightProvider)
 	} else {
 		m.network = stdnetwork.NewNetwork(m.GetBus(), addrbookProvider, currentHeightProvider)
 	}
+	m.networkMu.Unlock()
 
 	if cfg.ClientDebugMode {
 		return nil
@@ -133,18 +148,47 @@ func (m *p2pModule) Start() error {
 			telemetry.P2P_NODE_STARTED_TIMESERIES_METRIC_NAME,
 			telemetry.P2P_NODE_STARTED_TIMESERIES_METRIC_DESCRIPTION,
 		)
+	m.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		GaugeRegister(
+			telemetry.P2P_CONNECTED_PEERS_GAUGE_NAME,
+			telemetry.P2P_CONNECTED_PEERS_GAUGE_DESCRIPTION,
+		)
+	m.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		GaugeRegister(
+			telemetry.P2P_BANNED_PEERS_GAUGE_NAME,
+			telemetry.P2P_BANNED_PEERS_GAUGE_DESCRIPTION,
+		)
+
+	peerReputation, err := reputation.NewReputation(m.GetBus())
+	if err != nil {
+		return err
+	}
+	m.reputation = peerReputation
 
 	go func() {
 		for {
-			data, err := m.listener.Read()
+			data, remotePublicKey, stream, err := m.listener.Read()
 			if err != nil {
 				log.Println("Error reading data from connection: ", err)
 				continue
 			}
-			go m.handleNetworkMessage(data)
+			go m.handleNetworkMessage(data, remotePublicKey, stream)
 		}
 	}()
 
+	peerDiscovery, err := discovery.NewDiscovery(m.GetBus(), m.network, addrbookProvider, currentHeightProvider, m.publicKey)
+	if err != nil {
+		return err
+	}
+	if err := peerDiscovery.Start(); err != nil {
+		return err
+	}
+	m.discovery = peerDiscovery
+
 	m.GetBus().
 		GetTelemetryModule().
 		GetTimeSeriesAgent().
@@ -157,7 +201,9 @@ func (m *p2pModule) Start() error {
 func getAddrBookProvider(m *p2pModule) providers.AddrBookProvider {
 	var addrbookProvider providers.AddrBookProvider
 	if m.injectedAddrBookProvider == nil {
+		// The connections to the peers are shared by the address books of all the heights
 		addrbookProvider = persABP.NewPersistenceAddrBookProvider(m.GetBus())
+		addrbookProvider.SetConnectionFactory(m.connManager.CreateDialer)
 	} else {
 		addrbookProvider = m.injectedAddrBookProvider
 	}
@@ -177,6 +223,16 @@ func getCurrentHeightProvider(m *p2pModule) providers.CurrentHeightProvider {
 
 func (m *p2pModule) Stop() error {
 	log.Println("Stopping network module")
+	if m.discovery != nil {
+		if err := m.discovery.Stop(); err != nil {
+			return err
+		}
+	}
+	if m.connManager != nil {
+		if err := m.connManager.Close(); err != nil {
+			return err
+		}
+	}
 	if err := m.listener.Close(); err != nil {
 		return err
 	}
@@ -213,10 +269,43 @@ func (m *p2pModule) GetAddress() (cryptoPocket.Address, error) {
 	return m.address, nil
 }
 
-func (m *p2pModule) handleNetworkMessage(networkMsgData []byte) {
-	appMsgData, err := m.network.HandleNetworkData(networkMsgData)
+func (m *p2pModule) ReportInvalidMessage(msg *anypb.Any) {
+	if m.reputation == nil {
+		return
+	}
+	m.reputation.ReportInvalidContent(msg)
+}
+
+func (m *p2pModule) GetPeerScores() map[string]float64 {
+	if m.reputation == nil {
+		return map[string]float64{}
+	}
+	return m.reputation.GetScores()
+}
+
+func (m *p2pModule) GetPeerBans() map[string]time.Time {
+	if m.reputation == nil {
+		return map[string]time.Time{}
+	}
+	return m.reputation.GetBans()
+}
+
+// handleNetworkMessage handles the data received on the stream from the peer that authenticated with
+// `remotePublicKey`, which is nil if the transport does not authenticate its peers. Only the authenticated peers are
+// scored by their reputation.
+func (m *p2pModule) handleNetworkMessage(networkMsgData []byte, remotePublicKey cryptoPocket.PublicKey, stream typesP2P.Stream) {
+	var remoteAddr string
+	if remotePublicKey != nil && m.reputation != nil {
+		remoteAddr = remotePublicKey.Address().String()
+		if !m.reputation.AllowMessage(remoteAddr, stream, networkMsgData) {
+			return
+		}
+	}
+
+	appMsgData, err := m.network.HandleNetworkData(networkMsgData, remotePublicKey)
 	if err != nil {
 		log.Println("Error handling raw data: ", err)
+		m.reportInvalidNetworkMessage(remoteAddr)
 		return
 	}
 
@@ -230,12 +319,88 @@ func (m *p2pModule) handleNetworkMessage(networkMsgData []byte) {
 	networkMessage := messaging.PocketEnvelope{}
 	if err := proto.Unmarshal(appMsgData, &networkMessage); err != nil {
 		log.Println("Error decoding network message: ", err)
+		m.reportInvalidNetworkMessage(remoteAddr)
+		return
+	}
+
+	// The stream the message was received on is self-declared, so the peer is authorized again for its content type
+	if remotePublicKey != nil && !m.authorizePeer(remotePublicKey, typesP2P.GetStream(networkMessage.GetContentType())) {
+		log.Println("Error handling network message: ", typesP2P.ErrUnauthorizedPeer(remotePublicKey.Address().String(), networkMessage.GetContentType()))
+		return
+	}
+
+	if remoteAddr != "" {
+		// A peer cannot get its messages past the rate limit of their stream by sending them on another stream
+		if typesP2P.GetStream(networkMessage.GetContentType()) != stream {
+			log.Printf("Error handling network message: %s message received on the %s stream\n", networkMessage.GetContentType(), stream)
+			m.reputation.ReportInvalidMessage(remoteAddr)
+			return
+		}
+		m.reputation.RecordDelivery(remoteAddr, networkMessage.Content)
+	}
+
+	// Discovery messages are handled by the p2p module and not forwarded to the other modules
+	if networkMessage.GetContentType() == discovery.DiscoveryMessageContentType {
+		m.handleDiscoveryMessage(&networkMessage, remotePublicKey)
 		return
 	}
 
 	event := messaging.PocketEnvelope{
 		Content: networkMessage.Content,
 	}
+	// The modules identify the peer by the key it authenticated with, rather than by the identity it claims
+	if remotePublicKey != nil {
+		event.Sender = remotePublicKey.Address().String()
+	}
 
 	m.GetBus().PublishEventToBus(&event)
 }
+
+// authorizePeer accepts the messages of the peers of the address book, which holds the staked validators and the
+// peers added by discovery. A peer that is not known yet may only send discovery messages, through which it joins
+// the address book.
+func (m *p2pModule) authorizePeer(publicKey cryptoPocket.PublicKey, stream typesP2P.Stream) bool {
+	if stream == typesP2P.StreamDiscovery {
+		return true
+	}
+	m.networkMu.RLock()
+	network := m.network
+	m.networkMu.RUnlock()
+	if network == nil {
+		return false
+	}
+	address := publicKey.Address()
+	for _, peer := range network.GetAddrBook() {
+		if peer.Address.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportInvalidNetworkMessage penalizes the authenticated peer that sent a message that cannot be decoded
+func (m *p2pModule) reportInvalidNetworkMessage(remoteAddr string) {
+	if remoteAddr == "" {
+		return
+	}
+	m.reputation.ReportInvalidMessage(remoteAddr)
+}
+
+func (m *p2pModule) handleDiscoveryMessage(networkMessage *messaging.PocketEnvelope, remotePublicKey cryptoPocket.PublicKey) {
+	if m.discovery == nil {
+		return
+	}
+	discoveryMessage, err := messaging.UnpackMessage[*typesP2P.DiscoveryMessage](networkMessage)
+	if err != nil {
+		log.Println("Error decoding discovery message: ", err)
+		return
+	}
+	// Discovery messages are sent directly to their receiver, so their sender must be the authenticated peer
+	if remotePublicKey != nil && discoveryMessage.GetSender().GetPublicKey() != remotePublicKey.String() {
+		log.Println("Error handling discovery message: ", typesP2P.ErrUnauthenticatedSender(discoveryMessage.GetSender().GetPublicKey(), remotePublicKey.String()))
+		return
+	}
+	if err := m.discovery.HandleDiscoveryMessage(discoveryMessage); err != nil {
+		log.Println("Error handling discovery message: ", err)
+	}
+}
@@ -34,14 +34,14 @@ func ActorsToAddrBook(abp AddrBookProvider, actors []*coreTypes.Actor) (typesP2P
 }
 
 func ActorToNetworkPeer(abp AddrBookProvider, actor *coreTypes.Actor) (*typesP2P.NetworkPeer, error) {
-	conn, err := abp.GetConnFactory()(abp.GetP2PConfig(), actor.GetGenericParam()) // generic param is service url
+	pubKey, err := cryptoPocket.NewPublicKey(actor.GetPublicKey())
 	if err != nil {
-		return nil, fmt.Errorf("error resolving addr: %v", err)
+		return nil, err
 	}
 
-	pubKey, err := cryptoPocket.NewPublicKey(actor.GetPublicKey())
+	conn, err := abp.GetConnFactory()(abp.GetP2PConfig(), actor.GetGenericParam(), pubKey) // generic param is service url
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error resolving addr: %v", err)
 	}
 
 	peer := &typesP2P.NetworkPeer{
@@ -54,6 +54,32 @@ func ActorToNetworkPeer(abp AddrBookProvider, actor *coreTypes.Actor) (*typesP2P
 	return peer, nil
 }
 
+// GetAddrBookDelta returns the peers of `stakedAddrBook` that are not in `addrBook` and the peers of `addrBook`
+// that are not staked anymore, so a network can keep its address book up to date through its `AddPeerToAddrBook`
+// and `RemovePeerToAddrBook` helpers. A peer whose service url changed is both removed and added.
+func GetAddrBookDelta(addrBook, stakedAddrBook typesP2P.AddrBook) (added, removed typesP2P.AddrBook) {
+	addrBookMap := make(typesP2P.AddrBookMap, len(addrBook))
+	for _, peer := range addrBook {
+		addrBookMap[peer.Address.String()] = peer
+	}
+	stakedAddrBookMap := make(typesP2P.AddrBookMap, len(stakedAddrBook))
+	for _, peer := range stakedAddrBook {
+		stakedAddrBookMap[peer.Address.String()] = peer
+	}
+
+	for address, peer := range stakedAddrBookMap {
+		if current, ok := addrBookMap[address]; !ok || current.ServiceUrl != peer.ServiceUrl {
+			added = append(added, peer)
+		}
+	}
+	for address, peer := range addrBookMap {
+		if staked, ok := stakedAddrBookMap[address]; !ok || staked.ServiceUrl != peer.ServiceUrl {
+			removed = append(removed, peer)
+		}
+	}
+	return added, removed
+}
+
 // WithConnectionFactory allows the user to specify a custom connection factory
 func WithConnectionFactory(connFactory typesP2P.ConnectionFactory) func(AddrBookProvider) {
 	return func(ap AddrBookProvider) {
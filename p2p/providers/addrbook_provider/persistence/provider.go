@@ -5,6 +5,7 @@ import (
 	"github.com/pokt-network/pocket/p2p/transport"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
 	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/modules"
 )
 
@@ -38,6 +39,8 @@ func (pabp *persistenceAddrBookProvider) SetBus(bus modules.Bus) {
 	pabp.bus = bus
 }
 
+// GetStakedAddrBookAtHeight returns the address book of the validator set of the epoch of the height, which is the
+// validator set consensus uses at that height
 func (pabp *persistenceAddrBookProvider) GetStakedAddrBookAtHeight(height uint64) (typesP2P.AddrBook, error) {
 	persistenceReadContext, err := pabp.GetBus().GetPersistenceModule().NewReadContext(int64(height))
 	if err != nil {
@@ -45,7 +48,12 @@ func (pabp *persistenceAddrBookProvider) GetStakedAddrBookAtHeight(height uint64
 	}
 	defer persistenceReadContext.Close()
 
-	validators, err := persistenceReadContext.GetAllValidators(int64(height))
+	consensusCfg := pabp.GetBus().GetRuntimeMgr().GetConfig().Consensus
+	snapshotHeight := coreTypes.GetEpochSnapshotHeight(height, consensusCfg.GetEpochLength())
+	if consensusCfg.GetHotstuffMode() == configs.HotstuffMode_ChainedHotstuff {
+		snapshotHeight = coreTypes.GetChainedEpochSnapshotHeight(height, consensusCfg.GetEpochLength())
+	}
+	validators, err := persistenceReadContext.GetAllValidators(int64(snapshotHeight))
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,8 @@ import (
 	"log"
 	"math"
 	"strings"
+
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 )
 
 // Refer to the P2P specification for a formal description and proof of how the constants are selected
@@ -72,6 +74,46 @@ func (n *rainTreeNetwork) getTarget(targetPercentage float64, addrBookLen int, l
 	return target
 }
 
+// getFallbackTargets returns the peers following the target in the address book, up to `subtreeEnd`, which the message
+// is sent to in turn if the target does not acknowledge it
+func (n *rainTreeNetwork) getFallbackTargets(t target, subtreeEnd int) []cryptoPocket.Address {
+	peersManagerStateView := n.peersManager.getNetworkView()
+	if subtreeEnd > len(peersManagerStateView.addrList) {
+		subtreeEnd = len(peersManagerStateView.addrList)
+	}
+
+	fallbacks := make([]cryptoPocket.Address, 0, maxFallbackTargets)
+	for i := t.index + 1; i < subtreeEnd && len(fallbacks) < maxFallbackTargets; i++ {
+		if peer, ok := peersManagerStateView.addrBookMap[peersManagerStateView.addrList[i]]; ok {
+			fallbacks = append(fallbacks, peer.Address)
+		}
+	}
+	return fallbacks
+}
+
+// getNeighbours returns the peers before and after self in the address book
+func (n *rainTreeNetwork) getNeighbours() []cryptoPocket.Address {
+	peersManagerStateView := n.peersManager.getNetworkView()
+	addrList := peersManagerStateView.addrList
+
+	// Self is the first peer of the address list
+	var indices []int
+	switch {
+	case len(addrList) == 2:
+		indices = []int{1}
+	case len(addrList) > 2:
+		indices = []int{1, len(addrList) - 1}
+	}
+
+	neighbours := make([]cryptoPocket.Address, 0, len(indices))
+	for _, i := range indices {
+		if peer, ok := peersManagerStateView.addrBookMap[addrList[i]]; ok {
+			neighbours = append(neighbours, peer.Address)
+		}
+	}
+	return neighbours
+}
+
 // Only used for debug logging to understand what RainTree is doing under the hood
 func (n *rainTreeNetwork) debugMsgTargetString(target1, target2 target) string {
 	s := strings.Builder{}
@@ -24,10 +24,16 @@ var _ modules.IntegratableModule = &rainTreeNetwork{}
 type rainTreeNetwork struct {
 	bus modules.Bus
 
-	selfAddr         cryptoPocket.Address
-	addrBookProvider addrbook_provider.AddrBookProvider
+	selfAddr              cryptoPocket.Address
+	addrBookProvider      addrbook_provider.AddrBookProvider
+	currentHeightProvider providers.CurrentHeightProvider
 
 	peersManager *peersManager
+	// The staked address book the peersManager was last refreshed with, and its height. The peersManager also holds
+	// the peers added by discovery, which are not removed when the staked address book changes.
+	stakedAddrBook      typesP2P.AddrBook
+	addrBookHeight      uint64
+	addrBookHeightMutex sync.Mutex
 
 	// TODO (#278): What should we use for de-duping messages within P2P?
 	// TODO(#388): generalize to use the shared `FIFOMempool` type (in `utility/types/mempool.go` at the time of writing) in here as well for this.
@@ -35,10 +41,18 @@ type rainTreeNetwork struct {
 	nonceList        []uint64
 	mempoolMaxNonces uint64
 	nonceSetMutex    sync.Mutex
+
+	// Whether the messages broadcast at the last level are also sent to the neighbours of the node
+	useRedundancyLayer bool
+	// The time a target has to acknowledge a message, 0 if the targets do not acknowledge the messages
+	ackTimeout       time.Duration
+	pendingAcks      map[ackKey]*pendingAck
+	pendingAcksMutex sync.Mutex
 }
 
 func NewRainTreeNetwork(addr cryptoPocket.Address, bus modules.Bus, addrBookProvider providers.AddrBookProvider, currentHeightProvider providers.CurrentHeightProvider) typesP2P.Network {
-	addrBook, err := addrBookProvider.GetStakedAddrBookAtHeight(currentHeightProvider.CurrentHeight())
+	height := currentHeightProvider.CurrentHeight()
+	addrBook, err := addrBookProvider.GetStakedAddrBookAtHeight(height)
 	if err != nil {
 		log.Fatalf("[ERROR] Error getting addrBook: %v", err)
 	}
@@ -51,21 +65,29 @@ func NewRainTreeNetwork(addr cryptoPocket.Address, bus modules.Bus, addrBookProv
 	p2pCfg := bus.GetRuntimeMgr().GetConfig().P2P
 
 	n := &rainTreeNetwork{
-		selfAddr:         addr,
-		peersManager:     pm,
-		nonceSet:         make(map[uint64]struct{}),
-		nonceList:        make([]uint64, 0, p2pCfg.MaxMempoolCount),
-		addrBookProvider: addrBookProvider,
+		selfAddr:              addr,
+		peersManager:          pm,
+		stakedAddrBook:        addrBook,
+		addrBookHeight:        height,
+		nonceSet:              make(map[uint64]struct{}),
+		nonceList:             make([]uint64, 0, p2pCfg.MaxMempoolCount),
+		mempoolMaxNonces:      p2pCfg.MaxMempoolCount,
+		addrBookProvider:      addrBookProvider,
+		currentHeightProvider: currentHeightProvider,
+		useRedundancyLayer:    p2pCfg.GetUseRainTreeRedundancyLayer(),
+		ackTimeout:            time.Duration(p2pCfg.GetRainTreeAckTimeoutMsec()) * time.Millisecond,
+		pendingAcks:           make(map[ackKey]*pendingAck),
 	}
 	n.SetBus(bus)
 	return typesP2P.Network(n)
 }
 
 func (n *rainTreeNetwork) NetworkBroadcast(data []byte) error {
-	return n.networkBroadcastAtLevel(data, n.peersManager.getNetworkView().maxNumLevels, getNonce())
+	n.refreshAddrBook()
+	return n.networkBroadcastAtLevel(data, n.peersManager.getNetworkView().maxNumLevels, getNonce(), typesP2P.GetEnvelopeStream(data))
 }
 
-func (n *rainTreeNetwork) networkBroadcastAtLevel(data []byte, level uint32, nonce uint64) error {
+func (n *rainTreeNetwork) networkBroadcastAtLevel(data []byte, level uint32, nonce uint64, stream typesP2P.Stream) error {
 	// This is handled either by the cleanup layer or redundancy layer
 	if level == 0 {
 		return nil
@@ -75,29 +97,40 @@ func (n *rainTreeNetwork) networkBroadcastAtLevel(data []byte, level uint32, non
 		Data:  data,
 		Nonce: nonce,
 	}
+	if n.ackTimeout > 0 {
+		msg.Sender = n.selfAddr
+	}
 	msgBz, err := codec.GetCodec().Marshal(msg)
 	if err != nil {
 		return err
 	}
 
-	for _, target := range n.getTargetsAtLevel(level) {
+	targets := n.getTargetsAtLevel(level)
+	for i, target := range targets {
 		if shouldSendToTarget(target) {
-			if err = n.networkSendInternal(msgBz, target.address); err != nil {
-				log.Println("Error sending to peer during broadcast: ", err)
+			// The subtree of a target spans the peers up to the next target
+			subtreeEnd := target.addrBookLengthAtHeight
+			if i+1 < len(targets) && targets[i+1].index > target.index {
+				subtreeEnd = targets[i+1].index
 			}
+			n.networkSendWithAck(msgBz, level, nonce, target.address, stream, n.getFallbackTargets(target, subtreeEnd))
 		}
 	}
 
-	if err = n.demote(msg); err != nil {
+	if level == 1 && n.useRedundancyLayer {
+		n.sendToNeighbours(data, nonce, stream, targets)
+	}
+
+	if err = n.demote(msg, stream); err != nil {
 		log.Println("Error demoting self during RainTree message propagation: ", err)
 	}
 
 	return nil
 }
 
-func (n *rainTreeNetwork) demote(rainTreeMsg *typesP2P.RainTreeMessage) error {
+func (n *rainTreeNetwork) demote(rainTreeMsg *typesP2P.RainTreeMessage, stream typesP2P.Stream) error {
 	if rainTreeMsg.Level > 0 {
-		if err := n.networkBroadcastAtLevel(rainTreeMsg.Data, rainTreeMsg.Level-1, rainTreeMsg.Nonce); err != nil {
+		if err := n.networkBroadcastAtLevel(rainTreeMsg.Data, rainTreeMsg.Level-1, rainTreeMsg.Nonce, stream); err != nil {
 			return err
 		}
 	}
@@ -105,6 +138,7 @@ func (n *rainTreeNetwork) demote(rainTreeMsg *typesP2P.RainTreeMessage) error {
 }
 
 func (n *rainTreeNetwork) NetworkSend(data []byte, address cryptoPocket.Address) error {
+	n.refreshAddrBook()
 	msg := &typesP2P.RainTreeMessage{
 		Level: 0, // Direct send that does not need to be propagated
 		Data:  data,
@@ -116,10 +150,10 @@ func (n *rainTreeNetwork) NetworkSend(data []byte, address cryptoPocket.Address)
 		return err
 	}
 
-	return n.networkSendInternal(bz, address)
+	return n.networkSendInternal(bz, address, typesP2P.GetEnvelopeStream(data))
 }
 
-func (n *rainTreeNetwork) networkSendInternal(data []byte, address cryptoPocket.Address) error {
+func (n *rainTreeNetwork) networkSendInternal(data []byte, address cryptoPocket.Address, stream typesP2P.Stream) error {
 	// NOOP: Trying to send a message to self
 	if n.selfAddr.Equals(address) {
 		return nil
@@ -130,7 +164,7 @@ func (n *rainTreeNetwork) networkSendInternal(data []byte, address cryptoPocket.
 		return fmt.Errorf("address %s not found in addrBookMap", address.String())
 	}
 
-	if err := peer.Dialer.Write(data); err != nil {
+	if err := peer.Dialer.Write(data, stream); err != nil {
 		log.Println("Error writing to peer during send: ", err)
 		return err
 	}
@@ -153,7 +187,7 @@ func (n *rainTreeNetwork) networkSendInternal(data []byte, address cryptoPocket.
 	return nil
 }
 
-func (n *rainTreeNetwork) HandleNetworkData(data []byte) ([]byte, error) {
+func (n *rainTreeNetwork) HandleNetworkData(data []byte, remotePublicKey cryptoPocket.PublicKey) ([]byte, error) {
 	blockHeightInt := n.GetBus().GetConsensusModule().CurrentHeight()
 	blockHeight := fmt.Sprintf("%d", blockHeightInt)
 
@@ -171,6 +205,16 @@ func (n *rainTreeNetwork) HandleNetworkData(data []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if rainTreeMsg.IsAck {
+		n.handleAck(&rainTreeMsg, remotePublicKey)
+		return nil, nil
+	}
+	if len(rainTreeMsg.Sender) > 0 {
+		if err := n.sendAck(&rainTreeMsg, remotePublicKey); err != nil {
+			log.Println("Error acknowledging RainTree message: ", err)
+		}
+	}
+
 	networkMessage := messaging.PocketEnvelope{}
 	if err := proto.Unmarshal(rainTreeMsg.Data, &networkMessage); err != nil {
 		log.Println("Error decoding network message: ", err)
@@ -178,8 +222,10 @@ func (n *rainTreeNetwork) HandleNetworkData(data []byte) ([]byte, error) {
 	}
 
 	// Continue RainTree propagation
+	n.refreshAddrBook()
 	if rainTreeMsg.Level > 0 {
-		if err := n.networkBroadcastAtLevel(rainTreeMsg.Data, rainTreeMsg.Level-1, rainTreeMsg.Nonce); err != nil {
+		stream := typesP2P.GetStream(networkMessage.GetContentType())
+		if err := n.networkBroadcastAtLevel(rainTreeMsg.Data, rainTreeMsg.Level-1, rainTreeMsg.Nonce, stream); err != nil {
 			return nil, err
 		}
 	}
@@ -238,6 +284,44 @@ func (n *rainTreeNetwork) RemovePeerToAddrBook(peer *typesP2P.NetworkPeer) error
 	return nil
 }
 
+// refreshAddrBook applies the changes of the staked address book since the last refresh, so that the validators that
+// joined, left or rotated their key in the previous block are reflected while the discovered peers are kept
+func (n *rainTreeNetwork) refreshAddrBook() {
+	n.addrBookHeightMutex.Lock()
+	defer n.addrBookHeightMutex.Unlock()
+
+	height := n.currentHeightProvider.CurrentHeight()
+	if height == n.addrBookHeight {
+		return
+	}
+	stakedAddrBook, err := n.addrBookProvider.GetStakedAddrBookAtHeight(height)
+	if err != nil {
+		log.Printf("[WARN] Error getting the staked addrBook at height %d: %v\n", height, err)
+		return
+	}
+
+	added, removed := addrbook_provider.GetAddrBookDelta(n.stakedAddrBook, stakedAddrBook)
+	for _, peer := range removed {
+		// self is always kept in the addrBook since the RainTree targets are computed relative to it
+		if peer.Address.Equals(n.selfAddr) {
+			continue
+		}
+		if err := n.RemovePeerToAddrBook(peer); err != nil {
+			log.Println("[WARN] Error removing peer from addrBook: ", err)
+		}
+	}
+	for _, peer := range added {
+		if peer.Address.Equals(n.selfAddr) {
+			continue
+		}
+		if err := n.AddPeerToAddrBook(peer); err != nil {
+			log.Println("[WARN] Error adding peer to addrBook: ", err)
+		}
+	}
+	n.stakedAddrBook = stakedAddrBook
+	n.addrBookHeight = height
+}
+
 func (n *rainTreeNetwork) SetBus(bus modules.Bus) {
 	n.bus = bus
 }
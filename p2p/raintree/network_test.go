@@ -5,6 +5,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	mocksP2P "github.com/pokt-network/pocket/p2p/types/mocks"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"github.com/stretchr/testify/require"
 )
@@ -77,3 +78,39 @@ func TestRainTreeNetwork_RemovePeerToAddrBook(t *testing.T) {
 	require.Equal(t, selfPeer, stateView.addrBookMap[selfAddr.String()], "addrBookMap contains self")
 	require.NotContains(t, stateView.addrBookMap, peer.Address.String(), "addrBookMap contains removed peer key")
 }
+
+func TestRainTreeNetwork_RefreshAddrBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	selfAddr, err := cryptoPocket.GenerateAddress()
+	require.NoError(t, err)
+	addrBook := getAddrBook(t, 3)
+	addrBook = append(addrBook, &typesP2P.NetworkPeer{Address: selfAddr})
+
+	// at the next height, the key of the first peer was rotated
+	rotatedPeer := getAddrBook(t, 1)[0]
+	nextAddrBook := append(typesP2P.AddrBook{rotatedPeer}, addrBook[1:]...)
+
+	busMock := mockBus(ctrl)
+	addrBookProviderMock := mocksP2P.NewMockAddrBookProvider(ctrl)
+	addrBookProviderMock.EXPECT().GetStakedAddrBookAtHeight(uint64(0)).Return(addrBook, nil).AnyTimes()
+	addrBookProviderMock.EXPECT().GetStakedAddrBookAtHeight(uint64(1)).Return(nextAddrBook, nil).AnyTimes()
+	height := uint64(0)
+	currentHeightProviderMock := mocksP2P.NewMockCurrentHeightProvider(ctrl)
+	currentHeightProviderMock.EXPECT().CurrentHeight().DoAndReturn(func() uint64 { return height }).AnyTimes()
+
+	network := NewRainTreeNetwork(selfAddr, busMock, addrBookProviderMock, currentHeightProviderMock).(*rainTreeNetwork)
+
+	// the address book is not refreshed within a height
+	network.refreshAddrBook()
+	stateView := network.peersManager.getNetworkView()
+	require.Contains(t, stateView.addrBookMap, addrBook[0].Address.String())
+
+	height = 1
+	network.refreshAddrBook()
+	stateView = network.peersManager.getNetworkView()
+	require.Equal(t, len(nextAddrBook), len(stateView.addrList))
+	require.NotContains(t, stateView.addrBookMap, addrBook[0].Address.String(), "addrBookMap contains the rotated key")
+	require.Contains(t, stateView.addrBookMap, rotatedPeer.Address.String(), "addrBookMap does not contain the new key")
+	require.Contains(t, stateView.addrBookMap, selfAddr.String(), "addrBookMap does not contain self key")
+}
@@ -46,7 +46,7 @@ func newPeersManagerWithAddrBookProvider(selfAddr cryptoPocket.Address, addrBook
 func newPeersManager(selfAddr cryptoPocket.Address, addrBook typesP2P.AddrBook, isDynamic bool) (*peersManager, error) {
 	pm := &peersManager{
 		selfAddr:     selfAddr,
-		addrBook:     addrBook,
+		addrBook:     make(typesP2P.AddrBook, len(addrBook)),
 		eventCh:      make(chan addressBookEvent, 1),
 		addrBookMap:  make(typesP2P.AddrBookMap),
 		addrList:     make([]string, 0),
@@ -90,26 +90,30 @@ func newPeersManager(selfAddr cryptoPocket.Address, addrBook typesP2P.AddrBook,
 
 			peerAddress := evt.peer.Address.String()
 
+			// Adding a known peer replaces it and removing an unknown peer is a no-op, since peers are added and
+			// removed both when the staked address book changes and by peer discovery
+			_, isKnown := pm.addrBookMap[peerAddress]
+			i := pm.getAddrListIndex(peerAddress)
+
 			switch evt.eventType {
 			case addToAddressBook:
 				pm.addrBookMap[peerAddress] = evt.peer
-				// insert into sorted addrList and addrBook
-				// searching from index 1 because index 0 is self by convention and the rest of the slice is sorted
-				i := sort.SearchStrings(pm.addrList[1:], peerAddress)
-				pm.addrList = insertElementAtIndex(pm.addrList, peerAddress, i)
-				pm.addrBook = insertElementAtIndex(pm.addrBook, evt.peer, i)
+				if isKnown {
+					pm.addrBook[i] = evt.peer
+				} else {
+					pm.addrList = insertElementAtIndex(pm.addrList, peerAddress, i)
+					pm.addrBook = insertElementAtIndex(pm.addrBook, evt.peer, i)
+				}
 
 				updateMaxNumLevels(pm)
 
 				pm.wg.Done()
 			case removeFromAddressBook:
-				delete(pm.addrBookMap, peerAddress)
-
-				// remove from sorted addrList and addrBook
-				// searching from index 1 because index 0 is self by convention and the rest of the slice is sorted
-				i := sort.SearchStrings(pm.addrList[1:], peerAddress)
-				pm.addrList = removeElementAtIndex(pm.addrList, i)
-				pm.addrBook = removeElementAtIndex(pm.addrBook, i)
+				if isKnown {
+					delete(pm.addrBookMap, peerAddress)
+					pm.addrList = removeElementAtIndex(pm.addrList, i)
+					pm.addrBook = removeElementAtIndex(pm.addrBook, i)
+				}
 
 				updateMaxNumLevels(pm)
 
@@ -134,6 +138,21 @@ func (pm *peersManager) getNetworkView() networkView {
 	}
 }
 
+// getAddrListIndex returns the index of the address in addrList, or the index it is inserted at to keep the order of
+// addrList: the addresses are sorted lexicographically starting from the address of this node and wrapping around
+func (pm *peersManager) getAddrListIndex(address string) int {
+	selfAddress := pm.selfAddr.String()
+	isAfter := func(a, b string) bool {
+		if (a >= selfAddress) != (b >= selfAddress) {
+			return a < selfAddress
+		}
+		return a >= b
+	}
+	return sort.Search(len(pm.addrList), func(i int) bool {
+		return isAfter(pm.addrList[i], address)
+	})
+}
+
 // DISCUSS: This is only used in tests. Should we remove it?
 func (pm *peersManager) getSelfIndexInAddrBook() (int, bool) {
 	if len(pm.addrList) == 0 {
@@ -0,0 +1,145 @@
+package raintree
+
+import (
+	"log"
+
+	"github.com/benbjohnson/clock"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// The maximum number of peers of the subtree of a target a message is sent to in turn if the target does not
+// acknowledge it
+const maxFallbackTargets = 3
+
+// ackKey identifies a message sent to a target at a level of a broadcast
+type ackKey struct {
+	nonce   uint64
+	level   uint32
+	address string
+}
+
+// pendingAck is a message sent to a target that has not acknowledged it yet
+type pendingAck struct {
+	msgBz  []byte
+	stream typesP2P.Stream
+	// The peers the message is sent to next if the target does not acknowledge it
+	fallbacks []cryptoPocket.Address
+	timer     *clock.Timer
+}
+
+// networkSendWithAck sends the message of the broadcast level to the target and, if the ACKs are enabled, sends it to
+// the next fallback peer if the target fails to acknowledge it in time, as measured by the clock of the runtime
+func (n *rainTreeNetwork) networkSendWithAck(msgBz []byte, level uint32, nonce uint64, address cryptoPocket.Address, stream typesP2P.Stream, fallbacks []cryptoPocket.Address) {
+	if n.ackTimeout == 0 {
+		if err := n.networkSendInternal(msgBz, address, stream); err != nil {
+			log.Println("Error sending to peer during broadcast: ", err)
+		}
+		return
+	}
+
+	key := ackKey{nonce: nonce, level: level, address: address.String()}
+	n.pendingAcksMutex.Lock()
+	n.pendingAcks[key] = &pendingAck{
+		msgBz:     msgBz,
+		stream:    stream,
+		fallbacks: fallbacks,
+		timer:     n.GetBus().GetRuntimeMgr().GetClock().AfterFunc(n.ackTimeout, func() { n.handleAckTimeout(key) }),
+	}
+	n.pendingAcksMutex.Unlock()
+
+	if err := n.networkSendInternal(msgBz, address, stream); err != nil {
+		log.Println("Error sending to peer during broadcast: ", err)
+		// The target is unreachable, so the next peer does not wait for the timeout
+		n.handleAckTimeout(key)
+	}
+}
+
+// handleAckTimeout sends the message to the next fallback peer of a target that did not acknowledge it
+func (n *rainTreeNetwork) handleAckTimeout(key ackKey) {
+	n.pendingAcksMutex.Lock()
+	pending, ok := n.pendingAcks[key]
+	if !ok {
+		n.pendingAcksMutex.Unlock()
+		return
+	}
+	pending.timer.Stop()
+	delete(n.pendingAcks, key)
+	n.pendingAcksMutex.Unlock()
+
+	if len(pending.fallbacks) == 0 {
+		log.Printf("[WARN] RainTree message with nonce %d at level %d was not acknowledged by %s and has no fallback peer left\n", key.nonce, key.level, key.address)
+		return
+	}
+	fallback := pending.fallbacks[0]
+	log.Printf("[DEBUG] RainTree message with nonce %d at level %d was not acknowledged by %s, sending it to %s\n", key.nonce, key.level, key.address, fallback)
+	n.networkSendWithAck(pending.msgBz, key.level, key.nonce, fallback, pending.stream, pending.fallbacks[1:])
+}
+
+// handleAck stops waiting for the message acknowledged by the peer that authenticated with `remotePublicKey`, so a
+// peer cannot acknowledge the messages sent to another target
+func (n *rainTreeNetwork) handleAck(ack *typesP2P.RainTreeMessage, remotePublicKey cryptoPocket.PublicKey) {
+	key := ackKey{nonce: ack.Nonce, level: ack.Level, address: getAckPeer(ack, remotePublicKey).String()}
+	n.pendingAcksMutex.Lock()
+	defer n.pendingAcksMutex.Unlock()
+	if pending, ok := n.pendingAcks[key]; ok {
+		pending.timer.Stop()
+		delete(n.pendingAcks, key)
+	}
+}
+
+// sendAck acknowledges the receipt of the message to the peer that sent it, rather than to the sender it claims, so
+// a peer cannot make the node send ACKs to other peers
+func (n *rainTreeNetwork) sendAck(rainTreeMsg *typesP2P.RainTreeMessage, remotePublicKey cryptoPocket.PublicKey) error {
+	ack := &typesP2P.RainTreeMessage{
+		Level:  rainTreeMsg.Level,
+		Nonce:  rainTreeMsg.Nonce,
+		IsAck:  true,
+		Sender: n.selfAddr,
+	}
+	ackBz, err := codec.GetCodec().Marshal(ack)
+	if err != nil {
+		return err
+	}
+	return n.networkSendInternal(ackBz, getAckPeer(rainTreeMsg, remotePublicKey), typesP2P.StreamDefault)
+}
+
+// getAckPeer returns the address of the peer that authenticated with `remotePublicKey`, which ACKs are exchanged
+// with. The self-reported sender of the message is only used if the transport does not authenticate its peers.
+func getAckPeer(rainTreeMsg *typesP2P.RainTreeMessage, remotePublicKey cryptoPocket.PublicKey) cryptoPocket.Address {
+	if remotePublicKey != nil {
+		return remotePublicKey.Address()
+	}
+	return cryptoPocket.Address(rainTreeMsg.Sender)
+}
+
+// sendToNeighbours sends the message broadcast at the last level to the neighbours of the node that are not its
+// targets, without propagation nor ACK, so a node whose parent in the tree is down is still reached
+func (n *rainTreeNetwork) sendToNeighbours(data []byte, nonce uint64, stream typesP2P.Stream, targets []target) {
+	msg := &typesP2P.RainTreeMessage{
+		Level: 0,
+		Data:  data,
+		Nonce: nonce,
+	}
+	msgBz, err := codec.GetCodec().Marshal(msg)
+	if err != nil {
+		log.Println("Error encoding RainTree redundancy message: ", err)
+		return
+	}
+
+	for _, neighbour := range n.getNeighbours() {
+		isTarget := false
+		for _, target := range targets {
+			if shouldSendToTarget(target) && target.address.Equals(neighbour) {
+				isTarget = true
+			}
+		}
+		if isTarget {
+			continue
+		}
+		if err := n.networkSendInternal(msgBz, neighbour, stream); err != nil {
+			log.Println("Error sending to neighbour during broadcast: ", err)
+		}
+	}
+}
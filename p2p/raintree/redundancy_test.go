@@ -0,0 +1,339 @@
+package raintree
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/codec"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const simulationAckTimeoutMsec = 20
+
+func TestRainTreeRedundancy_CoverageWithNodesDown(t *testing.T) {
+	testCases := []struct {
+		numNodes       int
+		downPercentage int
+		// The minimum share of the live nodes the broadcast must reach with the redundancy layer and the ACKs
+		minCoverage float64
+	}{
+		{numNodes: 27, downPercentage: 10, minCoverage: 0.95},
+		{numNodes: 27, downPercentage: 20, minCoverage: 0.92},
+		{numNodes: 27, downPercentage: 30, minCoverage: 0.9},
+		{numNodes: 81, downPercentage: 10, minCoverage: 0.95},
+		{numNodes: 81, downPercentage: 20, minCoverage: 0.92},
+		{numNodes: 81, downPercentage: 30, minCoverage: 0.9},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d nodes with %d%% down", tc.numNodes, tc.downPercentage), func(t *testing.T) {
+			var baselineCoverage, coverage float64
+			// The coverage is averaged over several sets of down nodes
+			numRuns := 5
+			for seed := int64(0); seed < int64(numRuns); seed++ {
+				baselineCoverage += newRainTreeSimulation(t, tc.numNodes, tc.downPercentage, seed, false).broadcast(t) / float64(numRuns)
+				coverage += newRainTreeSimulation(t, tc.numNodes, tc.downPercentage, seed, true).broadcast(t) / float64(numRuns)
+			}
+			t.Logf("coverage of the live nodes: %.3f without redundancy, %.3f with the redundancy layer and ACKs", baselineCoverage, coverage)
+
+			require.GreaterOrEqual(t, coverage, baselineCoverage)
+			require.GreaterOrEqual(t, coverage, tc.minCoverage)
+		})
+	}
+}
+
+func TestRainTreeRedundancy_AckStopsFallback(t *testing.T) {
+	sim := newRainTreeSimulation(t, 9, 0, 0, true)
+	sim.broadcast(t)
+
+	// Every target acknowledged its message, so no message was sent to a fallback peer
+	for _, node := range sim.nodes {
+		node.network.pendingAcksMutex.Lock()
+		require.Empty(t, node.network.pendingAcks)
+		node.network.pendingAcksMutex.Unlock()
+	}
+	require.Equal(t, int64(0), atomic.LoadInt64(&sim.numLostWrites))
+	// The broadcast completed without waiting for any timeout
+	require.Equal(t, time.Unix(0, 0), sim.clock.Now())
+}
+
+func TestRainTreeRedundancy_FallbackAfterAckTimeout(t *testing.T) {
+	sim := newRainTreeSimulation(t, 9, 12, 0, true)
+	var downAddr string
+	for _, node := range sim.nodes {
+		if node.isDown {
+			downAddr = node.network.selfAddr.String()
+		}
+	}
+	require.NotEmpty(t, downAddr)
+
+	sim.startBroadcast(t)
+	sim.waitForInFlight()
+	pendingAcks := sim.getPendingAcksFrom(downAddr)
+	require.NotEmpty(t, pendingAcks)
+
+	// The message is not sent to a fallback peer until the timeout elapses on the clock of the runtime
+	sim.clock.Add(simulationAckTimeoutMsec*time.Millisecond - time.Millisecond)
+	sim.waitForInFlight()
+	for node, keys := range pendingAcks {
+		node.network.pendingAcksMutex.Lock()
+		for _, key := range keys {
+			require.Contains(t, node.network.pendingAcks, key)
+		}
+		node.network.pendingAcksMutex.Unlock()
+	}
+
+	sim.clock.Add(time.Millisecond)
+	sim.waitForInFlight()
+	for node, keys := range pendingAcks {
+		node.network.pendingAcksMutex.Lock()
+		for _, key := range keys {
+			require.NotContains(t, node.network.pendingAcks, key)
+		}
+		node.network.pendingAcksMutex.Unlock()
+	}
+}
+
+func TestRainTreeRedundancy_AckIsAuthenticated(t *testing.T) {
+	sim := newRainTreeSimulation(t, 3, 0, 0, true)
+	network := sim.nodes[0].network
+	target, impersonator := sim.nodes[1].network.selfAddr, sim.nodes[2].network.selfAddr
+
+	key := ackKey{nonce: 1, level: 1, address: target.String()}
+	network.pendingAcks[key] = &pendingAck{timer: sim.clock.Timer(time.Hour)}
+	ack := &typesP2P.RainTreeMessage{Level: 1, Nonce: 1, IsAck: true, Sender: target}
+
+	// A peer claiming to be the target does not acknowledge the message in its place
+	network.handleAck(ack, sim.publicKeys[impersonator.String()])
+	require.Contains(t, network.pendingAcks, key)
+
+	network.handleAck(ack, sim.publicKeys[target.String()])
+	require.NotContains(t, network.pendingAcks, key)
+}
+
+// rainTreeSimulation is an in-memory network of RainTree nodes, where the messages written to the nodes that are down
+// are lost
+type rainTreeSimulation struct {
+	nodes      []*simulatedNode
+	originator *simulatedNode
+	// The public keys of the nodes by address, which the simulated transport authenticates the senders with
+	publicKeys map[string]cryptoPocket.PublicKey
+	// The clock of the runtime of the nodes, which the ACK timeouts are measured with
+	clock *clock.Mock
+
+	numInFlight   int64
+	numLostWrites int64
+}
+
+type simulatedNode struct {
+	network *rainTreeNetwork
+	isDown  bool
+
+	m           sync.Mutex
+	isDelivered bool
+}
+
+func newRainTreeSimulation(t *testing.T, numNodes, downPercentage int, seed int64, withRedundancy bool) *rainTreeSimulation {
+	ctrl := gomock.NewController(t)
+	sim := &rainTreeSimulation{
+		nodes:      make([]*simulatedNode, numNodes),
+		publicKeys: make(map[string]cryptoPocket.PublicKey, numNodes),
+		clock:      clock.NewMock(),
+	}
+
+	addrBook := make(typesP2P.AddrBook, numNodes)
+	for i := range addrBook {
+		privateKey, err := cryptoPocket.GeneratePrivateKey()
+		require.NoError(t, err)
+		sim.nodes[i] = &simulatedNode{}
+		addrBook[i] = &typesP2P.NetworkPeer{
+			Dialer:     &simulatedTransport{sim: sim, node: sim.nodes[i]},
+			PublicKey:  privateKey.PublicKey(),
+			Address:    privateKey.Address(),
+			ServiceUrl: fmt.Sprintf("node%d:8080", i+1),
+		}
+		sim.publicKeys[privateKey.Address().String()] = privateKey.PublicKey()
+	}
+
+	p2pCfg := &configs.P2PConfig{
+		UseRainTree:     true,
+		MaxMempoolCount: 1000,
+	}
+	if withRedundancy {
+		p2pCfg.UseRainTreeRedundancyLayer = true
+		p2pCfg.RainTreeAckTimeoutMsec = simulationAckTimeoutMsec
+	}
+	busMock := mockSimulationBus(ctrl, &configs.Config{P2P: p2pCfg}, sim.clock)
+	addrBookProviderMock := mockAddrBookProvider(ctrl, addrBook)
+	currentHeightProviderMock := mockCurrentHeightProvider(ctrl, 0)
+	for i, node := range sim.nodes {
+		node.network = NewRainTreeNetwork(addrBook[i].Address, busMock, addrBookProviderMock, currentHeightProviderMock).(*rainTreeNetwork)
+	}
+
+	// The originator is always up
+	rng := rand.New(rand.NewSource(seed))
+	sim.originator = sim.nodes[rng.Intn(numNodes)]
+	numDown := numNodes * downPercentage / 100
+	for _, i := range rng.Perm(numNodes) {
+		if numDown == 0 {
+			break
+		}
+		if sim.nodes[i] != sim.originator {
+			sim.nodes[i].isDown = true
+			numDown--
+		}
+	}
+	return sim
+}
+
+// broadcast broadcasts a message from the originator, and returns the share of the live nodes it reached once the
+// network is quiet
+func (sim *rainTreeSimulation) broadcast(t *testing.T) float64 {
+	sim.startBroadcast(t)
+	sim.waitUntilQuiet()
+
+	numLive, numDelivered := 0, 0
+	for _, node := range sim.nodes {
+		if node.isDown {
+			continue
+		}
+		numLive++
+		node.m.Lock()
+		if node.isDelivered {
+			numDelivered++
+		}
+		node.m.Unlock()
+	}
+	return float64(numDelivered) / float64(numLive)
+}
+
+// startBroadcast broadcasts a message from the originator without waiting for it to propagate
+func (sim *rainTreeSimulation) startBroadcast(t *testing.T) {
+	envelope, err := messaging.PackMessage(&typesP2P.RainTreeMessage{})
+	require.NoError(t, err)
+	data, err := codec.GetCodec().Marshal(envelope)
+	require.NoError(t, err)
+
+	sim.originator.isDelivered = true
+	require.NoError(t, sim.originator.network.NetworkBroadcast(data))
+}
+
+// waitUntilQuiet waits until no message is in flight and no node waits for an ACK, moving the clock forward by the
+// ACK timeout whenever the only pending messages are the ones that were not acknowledged
+func (sim *rainTreeSimulation) waitUntilQuiet() {
+	for {
+		sim.waitForInFlight()
+		if !sim.hasPendingAcks() {
+			return
+		}
+		sim.clock.Add(simulationAckTimeoutMsec * time.Millisecond)
+	}
+}
+
+// waitForInFlight waits until every message written to a live node has been handled
+func (sim *rainTreeSimulation) waitForInFlight() {
+	for atomic.LoadInt64(&sim.numInFlight) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// getPendingAcksFrom returns the keys of the messages waiting for an ACK of the peer with the address, by node
+func (sim *rainTreeSimulation) getPendingAcksFrom(address string) map[*simulatedNode][]ackKey {
+	pendingAcks := make(map[*simulatedNode][]ackKey)
+	for _, node := range sim.nodes {
+		node.network.pendingAcksMutex.Lock()
+		for key := range node.network.pendingAcks {
+			if key.address == address {
+				pendingAcks[node] = append(pendingAcks[node], key)
+			}
+		}
+		node.network.pendingAcksMutex.Unlock()
+	}
+	return pendingAcks
+}
+
+func (sim *rainTreeSimulation) hasPendingAcks() bool {
+	for _, node := range sim.nodes {
+		node.network.pendingAcksMutex.Lock()
+		numPendingAcks := len(node.network.pendingAcks)
+		node.network.pendingAcksMutex.Unlock()
+		if numPendingAcks > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+var _ typesP2P.Transport = &simulatedTransport{}
+
+type simulatedTransport struct {
+	sim  *rainTreeSimulation
+	node *simulatedNode
+}
+
+func (c *simulatedTransport) IsListener() bool {
+	return false
+}
+
+func (c *simulatedTransport) Read() ([]byte, cryptoPocket.PublicKey, typesP2P.Stream, error) {
+	return nil, nil, typesP2P.StreamDefault, nil
+}
+
+// Write delivers the message asynchronously like the connection manager, so the message written to a node that is
+// down is lost without an error. The nodes are honest, so the message is authenticated with the key of its sender.
+func (c *simulatedTransport) Write(data []byte, _ typesP2P.Stream) error {
+	if c.node.isDown {
+		atomic.AddInt64(&c.sim.numLostWrites, 1)
+		return nil
+	}
+	atomic.AddInt64(&c.sim.numInFlight, 1)
+	go func() {
+		defer atomic.AddInt64(&c.sim.numInFlight, -1)
+		var remotePublicKey cryptoPocket.PublicKey
+		var rainTreeMsg typesP2P.RainTreeMessage
+		if err := codec.GetCodec().Unmarshal(data, &rainTreeMsg); err == nil {
+			remotePublicKey = c.sim.publicKeys[cryptoPocket.Address(rainTreeMsg.Sender).String()]
+		}
+		appData, err := c.node.network.HandleNetworkData(data, remotePublicKey)
+		if err == nil && appData != nil {
+			c.node.m.Lock()
+			c.node.isDelivered = true
+			c.node.m.Unlock()
+		}
+	}()
+	return nil
+}
+
+func (c *simulatedTransport) Close() error {
+	return nil
+}
+
+func mockSimulationBus(ctrl *gomock.Controller, cfg *configs.Config, clk clock.Clock) *mockModules.MockBus {
+	busMock := mockModules.NewMockBus(ctrl)
+	consensusMock := mockModules.NewMockConsensusModule(ctrl)
+	consensusMock.EXPECT().CurrentHeight().Return(uint64(0)).AnyTimes()
+	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
+	runtimeMgrMock := mockModules.NewMockRuntimeMgr(ctrl)
+	runtimeMgrMock.EXPECT().GetConfig().Return(cfg).AnyTimes()
+	runtimeMgrMock.EXPECT().GetClock().Return(clk).AnyTimes()
+	busMock.EXPECT().GetRuntimeMgr().Return(runtimeMgrMock).AnyTimes()
+	eventMetricsAgentMock := mockModules.NewMockEventMetricsAgent(ctrl)
+	eventMetricsAgentMock.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	eventMetricsAgentMock.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	eventMetricsAgentMock.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	telemetryMock := mockModules.NewMockTelemetryModule(ctrl)
+	telemetryMock.EXPECT().GetEventMetricsAgent().Return(eventMetricsAgentMock).AnyTimes()
+	busMock.EXPECT().GetTelemetryModule().Return(telemetryMock).AnyTimes()
+	return busMock
+}
@@ -0,0 +1,81 @@
+package reputation
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const banstoreFilePerm = 0600
+
+// banstore keeps the bans of the peers, so that a restarted node does not accept a banned peer again before its ban
+// ends. The bans are persisted to a JSON file, and only kept in memory if the path is empty.
+type banstore struct {
+	path string
+	bans map[string]time.Time // the end of the ban, by address
+}
+
+type storedBan struct {
+	Address     string `json:"address"`
+	BannedUntil int64  `json:"banned_until"` // unix seconds
+}
+
+// newBanstore loads the bans persisted to `path`, if any
+func newBanstore(path string) (*banstore, error) {
+	bs := &banstore{
+		path: path,
+		bans: make(map[string]time.Time),
+	}
+	if path == "" {
+		return bs, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return bs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var storedBans []storedBan
+	if err := json.Unmarshal(data, &storedBans); err != nil {
+		return nil, err
+	}
+	for _, storedBan := range storedBans {
+		bs.bans[storedBan.Address] = time.Unix(storedBan.BannedUntil, 0)
+	}
+	return bs, nil
+}
+
+// save persists the bans. The file is replaced atomically so a crash while saving does not lose the previous bans.
+func (bs *banstore) save() error {
+	if bs.path == "" {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(bs.bans))
+	for address := range bs.bans {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	storedBans := make([]storedBan, 0, len(addresses))
+	for _, address := range addresses {
+		storedBans = append(storedBans, storedBan{Address: address, BannedUntil: bs.bans[address].Unix()})
+	}
+
+	data, err := json.MarshalIndent(storedBans, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(bs.path), 0700); err != nil {
+		return err
+	}
+	tmpPath := bs.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, banstoreFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, bs.path)
+}
@@ -0,0 +1,54 @@
+package reputation
+
+import (
+	"time"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+)
+
+// rateLimit is the number of messages of a stream a peer can send per second, and in a burst
+type rateLimit struct {
+	ratePerSecond float64
+	burst         float64
+}
+
+// The rate limits of the messages of every stream, which leave room for the bursts of a busy validator
+var rateLimits = [typesP2P.NumStreams]rateLimit{
+	// The default stream also carries the RainTree ACKs
+	typesP2P.StreamDefault:   {ratePerSecond: 100, burst: 200},
+	typesP2P.StreamConsensus: {ratePerSecond: 100, burst: 300},
+	typesP2P.StreamStateSync: {ratePerSecond: 50, burst: 100},
+	typesP2P.StreamTxGossip:  {ratePerSecond: 500, burst: 1000},
+	typesP2P.StreamDiscovery: {ratePerSecond: 5, burst: 20},
+}
+
+// tokenBucket lets a message through for every token it holds, and refills at the rate of its limit up to its burst
+type tokenBucket struct {
+	limit      rateLimit
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(limit rateLimit, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		limit:      limit,
+		tokens:     limit.burst,
+		lastRefill: now,
+	}
+}
+
+// allow takes a token from the bucket, and returns false if it is empty
+func (b *tokenBucket) allow(now time.Time) bool {
+	if elapsed := now.Sub(b.lastRefill); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.limit.ratePerSecond
+		if b.tokens > b.limit.burst {
+			b.tokens = b.limit.burst
+		}
+		b.lastRefill = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
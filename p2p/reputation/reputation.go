@@ -0,0 +1,343 @@
+package reputation
+
+import (
+	"crypto/sha256"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/telemetry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// The penalties applied to the score of a peer for every message
+	invalidMessagePenalty         = 20 // A message that cannot be decoded, or whose signature is invalid
+	duplicateMessagePenalty       = 5  // The same message sent twice by the same peer
+	rateLimitedMessagePenalty     = 1  // A message sent over the rate limit of its stream
+	reportedInvalidMessagePenalty = 5  // An invalid message relayed by the peer, as reported by the other modules
+
+	// The score of a peer recovers at this rate per second, up to 0
+	scoreRecoveryPerSecond = 1
+
+	// The number of recent messages of every peer kept to detect its duplicates
+	maxRecentMessagesPerPeer = 1000
+	// The number of recent messages whose delivering peers are kept to penalize them if the message is reported invalid
+	maxTrackedDeliveries = 10000
+)
+
+// The reasons of the penalties, emitted with the scores
+const (
+	reasonInvalidMessage         = "invalid_message"
+	reasonDuplicateMessage       = "duplicate_message"
+	reasonRateLimited            = "rate_limited"
+	reasonReportedInvalidMessage = "reported_invalid_message"
+)
+
+var _ modules.IntegratableModule = &Reputation{}
+
+// Reputation scores the peers on the messages they send, and bans the peers whose score falls below the threshold:
+//   - A peer starts with a score of 0, which is lowered by a penalty for every invalid, duplicate or rate limited
+//     message it sends, and recovers over time
+//   - The messages of every stream of a peer are rate limited by a token bucket
+//   - A peer is banned for `peer_ban_duration_sec` once its score falls below `peer_ban_score_threshold`, and the
+//     messages of a banned peer are dropped. The bans are persisted to the banstore
+//
+// The messages are relayed by RainTree before the other modules validate them, so a peer cannot know whether the message
+// it relays is valid: the penalties of the invalid messages reported by the other modules never lower the score of a
+// peer below half the ban threshold, and only the invalid messages a peer sends itself can get it banned.
+type Reputation struct {
+	bus   modules.Bus
+	clock clock.Clock
+
+	banScoreThreshold float64 // 0 if the bans are disabled
+	banDuration       time.Duration
+
+	m        sync.Mutex
+	peers    map[string]*peerReputation
+	banstore *banstore
+	// The addresses of the peers that delivered each recent message, by content hash
+	deliveries     map[[sha256.Size]byte][]string
+	deliveryHashes [][sha256.Size]byte // in delivery order, to evict the oldest
+}
+
+type peerReputation struct {
+	score      float64
+	lastUpdate time.Time
+
+	buckets [typesP2P.NumStreams]*tokenBucket
+	// Whether the last message of each stream was rate limited, so a flood of messages emits a single event
+	isRateLimited [typesP2P.NumStreams]bool
+
+	recentMessages      map[[sha256.Size]byte]struct{}
+	recentMessageHashes [][sha256.Size]byte // in arrival order, to evict the oldest
+}
+
+func NewReputation(bus modules.Bus) (*Reputation, error) {
+	p2pCfg := bus.GetRuntimeMgr().GetConfig().P2P
+
+	banstore, err := newBanstore(p2pCfg.GetBanstorePath())
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reputation{
+		bus:               bus,
+		clock:             bus.GetRuntimeMgr().GetClock(),
+		banScoreThreshold: float64(p2pCfg.GetPeerBanScoreThreshold()),
+		banDuration:       time.Duration(p2pCfg.GetPeerBanDurationSec()) * time.Second,
+		peers:             make(map[string]*peerReputation),
+		banstore:          banstore,
+		deliveries:        make(map[[sha256.Size]byte][]string),
+	}
+	r.setBannedPeersGauge()
+	return r, nil
+}
+
+// AllowMessage returns whether the message the peer sent on the stream should be handled, and penalizes the peer if it
+// is a duplicate or over the rate limit of the stream. The messages of a banned peer are never allowed.
+func (r *Reputation) AllowMessage(address string, stream typesP2P.Stream, data []byte) bool {
+	if int(stream) >= typesP2P.NumStreams {
+		r.ReportInvalidMessage(address)
+		return false
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	now := r.clock.Now()
+	if r.isBanned(address, now) {
+		return false
+	}
+	peer := r.getPeer(address, now)
+
+	// Discovery messages, such as pings, are legitimately sent again with the same content
+	if stream != typesP2P.StreamDiscovery {
+		hash := sha256.Sum256(data)
+		if _, ok := peer.recentMessages[hash]; ok {
+			r.penalize(address, peer, duplicateMessagePenalty, reasonDuplicateMessage, now)
+			return false
+		}
+		peer.recentMessages[hash] = struct{}{}
+		peer.recentMessageHashes = append(peer.recentMessageHashes, hash)
+		if len(peer.recentMessageHashes) > maxRecentMessagesPerPeer {
+			delete(peer.recentMessages, peer.recentMessageHashes[0])
+			peer.recentMessageHashes = peer.recentMessageHashes[1:]
+		}
+	}
+
+	if !peer.buckets[stream].allow(now) {
+		peer.score -= rateLimitedMessagePenalty
+		if !peer.isRateLimited[stream] {
+			peer.isRateLimited[stream] = true
+			r.emitScore(address, peer, reasonRateLimited)
+		}
+		r.banIfBelowThreshold(address, peer, now)
+		return false
+	}
+	peer.isRateLimited[stream] = false
+	return true
+}
+
+// ReportInvalidMessage penalizes the peer for a message it sent that is invalid, such as a message that cannot be
+// decoded or that was received on the stream of another content type
+func (r *Reputation) ReportInvalidMessage(address string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	now := r.clock.Now()
+	r.penalize(address, r.getPeer(address, now), invalidMessagePenalty, reasonInvalidMessage, now)
+}
+
+// RecordDelivery records the peer that delivered the content, so it can be penalized if the content is reported invalid
+func (r *Reputation) RecordDelivery(address string, content *anypb.Any) {
+	hash := hashContent(content)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	addresses, ok := r.deliveries[hash]
+	if !ok {
+		r.deliveryHashes = append(r.deliveryHashes, hash)
+		if len(r.deliveryHashes) > maxTrackedDeliveries {
+			delete(r.deliveries, r.deliveryHashes[0])
+			r.deliveryHashes = r.deliveryHashes[1:]
+		}
+	}
+	for _, a := range addresses {
+		if a == address {
+			return
+		}
+	}
+	r.deliveries[hash] = append(addresses, address)
+}
+
+// ReportInvalidContent penalizes the peers that delivered the content, which the other modules found invalid
+// (e.g. a transaction or a consensus message with an invalid signature)
+func (r *Reputation) ReportInvalidContent(content *anypb.Any) {
+	hash := hashContent(content)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	now := r.clock.Now()
+	for _, address := range r.deliveries[hash] {
+		peer := r.getPeer(address, now)
+		penalty := float64(reportedInvalidMessagePenalty)
+		if minScore := r.banScoreThreshold / 2; r.banScoreThreshold < 0 && peer.score-penalty < minScore {
+			penalty = peer.score - minScore
+		}
+		if penalty > 0 {
+			r.penalize(address, peer, penalty, reasonReportedInvalidMessage, now)
+		}
+	}
+	// The peers are only penalized once for the same content
+	delete(r.deliveries, hash)
+}
+
+// GetScores returns the current score of every peer that sent messages since its last ban, by address
+func (r *Reputation) GetScores() map[string]float64 {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	now := r.clock.Now()
+	scores := make(map[string]float64, len(r.peers))
+	for address := range r.peers {
+		scores[address] = r.getPeer(address, now).score
+	}
+	return scores
+}
+
+// GetBans returns the end of the ban of every banned peer, by address
+func (r *Reputation) GetBans() map[string]time.Time {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	now := r.clock.Now()
+	bans := make(map[string]time.Time, len(r.banstore.bans))
+	for address, bannedUntil := range r.banstore.bans {
+		if r.isBanned(address, now) {
+			bans[address] = bannedUntil
+		}
+	}
+	return bans
+}
+
+// getPeer returns the reputation of the peer, with its score recovered up to `now`
+func (r *Reputation) getPeer(address string, now time.Time) *peerReputation {
+	peer, ok := r.peers[address]
+	if !ok {
+		peer = &peerReputation{
+			lastUpdate:     now,
+			recentMessages: make(map[[sha256.Size]byte]struct{}),
+		}
+		for stream := range peer.buckets {
+			peer.buckets[stream] = newTokenBucket(rateLimits[stream], now)
+		}
+		r.peers[address] = peer
+		return peer
+	}
+
+	if elapsed := now.Sub(peer.lastUpdate); elapsed > 0 {
+		peer.score += elapsed.Seconds() * scoreRecoveryPerSecond
+		if peer.score > 0 {
+			peer.score = 0
+		}
+		peer.lastUpdate = now
+	}
+	return peer
+}
+
+func (r *Reputation) penalize(address string, peer *peerReputation, penalty float64, reason string, now time.Time) {
+	peer.score -= penalty
+	r.emitScore(address, peer, reason)
+	r.banIfBelowThreshold(address, peer, now)
+}
+
+func (r *Reputation) banIfBelowThreshold(address string, peer *peerReputation, now time.Time) {
+	if r.banScoreThreshold == 0 || peer.score >= r.banScoreThreshold || r.isBanned(address, now) {
+		return
+	}
+
+	log.Printf("[WARN] Banning peer %s with score %.2f for %s\n", address, peer.score, r.banDuration)
+	r.banstore.bans[address] = now.Add(r.banDuration)
+	if err := r.banstore.save(); err != nil {
+		log.Println("[WARN] Error saving banstore: ", err)
+	}
+	// The peer starts over once its ban ends
+	delete(r.peers, address)
+
+	r.GetBus().
+		GetTelemetryModule().
+		GetEventMetricsAgent().
+		EmitEvent(
+			telemetry.P2P_EVENT_METRICS_NAMESPACE,
+			telemetry.P2P_PEER_BANNED_EVENT_METRIC_NAME,
+			telemetry.P2P_PEER_SCORE_EVENT_METRIC_PEER_LABEL, address,
+		)
+	r.setBannedPeersGauge()
+}
+
+// isBanned returns whether the peer is banned, and lifts its ban if it has ended
+func (r *Reputation) isBanned(address string, now time.Time) bool {
+	bannedUntil, ok := r.banstore.bans[address]
+	if !ok {
+		return false
+	}
+	if now.Before(bannedUntil) {
+		return true
+	}
+
+	delete(r.banstore.bans, address)
+	if err := r.banstore.save(); err != nil {
+		log.Println("[WARN] Error saving banstore: ", err)
+	}
+	r.setBannedPeersGauge()
+	return false
+}
+
+func (r *Reputation) emitScore(address string, peer *peerReputation, reason string) {
+	r.GetBus().
+		GetTelemetryModule().
+		GetEventMetricsAgent().
+		EmitEvent(
+			telemetry.P2P_EVENT_METRICS_NAMESPACE,
+			telemetry.P2P_PEER_SCORE_EVENT_METRIC_NAME,
+			telemetry.P2P_PEER_SCORE_EVENT_METRIC_PEER_LABEL, address,
+			telemetry.P2P_PEER_SCORE_EVENT_METRIC_REASON_LABEL, reason,
+			telemetry.P2P_PEER_SCORE_EVENT_METRIC_SCORE_LABEL, peer.score,
+		)
+}
+
+func (r *Reputation) setBannedPeersGauge() {
+	if _, err := r.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		GaugeSet(
+			telemetry.P2P_BANNED_PEERS_GAUGE_NAME,
+			float64(len(r.banstore.bans)),
+		); err != nil {
+		log.Println("[WARN] Failed to set the banned peers gauge: ", err)
+	}
+}
+
+// hashContent hashes the content as it is relayed by the network, so the modules can report the content they decoded
+func hashContent(content *anypb.Any) [sha256.Size]byte {
+	h := sha256.New()
+	h.Write([]byte(content.GetTypeUrl()))
+	h.Write(content.GetValue())
+	var hash [sha256.Size]byte
+	copy(hash[:], h.Sum(nil))
+	return hash
+}
+
+func (r *Reputation) SetBus(bus modules.Bus) {
+	r.bus = bus
+}
+
+func (r *Reputation) GetBus() modules.Bus {
+	return r.bus
+}
@@ -0,0 +1,130 @@
+package reputation
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	testingPeer      = "peer1"
+	testingOtherPeer = "peer2"
+)
+
+func TestReputation_RateLimitsStreamsSeparately(t *testing.T) {
+	r, clk := newTestingReputation(t, &configs.P2PConfig{})
+
+	limit := rateLimits[typesP2P.StreamDiscovery]
+	for i := 0; i < int(limit.burst); i++ {
+		require.True(t, r.AllowMessage(testingPeer, typesP2P.StreamDiscovery, []byte("ping")))
+	}
+	require.False(t, r.AllowMessage(testingPeer, typesP2P.StreamDiscovery, []byte("ping")))
+	require.Equal(t, -float64(rateLimitedMessagePenalty), r.GetScores()[testingPeer])
+
+	// The other streams and the other peers have their own buckets
+	require.True(t, r.AllowMessage(testingPeer, typesP2P.StreamConsensus, []byte("consensus")))
+	require.True(t, r.AllowMessage(testingOtherPeer, typesP2P.StreamDiscovery, []byte("ping")))
+
+	// The bucket refills over time
+	clk.Add(time.Second)
+	for i := 0; i < int(limit.ratePerSecond); i++ {
+		require.True(t, r.AllowMessage(testingPeer, typesP2P.StreamDiscovery, []byte("ping")))
+	}
+	require.False(t, r.AllowMessage(testingPeer, typesP2P.StreamDiscovery, []byte("ping")))
+}
+
+func TestReputation_PenalizesDuplicatesAndRecovers(t *testing.T) {
+	r, clk := newTestingReputation(t, &configs.P2PConfig{})
+
+	require.True(t, r.AllowMessage(testingPeer, typesP2P.StreamConsensus, []byte("proposal")))
+	require.False(t, r.AllowMessage(testingPeer, typesP2P.StreamConsensus, []byte("proposal")))
+	require.True(t, r.AllowMessage(testingOtherPeer, typesP2P.StreamConsensus, []byte("proposal")))
+	require.Equal(t, map[string]float64{testingPeer: -duplicateMessagePenalty, testingOtherPeer: 0}, r.GetScores())
+
+	clk.Add(2 * time.Second)
+	require.Equal(t, float64(-duplicateMessagePenalty+2*scoreRecoveryPerSecond), r.GetScores()[testingPeer])
+	clk.Add(time.Hour)
+	require.Equal(t, float64(0), r.GetScores()[testingPeer])
+}
+
+func TestReputation_BansPeerBelowThresholdAcrossRestarts(t *testing.T) {
+	p2pCfg := &configs.P2PConfig{
+		PeerBanScoreThreshold: -50,
+		PeerBanDurationSec:    60,
+		BanstorePath:          filepath.Join(t.TempDir(), "p2p", "banstore.json"),
+	}
+	r, clk := newTestingReputation(t, p2pCfg)
+
+	for i := 0; i < 3; i++ {
+		r.ReportInvalidMessage(testingPeer)
+	}
+	require.False(t, r.AllowMessage(testingPeer, typesP2P.StreamConsensus, []byte("proposal")))
+	require.True(t, r.AllowMessage(testingOtherPeer, typesP2P.StreamConsensus, []byte("proposal")))
+	require.Equal(t, clk.Now().Add(time.Minute).Unix(), r.GetBans()[testingPeer].Unix())
+
+	// The ban is kept by a restarted node
+	restarted, restartedClk := newTestingReputation(t, p2pCfg)
+	restartedClk.Set(clk.Now())
+	require.False(t, restarted.AllowMessage(testingPeer, typesP2P.StreamConsensus, []byte("proposal")))
+
+	// The peer starts over once its ban ends
+	restartedClk.Add(time.Minute)
+	require.True(t, restarted.AllowMessage(testingPeer, typesP2P.StreamConsensus, []byte("proposal")))
+	require.Empty(t, restarted.GetBans())
+	require.Equal(t, float64(0), restarted.GetScores()[testingPeer])
+}
+
+func TestReputation_ReportedContentDoesNotBanRelayingPeers(t *testing.T) {
+	r, _ := newTestingReputation(t, &configs.P2PConfig{PeerBanScoreThreshold: -20, PeerBanDurationSec: 60})
+
+	for i := 0; i < 10; i++ {
+		content := &anypb.Any{TypeUrl: "type.googleapis.com/utility.Transaction", Value: []byte{byte(i)}}
+		r.RecordDelivery(testingPeer, content)
+		r.RecordDelivery(testingOtherPeer, content)
+		r.ReportInvalidContent(content)
+		// The peers are penalized once per content
+		r.ReportInvalidContent(content)
+	}
+
+	// The relaying peers are penalized down to half the ban threshold, but are not banned
+	require.Equal(t, map[string]float64{testingPeer: -10, testingOtherPeer: -10}, r.GetScores())
+	require.Empty(t, r.GetBans())
+
+	// A peer that did not deliver the content is not penalized
+	r.ReportInvalidContent(&anypb.Any{TypeUrl: "type.googleapis.com/utility.Transaction", Value: []byte("unknown")})
+	require.Len(t, r.GetScores(), 2)
+}
+
+func newTestingReputation(t *testing.T, p2pCfg *configs.P2PConfig) (*Reputation, *clock.Mock) {
+	ctrl := gomock.NewController(t)
+	clk := clock.NewMock()
+
+	runtimeMgrMock := mockModules.NewMockRuntimeMgr(ctrl)
+	runtimeMgrMock.EXPECT().GetConfig().Return(&configs.Config{P2P: p2pCfg}).AnyTimes()
+	runtimeMgrMock.EXPECT().GetClock().Return(clk).AnyTimes()
+
+	eventMetricsAgentMock := mockModules.NewMockEventMetricsAgent(ctrl)
+	eventMetricsAgentMock.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	eventMetricsAgentMock.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	timeSeriesAgentMock := mockModules.NewMockTimeSeriesAgent(ctrl)
+	timeSeriesAgentMock.EXPECT().GaugeSet(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	telemetryMock := mockModules.NewMockTelemetryModule(ctrl)
+	telemetryMock.EXPECT().GetEventMetricsAgent().Return(eventMetricsAgentMock).AnyTimes()
+	telemetryMock.EXPECT().GetTimeSeriesAgent().Return(timeSeriesAgentMock).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetRuntimeMgr().Return(runtimeMgrMock).AnyTimes()
+	busMock.EXPECT().GetTelemetryModule().Return(telemetryMock).AnyTimes()
+
+	r, err := NewReputation(busMock)
+	require.NoError(t, err)
+	return r, clk
+}
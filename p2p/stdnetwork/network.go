@@ -5,8 +5,10 @@ package stdnetwork
 import (
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/pokt-network/pocket/p2p/providers"
+	"github.com/pokt-network/pocket/p2p/providers/addrbook_provider"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
@@ -16,11 +18,21 @@ var _ typesP2P.Network = &network{}
 var _ modules.IntegratableModule = &network{}
 
 type network struct {
-	addrBookMap typesP2P.AddrBookMap
+	addrBookMap      typesP2P.AddrBookMap
+	addrBookMapMutex sync.RWMutex
+
+	addrBookProvider      providers.AddrBookProvider
+	currentHeightProvider providers.CurrentHeightProvider
+	// The staked address book the addrBookMap was last refreshed with, and its height. The addrBookMap also holds the
+	// peers added by discovery, which are not removed when the staked address book changes.
+	stakedAddrBook      typesP2P.AddrBook
+	addrBookHeight      uint64
+	addrBookHeightMutex sync.Mutex
 }
 
 func NewNetwork(bus modules.Bus, addrBookProvider providers.AddrBookProvider, currentHeightProvider providers.CurrentHeightProvider) (n typesP2P.Network) {
-	addrBook, err := addrBookProvider.GetStakedAddrBookAtHeight(currentHeightProvider.CurrentHeight())
+	height := currentHeightProvider.CurrentHeight()
+	addrBook, err := addrBookProvider.GetStakedAddrBookAtHeight(height)
 	if err != nil {
 		log.Fatalf("[ERROR] Error getting addrBook: %v", err)
 	}
@@ -30,14 +42,20 @@ func NewNetwork(bus modules.Bus, addrBookProvider providers.AddrBookProvider, cu
 		addrBookMap[peer.Address.String()] = peer
 	}
 	return &network{
-		addrBookMap: addrBookMap,
+		addrBookMap:           addrBookMap,
+		addrBookProvider:      addrBookProvider,
+		currentHeightProvider: currentHeightProvider,
+		stakedAddrBook:        addrBook,
+		addrBookHeight:        height,
 	}
 }
 
 // TODO(olshansky): How do we avoid self-broadcasts given that `AddrBook` may contain self in the current p2p implementation?
 func (n *network) NetworkBroadcast(data []byte) error {
-	for _, peer := range n.addrBookMap {
-		if err := peer.Dialer.Write(data); err != nil {
+	n.refreshAddrBook()
+	stream := typesP2P.GetEnvelopeStream(data)
+	for _, peer := range n.GetAddrBook() {
+		if err := peer.Dialer.Write(data, stream); err != nil {
 			log.Println("Error writing to one of the peers during broadcast: ", err)
 			continue
 		}
@@ -46,12 +64,15 @@ func (n *network) NetworkBroadcast(data []byte) error {
 }
 
 func (n *network) NetworkSend(data []byte, address cryptoPocket.Address) error {
+	n.refreshAddrBook()
+	n.addrBookMapMutex.RLock()
 	peer, ok := n.addrBookMap[address.String()]
+	n.addrBookMapMutex.RUnlock()
 	if !ok {
 		return fmt.Errorf("peer with address %v not in addrBookMap", peer)
 	}
 
-	if err := peer.Dialer.Write(data); err != nil {
+	if err := peer.Dialer.Write(data, typesP2P.GetEnvelopeStream(data)); err != nil {
 		log.Println("Error writing to peer during send: ", err)
 		return err
 	}
@@ -59,12 +80,15 @@ func (n *network) NetworkSend(data []byte, address cryptoPocket.Address) error {
 	return nil
 }
 
-func (n *network) HandleNetworkData(data []byte) ([]byte, error) {
+func (n *network) HandleNetworkData(data []byte, _ cryptoPocket.PublicKey) ([]byte, error) {
 	return data, nil // intentional passthrough
 }
 
 func (n *network) GetAddrBook() typesP2P.AddrBook {
-	addrBook := make(typesP2P.AddrBook, 0)
+	n.addrBookMapMutex.RLock()
+	defer n.addrBookMapMutex.RUnlock()
+
+	addrBook := make(typesP2P.AddrBook, 0, len(n.addrBookMap))
 	for _, p := range n.addrBookMap {
 		addrBook = append(addrBook, p)
 	}
@@ -72,14 +96,48 @@ func (n *network) GetAddrBook() typesP2P.AddrBook {
 }
 
 func (n *network) AddPeerToAddrBook(peer *typesP2P.NetworkPeer) error {
+	n.addrBookMapMutex.Lock()
+	defer n.addrBookMapMutex.Unlock()
 	n.addrBookMap[peer.Address.String()] = peer
 	return nil
 }
 
 func (n *network) RemovePeerToAddrBook(peer *typesP2P.NetworkPeer) error {
+	n.addrBookMapMutex.Lock()
+	defer n.addrBookMapMutex.Unlock()
 	delete(n.addrBookMap, peer.Address.String())
 	return nil
 }
 
+// refreshAddrBook applies the changes of the staked address book since the last refresh, keeping the discovered peers
+func (n *network) refreshAddrBook() {
+	n.addrBookHeightMutex.Lock()
+	defer n.addrBookHeightMutex.Unlock()
+
+	height := n.currentHeightProvider.CurrentHeight()
+	if height == n.addrBookHeight {
+		return
+	}
+	stakedAddrBook, err := n.addrBookProvider.GetStakedAddrBookAtHeight(height)
+	if err != nil {
+		log.Printf("[WARN] Error getting the staked addrBook at height %d: %v\n", height, err)
+		return
+	}
+
+	added, removed := addrbook_provider.GetAddrBookDelta(n.stakedAddrBook, stakedAddrBook)
+	for _, peer := range removed {
+		if err := n.RemovePeerToAddrBook(peer); err != nil {
+			log.Println("[WARN] Error removing peer from addrBook: ", err)
+		}
+	}
+	for _, peer := range added {
+		if err := n.AddPeerToAddrBook(peer); err != nil {
+			log.Println("[WARN] Error adding peer to addrBook: ", err)
+		}
+	}
+	n.stakedAddrBook = stakedAddrBook
+	n.addrBookHeight = height
+}
+
 func (n *network) GetBus() modules.Bus  { return nil }
 func (n *network) SetBus(_ modules.Bus) {}
@@ -0,0 +1,434 @@
+package transport
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	types "github.com/pokt-network/pocket/runtime/configs/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/telemetry"
+)
+
+const (
+	// The number of messages of a stream queued for a peer, beyond which the messages are rejected
+	maxPeerQueueSize = 1000
+
+	initialRedialBackoff = 100 * time.Millisecond
+	maxRedialBackoff     = 30 * time.Second
+
+	// A connection without messages to write for this long is closed, and dialed again for the next message
+	peerConnIdleTimeout = 5 * time.Minute
+)
+
+// PeerConnState is the state of the connection to a peer
+type PeerConnState string
+
+const (
+	// No connection is open, and it is dialed for the next message
+	PeerConnStateIdle PeerConnState = "idle"
+	// The peer is being dialed
+	PeerConnStateConnecting PeerConnState = "connecting"
+	// The connection is open and the queued messages are written to it
+	PeerConnStateConnected PeerConnState = "connected"
+	// The peer could not be dialed, and is dialed again once the backoff elapses
+	PeerConnStateBackoff PeerConnState = "backoff"
+	// The connection was closed by this node and is not used anymore
+	PeerConnStateClosed PeerConnState = "closed"
+)
+
+var _ modules.IntegratableModule = &ConnManager{}
+
+// ConnManager creates the connections to the peers, keeping a single long-lived connection per peer however many times
+// the peer is added to the address book, and reports the state of the connections through telemetry.
+type ConnManager struct {
+	bus modules.Bus
+
+	m         sync.Mutex
+	peerConns map[string]*peerConn // by `<address>@<url>`
+}
+
+func NewConnManager(bus modules.Bus) *ConnManager {
+	return &ConnManager{
+		bus:       bus,
+		peerConns: make(map[string]*peerConn),
+	}
+}
+
+// CreateDialer returns the connection to the peer reachable at `url` that authenticates with `publicKey`, and is used
+// as the connection factory of the address book providers
+func (cm *ConnManager) CreateDialer(cfg *configs.P2PConfig, url string, publicKey cryptoPocket.PublicKey) (typesP2P.Transport, error) {
+	if cfg.GetConnectionType() == types.ConnectionType_EmptyConnection {
+		return createEmptyDialer(cfg, url)
+	}
+
+	key := url
+	if publicKey != nil {
+		key = fmt.Sprintf("%s@%s", publicKey.Address(), url)
+	}
+
+	cm.m.Lock()
+	defer cm.m.Unlock()
+	if pc, ok := cm.peerConns[key]; ok {
+		return pc, nil
+	}
+	pc, err := createPeerConn(cfg, url, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	pc.onStateChange = cm.emitPeerConnState
+	cm.peerConns[key] = pc
+	return pc, nil
+}
+
+// GetPeerConnStates returns the state of the connection to every peer by `<address>@<url>`
+func (cm *ConnManager) GetPeerConnStates() map[string]PeerConnState {
+	cm.m.Lock()
+	defer cm.m.Unlock()
+	states := make(map[string]PeerConnState, len(cm.peerConns))
+	for key, pc := range cm.peerConns {
+		states[key] = pc.getState()
+	}
+	return states
+}
+
+// Close closes the connections to all the peers
+func (cm *ConnManager) Close() error {
+	cm.m.Lock()
+	defer cm.m.Unlock()
+	for _, pc := range cm.peerConns {
+		if err := pc.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cm *ConnManager) emitPeerConnState(pc *peerConn, previousState, state PeerConnState) {
+	bus := cm.GetBus()
+	if bus == nil {
+		return
+	}
+	telemetryModule := bus.GetTelemetryModule()
+
+	if state == PeerConnStateConnected {
+		telemetryModule.GetTimeSeriesAgent().GaugeIncrement(telemetry.P2P_CONNECTED_PEERS_GAUGE_NAME)
+	} else if previousState == PeerConnStateConnected {
+		telemetryModule.GetTimeSeriesAgent().GaugeDecrement(telemetry.P2P_CONNECTED_PEERS_GAUGE_NAME)
+	}
+
+	telemetryModule.
+		GetEventMetricsAgent().
+		EmitEvent(
+			telemetry.P2P_EVENT_METRICS_NAMESPACE,
+			telemetry.P2P_PEER_CONNECTION_STATE_EVENT_METRIC_NAME,
+			telemetry.P2P_PEER_CONNECTION_EVENT_METRIC_PEER_LABEL, pc.url,
+			telemetry.P2P_PEER_CONNECTION_EVENT_METRIC_STATE_LABEL, string(state),
+		)
+}
+
+func (cm *ConnManager) SetBus(bus modules.Bus) {
+	cm.bus = bus
+}
+
+func (cm *ConnManager) GetBus() modules.Bus {
+	return cm.bus
+}
+
+var _ typesP2P.Transport = &peerConn{}
+
+// peerConn is the long-lived connection to a peer. The messages are queued by stream, and written by a goroutine
+// started for the first message: it dials the peer, interleaves the frames of the streams, redials the peer with an
+// exponential backoff if the connection fails, and closes the connection once it is idle.
+type peerConn struct {
+	url        string
+	address    *net.TCPAddr
+	newSession func(conn net.Conn) (session, error)
+
+	onStateChange func(pc *peerConn, previousState, state PeerConnState)
+
+	m      sync.Mutex
+	queues [typesP2P.NumStreams][][]byte
+	state  PeerConnState
+	// Whether the writer goroutine is running
+	isWriting bool
+	isClosed  bool
+	backoff   time.Duration
+	// The time the peer can be dialed again after it could not be dialed
+	nextDialTime time.Time
+
+	// Signals the writer goroutine that a message was queued
+	notify chan struct{}
+	quit   chan struct{}
+}
+
+func createPeerConn(cfg *configs.P2PConfig, url string, publicKey cryptoPocket.PublicKey) (*peerConn, error) {
+	address, err := net.ResolveTCPAddr(TCPNetworkLayerProtocol, url)
+	if err != nil {
+		return nil, err
+	}
+
+	pc := &peerConn{
+		url:     url,
+		address: address,
+		state:   PeerConnStateIdle,
+		backoff: initialRedialBackoff,
+		notify:  make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+	}
+
+	switch cfg.GetConnectionType() {
+	case types.ConnectionType_TCPConnection:
+		pc.newSession = func(conn net.Conn) (session, error) {
+			return newPlainSession(conn), nil
+		}
+	case types.ConnectionType_SecureTCPConnection:
+		if publicKey == nil {
+			return nil, fmt.Errorf("the public key of the peer at %s is required to authenticate it", url)
+		}
+		privateKey, err := cryptoPocket.NewPrivateKey(cfg.GetPrivateKey())
+		if err != nil {
+			return nil, err
+		}
+		pc.newSession = func(conn net.Conn) (session, error) {
+			return newSecureSession(conn, privateKey, publicKey)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported connection type for dialer: %v", cfg.GetConnectionType())
+	}
+
+	return pc, nil
+}
+
+func (pc *peerConn) IsListener() bool {
+	return false
+}
+
+func (pc *peerConn) Read() ([]byte, cryptoPocket.PublicKey, typesP2P.Stream, error) {
+	return nil, nil, typesP2P.StreamDefault, fmt.Errorf("connection is not a listener")
+}
+
+func (pc *peerConn) Write(data []byte, stream typesP2P.Stream) error {
+	if int(stream) >= typesP2P.NumStreams {
+		return fmt.Errorf("invalid stream %d", stream)
+	}
+
+	pc.m.Lock()
+	if pc.isClosed {
+		pc.m.Unlock()
+		return fmt.Errorf("connection to %s is closed", pc.url)
+	}
+	if len(pc.queues[stream]) >= maxPeerQueueSize {
+		pc.m.Unlock()
+		return typesP2P.ErrPeerQueueFull(pc.url, stream)
+	}
+	pc.queues[stream] = append(pc.queues[stream], data)
+	startWriter := !pc.isWriting
+	pc.isWriting = true
+	pc.m.Unlock()
+
+	if startWriter {
+		go pc.run()
+	}
+	select {
+	case pc.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (pc *peerConn) Close() error {
+	pc.m.Lock()
+	if pc.isClosed {
+		pc.m.Unlock()
+		return nil
+	}
+	pc.isClosed = true
+	close(pc.quit)
+	pc.m.Unlock()
+
+	pc.setState(PeerConnStateClosed)
+	return nil
+}
+
+// run writes the queued messages until the connection is idle or closed
+func (pc *peerConn) run() {
+	for {
+		if !pc.waitForDial() {
+			return
+		}
+
+		pc.setState(PeerConnStateConnecting)
+		conn, s, err := pc.dial()
+		if err != nil {
+			// The queued messages are dropped, so no goroutine keeps redialing a peer that is gone
+			dropped := pc.backOff()
+			log.Printf("[WARN] Error dialing peer at %s, dropped %d messages: %v\n", pc.url, dropped, err)
+			if pc.stopIfIdle(PeerConnStateBackoff) {
+				return
+			}
+			continue
+		}
+
+		pc.m.Lock()
+		pc.backoff = initialRedialBackoff
+		pc.m.Unlock()
+		pc.setState(PeerConnStateConnected)
+
+		err = pc.writeMessages(conn, s)
+		conn.Close()
+		if err != nil {
+			log.Printf("[WARN] Error writing to peer at %s: %v\n", pc.url, err)
+		}
+		if pc.stopIfIdle(PeerConnStateIdle) {
+			return
+		}
+	}
+}
+
+// waitForDial waits until the backoff of the last failed dial elapses, and returns false if the connection is closed
+func (pc *peerConn) waitForDial() bool {
+	pc.m.Lock()
+	wait := time.Until(pc.nextDialTime)
+	pc.m.Unlock()
+
+	if wait <= 0 {
+		select {
+		case <-pc.quit:
+			return false
+		default:
+			return true
+		}
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-pc.quit:
+		return false
+	}
+}
+
+func (pc *peerConn) dial() (net.Conn, session, error) {
+	conn, err := net.DialTimeout(TCPNetworkLayerProtocol, pc.address.String(), connTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(connTimeout)); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	s, err := pc.newSession(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("error during handshake: %v", err)
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, s, nil
+}
+
+// backOff doubles the backoff before the next dial and drops the queued messages, returning how many were dropped
+func (pc *peerConn) backOff() int {
+	pc.m.Lock()
+	defer pc.m.Unlock()
+
+	pc.nextDialTime = time.Now().Add(pc.backoff)
+	pc.backoff *= 2
+	if pc.backoff > maxRedialBackoff {
+		pc.backoff = maxRedialBackoff
+	}
+
+	dropped := 0
+	for stream := range pc.queues {
+		dropped += len(pc.queues[stream])
+		pc.queues[stream] = nil
+	}
+	return dropped
+}
+
+// stopIfIdle stops the writer goroutine in the given state if no message is queued, and returns whether it stopped
+func (pc *peerConn) stopIfIdle(state PeerConnState) bool {
+	pc.m.Lock()
+	if pc.isClosed {
+		pc.m.Unlock()
+		return true
+	}
+	for stream := range pc.queues {
+		if len(pc.queues[stream]) > 0 {
+			pc.m.Unlock()
+			return false
+		}
+	}
+	pc.isWriting = false
+	pc.m.Unlock()
+
+	pc.setState(state)
+	return true
+}
+
+// writeMessages writes the queued messages to the connection, a frame of every stream in turn, until the connection
+// fails, is closed or stays idle
+func (pc *peerConn) writeMessages(conn net.Conn, s session) error {
+	writer := newMessageWriter(s)
+	for {
+		pc.m.Lock()
+		for stream := range pc.queues {
+			if !writer.hasPending(typesP2P.Stream(stream)) && len(pc.queues[stream]) > 0 {
+				writer.push(typesP2P.Stream(stream), pc.queues[stream][0])
+				pc.queues[stream] = pc.queues[stream][1:]
+			}
+		}
+		pc.m.Unlock()
+
+		if writer.isIdle() {
+			idleTimer := time.NewTimer(peerConnIdleTimeout)
+			select {
+			case <-pc.notify:
+				idleTimer.Stop()
+				continue
+			case <-idleTimer.C:
+				return nil
+			case <-pc.quit:
+				idleTimer.Stop()
+				return nil
+			}
+		}
+
+		if err := conn.SetWriteDeadline(time.Now().Add(connTimeout)); err != nil {
+			return err
+		}
+		if err := writer.writeRound(); err != nil {
+			return err
+		}
+	}
+}
+
+func (pc *peerConn) getState() PeerConnState {
+	pc.m.Lock()
+	defer pc.m.Unlock()
+	return pc.state
+}
+
+func (pc *peerConn) setState(state PeerConnState) {
+	pc.m.Lock()
+	previousState := pc.state
+	if previousState == PeerConnStateClosed || previousState == state {
+		pc.m.Unlock()
+		return
+	}
+	pc.state = state
+	pc.m.Unlock()
+
+	if pc.onStateChange != nil {
+		pc.onStateChange(pc, previousState, state)
+	}
+}
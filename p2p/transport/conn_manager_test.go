@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	types "github.com/pokt-network/pocket/runtime/configs/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnManager_MultiplexesStreamsOverASingleConnection(t *testing.T) {
+	listenerKey, dialerKey := newTestingPrivateKey(t), newTestingPrivateKey(t)
+	l, url := newTestingListener(t, &configs.P2PConfig{
+		PrivateKey:     listenerKey.String(),
+		ConnectionType: types.ConnectionType_SecureTCPConnection,
+	}, nil)
+
+	cm := NewConnManager(nil)
+	defer cm.Close()
+	dialerCfg := &configs.P2PConfig{
+		PrivateKey:     dialerKey.String(),
+		ConnectionType: types.ConnectionType_SecureTCPConnection,
+	}
+	dialer, err := cm.CreateDialer(dialerCfg, url, listenerKey.PublicKey())
+	require.NoError(t, err)
+	sameDialer, err := cm.CreateDialer(dialerCfg, url, listenerKey.PublicKey())
+	require.NoError(t, err)
+	require.Same(t, dialer, sameDialer)
+
+	// A large message of a stream does not hold back the messages of the other streams
+	largeMsg := bytes.Repeat([]byte("state sync"), maxFramePayloadSize)
+	require.NoError(t, dialer.Write(largeMsg, typesP2P.StreamStateSync))
+	require.NoError(t, dialer.Write([]byte("consensus"), typesP2P.StreamConsensus))
+	require.NoError(t, sameDialer.Write([]byte("transaction"), typesP2P.StreamTxGossip))
+
+	received := make(map[string]typesP2P.Stream)
+	for i := 0; i < 3; i++ {
+		data, remotePublicKey, stream, err := l.Read()
+		require.NoError(t, err)
+		require.True(t, remotePublicKey.Equals(dialerKey.PublicKey()))
+		received[string(data)] = stream
+	}
+	require.Equal(t, map[string]typesP2P.Stream{
+		string(largeMsg): typesP2P.StreamStateSync,
+		"consensus":      typesP2P.StreamConsensus,
+		"transaction":    typesP2P.StreamTxGossip,
+	}, received)
+
+	l.m.Lock()
+	require.Len(t, l.conns, 1)
+	l.m.Unlock()
+	require.Equal(t, map[string]PeerConnState{
+		fmt.Sprintf("%s@%s", listenerKey.PublicKey().Address(), url): PeerConnStateConnected,
+	}, cm.GetPeerConnStates())
+}
+
+func TestListener_DropsMessagesOfUnauthorizedPeers(t *testing.T) {
+	listenerKey, dialerKey := newTestingPrivateKey(t), newTestingPrivateKey(t)
+	// The dialer is unknown to the listener, which only accepts its discovery messages
+	l, url := newTestingListener(t, &configs.P2PConfig{
+		PrivateKey:     listenerKey.String(),
+		ConnectionType: types.ConnectionType_SecureTCPConnection,
+	}, func(publicKey cryptoPocket.PublicKey, stream typesP2P.Stream) bool {
+		return stream == typesP2P.StreamDiscovery
+	})
+
+	dialer, err := CreateDialer(&configs.P2PConfig{
+		PrivateKey:     dialerKey.String(),
+		ConnectionType: types.ConnectionType_SecureTCPConnection,
+	}, url, listenerKey.PublicKey())
+	require.NoError(t, err)
+	defer dialer.Close()
+	require.NoError(t, dialer.Write([]byte("consensus"), typesP2P.StreamConsensus))
+	require.NoError(t, dialer.Write([]byte("discovery"), typesP2P.StreamDiscovery))
+
+	data, remotePublicKey, stream, err := l.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("discovery"), data)
+	require.Equal(t, typesP2P.StreamDiscovery, stream)
+	require.True(t, remotePublicKey.Equals(dialerKey.PublicKey()))
+}
+
+func TestConnManager_RedialsPeerAfterConnectionFailure(t *testing.T) {
+	cfg := &configs.P2PConfig{ConnectionType: types.ConnectionType_TCPConnection}
+	l, url := newTestingListener(t, cfg, nil)
+
+	dialer, err := CreateDialer(cfg, url, nil)
+	require.NoError(t, err)
+	defer dialer.Close()
+	require.NoError(t, dialer.Write([]byte("before restart"), typesP2P.StreamConsensus))
+	data, _, _, err := l.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte("before restart"), data)
+
+	// The messages written while the peer restarts may be lost, but the connection recovers once it is back
+	require.NoError(t, l.Close())
+	cfg.ConsensusPort = uint32(l.tcpListener.Addr().(*net.TCPAddr).Port)
+	restarted, err := createListener(cfg, nil, func(conn net.Conn) (session, error) {
+		return newPlainSession(conn), nil
+	})
+	require.NoError(t, err)
+	defer restarted.Close()
+
+	received := make(chan []byte, 1)
+	go func() {
+		data, _, _, err := restarted.Read()
+		if err == nil {
+			received <- data
+		}
+	}()
+	require.Eventually(t, func() bool {
+		_ = dialer.Write([]byte("after restart"), typesP2P.StreamConsensus)
+		select {
+		case data := <-received:
+			return bytes.Equal(data, []byte("after restart"))
+		default:
+			return false
+		}
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestPeerConn_RejectsMessagesWhenQueueIsFull(t *testing.T) {
+	pc, err := createPeerConn(&configs.P2PConfig{ConnectionType: types.ConnectionType_TCPConnection}, "127.0.0.1:1", nil)
+	require.NoError(t, err)
+	defer pc.Close()
+
+	pc.queues[typesP2P.StreamTxGossip] = make([][]byte, maxPeerQueueSize)
+	require.ErrorContains(t, pc.Write([]byte("transaction"), typesP2P.StreamTxGossip), "queue")
+	require.Error(t, pc.Write([]byte("unknown"), typesP2P.Stream(typesP2P.NumStreams)))
+}
+
+// newTestingListener creates a listener on a free port, and returns it along with the URL to dial it
+func newTestingListener(t *testing.T, cfg *configs.P2PConfig, authorizePeer typesP2P.PeerAuthorizer) (*listener, string) {
+	transport, err := CreateListener(cfg, authorizePeer)
+	require.NoError(t, err)
+	l := transport.(*listener)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	return l, fmt.Sprintf("127.0.0.1:%d", l.tcpListener.Addr().(*net.TCPAddr).Port)
+}
@@ -0,0 +1,161 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// The number of received messages buffered until they are read
+const listenerBufferSize = 1000
+
+var _ typesP2P.Transport = &listener{}
+
+// listener accepts the long-lived connections of the peers, and reads the messages of all their streams
+type listener struct {
+	tcpListener   *net.TCPListener
+	newSession    func(conn net.Conn) (session, error)
+	authorizePeer typesP2P.PeerAuthorizer
+
+	incoming chan incomingMessage
+
+	m         sync.Mutex
+	conns     map[net.Conn]struct{}
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+type incomingMessage struct {
+	data            []byte
+	remotePublicKey cryptoPocket.PublicKey
+	stream          typesP2P.Stream
+}
+
+func createListener(cfg *configs.P2PConfig, authorizePeer typesP2P.PeerAuthorizer, newSession func(conn net.Conn) (session, error)) (*listener, error) {
+	addr, err := net.ResolveTCPAddr(TCPNetworkLayerProtocol, fmt.Sprintf(":%d", cfg.GetConsensusPort()))
+	if err != nil {
+		return nil, err
+	}
+	tcpListener, err := net.ListenTCP(TCPNetworkLayerProtocol, addr)
+	if err != nil {
+		return nil, err
+	}
+	l := &listener{
+		tcpListener:   tcpListener,
+		newSession:    newSession,
+		authorizePeer: authorizePeer,
+		incoming:      make(chan incomingMessage, listenerBufferSize),
+		conns:         make(map[net.Conn]struct{}),
+		quit:          make(chan struct{}),
+	}
+	go l.acceptConns()
+	return l, nil
+}
+
+func (l *listener) IsListener() bool {
+	return true
+}
+
+func (l *listener) Read() ([]byte, cryptoPocket.PublicKey, typesP2P.Stream, error) {
+	select {
+	case msg := <-l.incoming:
+		return msg.data, msg.remotePublicKey, msg.stream, nil
+	case <-l.quit:
+		return nil, nil, typesP2P.StreamDefault, fmt.Errorf("listener is closed")
+	}
+}
+
+func (l *listener) Write(_ []byte, _ typesP2P.Stream) error {
+	return fmt.Errorf("connection is a listener")
+}
+
+func (l *listener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.quit)
+		err = l.tcpListener.Close()
+
+		l.m.Lock()
+		defer l.m.Unlock()
+		for conn := range l.conns {
+			conn.Close()
+		}
+	})
+	return err
+}
+
+func (l *listener) acceptConns() {
+	for {
+		conn, err := l.tcpListener.Accept()
+		if err != nil {
+			select {
+			case <-l.quit:
+				return
+			default:
+				log.Println("Error accepting connection: ", err)
+				continue
+			}
+		}
+
+		l.m.Lock()
+		l.conns[conn] = struct{}{}
+		l.m.Unlock()
+
+		go l.readConn(conn)
+	}
+}
+
+// readConn reads the messages of the connection until it is closed. The messages the authenticated peer is not
+// authorized to send are dropped before they are buffered.
+func (l *listener) readConn(conn net.Conn) {
+	defer func() {
+		l.m.Lock()
+		delete(l.conns, conn)
+		l.m.Unlock()
+		conn.Close()
+	}()
+
+	if err := conn.SetDeadline(time.Now().Add(connTimeout)); err != nil {
+		return
+	}
+	s, err := l.newSession(conn)
+	if err != nil {
+		log.Printf("Error during handshake with %s: %v\n", conn.RemoteAddr(), err)
+		return
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return
+	}
+
+	remotePublicKey := s.getRemotePublicKey()
+	reader := newMessageReader(s)
+	for {
+		stream, data, err := reader.readMessage()
+		if err != nil {
+			select {
+			case <-l.quit:
+			default:
+				if err != io.EOF {
+					log.Printf("Error reading from %s: %v\n", conn.RemoteAddr(), err)
+				}
+			}
+			return
+		}
+		if remotePublicKey != nil && l.authorizePeer != nil && !l.authorizePeer(remotePublicKey, stream) {
+			log.Printf("Dropping %s message from unauthorized peer %s at %s\n", stream, remotePublicKey.Address(), conn.RemoteAddr())
+			continue
+		}
+		select {
+		case l.incoming <- incomingMessage{data: data, remotePublicKey: remotePublicKey, stream: stream}:
+		case <-l.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package transport
+
+import (
+	"fmt"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+const (
+	// The maximum size of the payload of a frame; a message is split across as many frames as needed
+	maxFramePayloadSize = 64 * 1024
+	// The maximum size of a message, so a peer cannot make a listener buffer an unbounded amount of data
+	maxMessageSize = 64 * 1024 * 1024
+
+	// A frame starts with the stream of the message and whether it is the last frame of the message
+	frameHeaderSize = 2
+	frameFlagMore   = byte(0)
+	frameFlagFinal  = byte(1)
+)
+
+// frame is a chunk of a message of a stream
+type frame struct {
+	stream  typesP2P.Stream
+	isFinal bool
+	payload []byte
+}
+
+// session sends and receives the frames of the streams multiplexed over a connection
+type session interface {
+	writeFrame(f frame) error
+	readFrame() (frame, error)
+	// getRemotePublicKey returns the public key the peer authenticated with, or nil if the session is not authenticated
+	getRemotePublicKey() cryptoPocket.PublicKey
+}
+
+func encodeFrame(f frame) []byte {
+	flag := frameFlagMore
+	if f.isFinal {
+		flag = frameFlagFinal
+	}
+	return append([]byte{byte(f.stream), flag}, f.payload...)
+}
+
+func decodeFrame(bz []byte) (frame, error) {
+	if len(bz) < frameHeaderSize {
+		return frame{}, fmt.Errorf("invalid frame of %d bytes", len(bz))
+	}
+	if int(bz[0]) >= typesP2P.NumStreams {
+		return frame{}, fmt.Errorf("invalid frame stream %d", bz[0])
+	}
+	if bz[1] != frameFlagMore && bz[1] != frameFlagFinal {
+		return frame{}, fmt.Errorf("invalid frame flag %d", bz[1])
+	}
+	return frame{
+		stream:  typesP2P.Stream(bz[0]),
+		isFinal: bz[1] == frameFlagFinal,
+		payload: bz[frameHeaderSize:],
+	}, nil
+}
+
+// messageReader reassembles the messages of the streams from their interleaved frames
+type messageReader struct {
+	session session
+	pending [typesP2P.NumStreams][]byte
+}
+
+func newMessageReader(s session) *messageReader {
+	return &messageReader{session: s}
+}
+
+// readMessage returns the next message completed on any of the streams
+func (r *messageReader) readMessage() (typesP2P.Stream, []byte, error) {
+	for {
+		f, err := r.session.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(r.pending[f.stream])+len(f.payload) > maxMessageSize {
+			return 0, nil, fmt.Errorf("message exceeds the maximum size of %d bytes", maxMessageSize)
+		}
+		r.pending[f.stream] = append(r.pending[f.stream], f.payload...)
+		if f.isFinal {
+			data := r.pending[f.stream]
+			r.pending[f.stream] = nil
+			if data == nil {
+				data = []byte{}
+			}
+			return f.stream, data, nil
+		}
+	}
+}
+
+// messageWriter splits the messages of the streams into frames, and writes a frame of every stream in turn
+type messageWriter struct {
+	session session
+	// The part of the message of each stream that is not written yet, nil if the stream has no message
+	pending [typesP2P.NumStreams][]byte
+}
+
+func newMessageWriter(s session) *messageWriter {
+	return &messageWriter{session: s}
+}
+
+// hasPending returns whether the message of the stream is not completely written yet
+func (w *messageWriter) hasPending(stream typesP2P.Stream) bool {
+	return w.pending[stream] != nil
+}
+
+func (w *messageWriter) isIdle() bool {
+	for stream := range w.pending {
+		if w.pending[stream] != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// push starts writing the message of the stream, which must not have a pending message
+func (w *messageWriter) push(stream typesP2P.Stream, data []byte) {
+	if data == nil {
+		data = []byte{}
+	}
+	w.pending[stream] = data
+}
+
+// writeRound writes the next frame of the message of every stream that has one
+func (w *messageWriter) writeRound() error {
+	for stream, data := range w.pending {
+		if data == nil {
+			continue
+		}
+		f := frame{stream: typesP2P.Stream(stream), isFinal: true, payload: data}
+		if len(data) > maxFramePayloadSize {
+			f.isFinal, f.payload = false, data[:maxFramePayloadSize]
+		}
+		if err := w.session.writeFrame(f); err != nil {
+			return err
+		}
+		if f.isFinal {
+			w.pending[stream] = nil
+		} else {
+			w.pending[stream] = data[maxFramePayloadSize:]
+		}
+	}
+	return nil
+}
+
+// writeMessage writes a whole message of the stream
+func writeMessage(s session, stream typesP2P.Stream, data []byte) error {
+	w := newMessageWriter(s)
+	w.push(stream, data)
+	for !w.isIdle() {
+		if err := w.writeRound(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package transport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+var _ session = &plainSession{}
+
+// plainSession sends the frames in cleartext, prefixed with their length. It neither authenticates the peers nor
+// encrypts the data, and is meant for local debugging.
+type plainSession struct {
+	conn io.ReadWriter
+}
+
+func newPlainSession(conn io.ReadWriter) *plainSession {
+	return &plainSession{conn: conn}
+}
+
+func (s *plainSession) writeFrame(f frame) error {
+	encoded := encodeFrame(f)
+	bz := make([]byte, frameLengthSize, frameLengthSize+len(encoded))
+	binary.BigEndian.PutUint32(bz, uint32(len(encoded)))
+	_, err := s.conn.Write(append(bz, encoded...))
+	return err
+}
+
+func (s *plainSession) readFrame() (frame, error) {
+	frameLength := make([]byte, frameLengthSize)
+	if _, err := io.ReadFull(s.conn, frameLength); err != nil {
+		return frame{}, err
+	}
+	length := binary.BigEndian.Uint32(frameLength)
+	if length < frameHeaderSize || length > frameHeaderSize+maxFramePayloadSize {
+		return frame{}, fmt.Errorf("invalid frame length %d", length)
+	}
+	bz := make([]byte, length)
+	if _, err := io.ReadFull(s.conn, bz); err != nil {
+		return frame{}, err
+	}
+	return decodeFrame(bz)
+}
+
+func (s *plainSession) getRemotePublicKey() cryptoPocket.PublicKey {
+	return nil
+}
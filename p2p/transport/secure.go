@@ -0,0 +1,227 @@
+package transport
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// The protocol name is mixed into the handshake transcript and the key derivation, so a handshake of another
+	// protocol or version never succeeds
+	secureProtocolName = "pocket-p2p-secure-tcp-v1"
+
+	initiatorSignaturePrefix = "initiator"
+	responderSignaturePrefix = "responder"
+
+	frameLengthSize = 4
+)
+
+var _ session = &secureSession{}
+
+// secureSession is a session where the connection starts with a handshake authenticating both peers with their ed25519
+// keys, after which the frames are encrypted and authenticated one by one.
+//
+// The handshake follows the Noise XX pattern, with the static keys proven by signatures instead of Diffie-Hellman
+// since the identities of the nodes are ed25519 keys:
+//  1. initiator -> responder: the ephemeral X25519 key of the initiator
+//  2. responder -> initiator: the ephemeral X25519 key of the responder
+//  3. responder -> initiator: encrypted, the ed25519 public key of the responder and its signature of the transcript
+//  4. initiator -> responder: encrypted, the ed25519 public key of the initiator and its signature of the transcript
+//
+// The session keys are derived from the ephemeral Diffie-Hellman secret, so past sessions stay secret if a node key
+// leaks. The transcript commits to both ephemeral keys, which binds the identities of the peers to the session.
+type secureSession struct {
+	conn io.ReadWriter
+
+	sendCipher cipher.AEAD
+	recvCipher cipher.AEAD
+	sendNonce  uint64
+	recvNonce  uint64
+
+	remotePublicKey cryptoPocket.PublicKey
+}
+
+// newSecureSession runs the handshake over `conn`. The node initiates the handshake if it knows the public key of the
+// peer it expects, and responds to it otherwise.
+func newSecureSession(conn io.ReadWriter, privateKey cryptoPocket.PrivateKey, expectedPublicKey cryptoPocket.PublicKey) (*secureSession, error) {
+	isInitiator := expectedPublicKey != nil
+
+	ephemeralPrivateKey := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(ephemeralPrivateKey); err != nil {
+		return nil, err
+	}
+	ephemeralPublicKey, err := curve25519.X25519(ephemeralPrivateKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteEphemeralPublicKey := make([]byte, curve25519.PointSize)
+	if isInitiator {
+		if _, err := conn.Write(ephemeralPublicKey); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(conn, remoteEphemeralPublicKey); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := io.ReadFull(conn, remoteEphemeralPublicKey); err != nil {
+			return nil, err
+		}
+		if _, err := conn.Write(ephemeralPublicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	// X25519 rejects the low order points, which would make the shared secret predictable
+	sharedSecret, err := curve25519.X25519(ephemeralPrivateKey, remoteEphemeralPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	initiatorEphemeralPublicKey, responderEphemeralPublicKey := ephemeralPublicKey, remoteEphemeralPublicKey
+	if !isInitiator {
+		initiatorEphemeralPublicKey, responderEphemeralPublicKey = remoteEphemeralPublicKey, ephemeralPublicKey
+	}
+	transcript := sha256.New()
+	transcript.Write([]byte(secureProtocolName))
+	transcript.Write(initiatorEphemeralPublicKey)
+	transcript.Write(responderEphemeralPublicKey)
+	transcriptHash := transcript.Sum(nil)
+
+	initiatorCipher, responderCipher, err := deriveSessionCiphers(sharedSecret, transcriptHash)
+	if err != nil {
+		return nil, err
+	}
+	session := &secureSession{conn: conn}
+	if isInitiator {
+		session.sendCipher, session.recvCipher = initiatorCipher, responderCipher
+	} else {
+		session.sendCipher, session.recvCipher = responderCipher, initiatorCipher
+	}
+
+	// The responder proves its identity first, so the initiator does not reveal its own to an unexpected peer
+	if isInitiator {
+		remotePublicKey, err := session.readIdentity(responderSignaturePrefix, transcriptHash)
+		if err != nil {
+			return nil, err
+		}
+		if !remotePublicKey.Equals(expectedPublicKey) {
+			return nil, fmt.Errorf("peer authenticated with address %s instead of %s", remotePublicKey.Address(), expectedPublicKey.Address())
+		}
+		if err := session.writeIdentity(privateKey, initiatorSignaturePrefix, transcriptHash); err != nil {
+			return nil, err
+		}
+		session.remotePublicKey = remotePublicKey
+	} else {
+		if err := session.writeIdentity(privateKey, responderSignaturePrefix, transcriptHash); err != nil {
+			return nil, err
+		}
+		remotePublicKey, err := session.readIdentity(initiatorSignaturePrefix, transcriptHash)
+		if err != nil {
+			return nil, err
+		}
+		session.remotePublicKey = remotePublicKey
+	}
+
+	return session, nil
+}
+
+// deriveSessionCiphers derives a key for each direction of the session from the Diffie-Hellman secret
+func deriveSessionCiphers(sharedSecret, transcriptHash []byte) (initiatorCipher, responderCipher cipher.AEAD, err error) {
+	kdf := hkdf.New(sha256.New, sharedSecret, transcriptHash, []byte(secureProtocolName))
+	initiatorKey := make([]byte, chacha20poly1305.KeySize)
+	responderKey := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(kdf, initiatorKey); err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.ReadFull(kdf, responderKey); err != nil {
+		return nil, nil, err
+	}
+	if initiatorCipher, err = chacha20poly1305.New(initiatorKey); err != nil {
+		return nil, nil, err
+	}
+	if responderCipher, err = chacha20poly1305.New(responderKey); err != nil {
+		return nil, nil, err
+	}
+	return initiatorCipher, responderCipher, nil
+}
+
+func (s *secureSession) writeIdentity(privateKey cryptoPocket.PrivateKey, signaturePrefix string, transcriptHash []byte) error {
+	signature, err := privateKey.Sign(append([]byte(signaturePrefix), transcriptHash...))
+	if err != nil {
+		return err
+	}
+	return s.writeFrame(frame{isFinal: true, payload: append(privateKey.PublicKey().Bytes(), signature...)})
+}
+
+func (s *secureSession) readIdentity(signaturePrefix string, transcriptHash []byte) (cryptoPocket.PublicKey, error) {
+	f, err := s.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	identity := f.payload
+	publicKeySize := cryptoPocket.PublicKeyLen
+	if len(identity) < publicKeySize {
+		return nil, fmt.Errorf("invalid handshake identity of %d bytes", len(identity))
+	}
+	publicKey, err := cryptoPocket.NewPublicKeyFromBytes(identity[:publicKeySize])
+	if err != nil {
+		return nil, err
+	}
+	if !publicKey.Verify(append([]byte(signaturePrefix), transcriptHash...), identity[publicKeySize:]) {
+		return nil, fmt.Errorf("invalid handshake signature of %s", publicKey.Address())
+	}
+	return publicKey, nil
+}
+
+// writeFrame sends the frame sealed under the next nonce, prefixed with the length of the sealed frame
+func (s *secureSession) writeFrame(f frame) error {
+	plaintext := encodeFrame(f)
+	sealed := make([]byte, frameLengthSize, frameLengthSize+len(plaintext)+s.sendCipher.Overhead())
+	sealed = s.sendCipher.Seal(sealed, nextNonce(&s.sendNonce), plaintext, nil)
+	binary.BigEndian.PutUint32(sealed[:frameLengthSize], uint32(len(sealed)-frameLengthSize))
+	_, err := s.conn.Write(sealed)
+	return err
+}
+
+func (s *secureSession) readFrame() (frame, error) {
+	frameLength := make([]byte, frameLengthSize)
+	if _, err := io.ReadFull(s.conn, frameLength); err != nil {
+		return frame{}, err
+	}
+	sealedLength := binary.BigEndian.Uint32(frameLength)
+	if sealedLength < uint32(frameHeaderSize+s.recvCipher.Overhead()) || sealedLength > uint32(frameHeaderSize+maxFramePayloadSize+s.recvCipher.Overhead()) {
+		return frame{}, fmt.Errorf("invalid frame length %d", sealedLength)
+	}
+	sealed := make([]byte, sealedLength)
+	if _, err := io.ReadFull(s.conn, sealed); err != nil {
+		return frame{}, err
+	}
+	plaintext, err := s.recvCipher.Open(sealed[:0], nextNonce(&s.recvNonce), sealed, nil)
+	if err != nil {
+		return frame{}, fmt.Errorf("invalid frame: %v", err)
+	}
+	return decodeFrame(plaintext)
+}
+
+func (s *secureSession) getRemotePublicKey() cryptoPocket.PublicKey {
+	return s.remotePublicKey
+}
+
+// nextNonce returns the nonce of the next frame from its counter, so a replayed, reordered or dropped frame fails
+// authentication
+func nextNonce(counter *uint64) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.LittleEndian.PutUint64(nonce[chacha20poly1305.NonceSize-8:], *counter)
+	*counter++
+	return nonce
+}
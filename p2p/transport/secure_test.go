@@ -0,0 +1,99 @@
+package transport
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecureSession_AuthenticatesPeersAndEncryptsMessages(t *testing.T) {
+	initiatorKey, responderKey := newTestingPrivateKey(t), newTestingPrivateKey(t)
+	initiator, responder := newTestingSecureSessions(t, initiatorKey, responderKey, responderKey.PublicKey())
+	require.True(t, initiator.remotePublicKey.Equals(responderKey.PublicKey()))
+	require.True(t, responder.remotePublicKey.Equals(initiatorKey.PublicKey()))
+
+	// The message spans several frames, none of which contains the plaintext
+	msg := bytes.Repeat([]byte("pocket"), maxFramePayloadSize)
+	sent := new(bytes.Buffer)
+	initiator.conn = sent
+	require.NoError(t, writeMessage(initiator, typesP2P.StreamConsensus, msg))
+	require.NotContains(t, sent.String(), string(msg[:64]))
+
+	responder.conn = sent
+	stream, received, err := newMessageReader(responder).readMessage()
+	require.NoError(t, err)
+	require.Equal(t, typesP2P.StreamConsensus, stream)
+	require.Equal(t, msg, received)
+}
+
+func TestSecureSession_RejectsUnexpectedPeer(t *testing.T) {
+	initiatorKey, responderKey := newTestingPrivateKey(t), newTestingPrivateKey(t)
+	initiatorConn, responderConn := net.Pipe()
+	defer responderConn.Close()
+
+	go func() {
+		// The responder fails once the initiator hangs up
+		_, _ = newSecureSession(responderConn, responderKey, nil)
+	}()
+	_, err := newSecureSession(initiatorConn, initiatorKey, newTestingPrivateKey(t).PublicKey())
+	require.ErrorContains(t, err, "peer authenticated with address")
+	initiatorConn.Close()
+}
+
+func TestSecureSession_RejectsTamperedAndReplayedFrames(t *testing.T) {
+	initiatorKey, responderKey := newTestingPrivateKey(t), newTestingPrivateKey(t)
+	initiator, responder := newTestingSecureSessions(t, initiatorKey, responderKey, responderKey.PublicKey())
+
+	sent := new(bytes.Buffer)
+	initiator.conn = sent
+	require.NoError(t, writeMessage(initiator, typesP2P.StreamDefault, []byte("first")))
+	frame := append([]byte{}, sent.Bytes()...)
+	recvNonce := responder.recvNonce
+
+	// A flipped bit fails authentication
+	tampered := append([]byte{}, frame...)
+	tampered[len(tampered)-1] ^= 1
+	responder.conn = bytes.NewBuffer(tampered)
+	_, _, err := newMessageReader(responder).readMessage()
+	require.ErrorContains(t, err, "invalid frame")
+
+	// A frame replayed after it was received is sealed under a stale nonce
+	responder.recvNonce = recvNonce
+	responder.conn = bytes.NewBuffer(append(append([]byte{}, frame...), frame...))
+	reader := newMessageReader(responder)
+	_, received, err := reader.readMessage()
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), received)
+	_, _, err = reader.readMessage()
+	require.ErrorContains(t, err, "invalid frame")
+}
+
+// newTestingSecureSessions runs the handshake between an initiator expecting `expectedPublicKey` and a responder
+func newTestingSecureSessions(t *testing.T, initiatorKey, responderKey cryptoPocket.PrivateKey, expectedPublicKey cryptoPocket.PublicKey) (initiator, responder *secureSession) {
+	initiatorConn, responderConn := net.Pipe()
+	t.Cleanup(func() {
+		initiatorConn.Close()
+		responderConn.Close()
+	})
+
+	responderErr := make(chan error, 1)
+	go func() {
+		var err error
+		responder, err = newSecureSession(responderConn, responderKey, nil)
+		responderErr <- err
+	}()
+	initiator, err := newSecureSession(initiatorConn, initiatorKey, expectedPublicKey)
+	require.NoError(t, err)
+	require.NoError(t, <-responderErr)
+	return initiator, responder
+}
+
+func newTestingPrivateKey(t *testing.T) cryptoPocket.PrivateKey {
+	privateKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey
+}
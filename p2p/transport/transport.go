@@ -2,119 +2,60 @@ package transport
 
 import (
 	"fmt"
-	types "github.com/pokt-network/pocket/runtime/configs/types"
-	"io/ioutil"
 	"net"
+	"time"
+
+	types "github.com/pokt-network/pocket/runtime/configs/types"
 
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
 	"github.com/pokt-network/pocket/runtime/configs"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 )
 
 const (
 	TCPNetworkLayerProtocol = "tcp4"
+
+	// The time a peer has to connect and complete the handshake, and to accept each round of frames
+	connTimeout = 10 * time.Second
 )
 
-func CreateListener(cfg *configs.P2PConfig) (typesP2P.Transport, error) {
+// CreateListener creates the transport accepting the connections of the peers. The messages of a peer that
+// authenticated with the secure transport are dropped unless `authorizePeer` accepts them; a nil `authorizePeer`
+// accepts every message.
+func CreateListener(cfg *configs.P2PConfig, authorizePeer typesP2P.PeerAuthorizer) (typesP2P.Transport, error) {
 	switch cfg.ConnectionType {
 	case types.ConnectionType_EmptyConnection:
 		return createEmptyListener(cfg)
 	case types.ConnectionType_TCPConnection:
-		return createTCPListener(cfg)
+		return createListener(cfg, authorizePeer, func(conn net.Conn) (session, error) {
+			return newPlainSession(conn), nil
+		})
+	case types.ConnectionType_SecureTCPConnection:
+		privateKey, err := cryptoPocket.NewPrivateKey(cfg.GetPrivateKey())
+		if err != nil {
+			return nil, err
+		}
+		return createListener(cfg, authorizePeer, func(conn net.Conn) (session, error) {
+			return newSecureSession(conn, privateKey, nil)
+		})
 	default:
 		return nil, fmt.Errorf("unsupported connection type for listener: %v", cfg.ConnectionType)
 	}
 }
 
-func CreateDialer(cfg *configs.P2PConfig, url string) (typesP2P.Transport, error) {
+// CreateDialer creates a transport to the peer reachable at `url`, which authenticates with `publicKey` if the
+// connection type is secure. The connection is not shared with other dialers of the same peer; see ConnManager.
+func CreateDialer(cfg *configs.P2PConfig, url string, publicKey cryptoPocket.PublicKey) (typesP2P.Transport, error) {
 	switch cfg.ConnectionType {
 	case types.ConnectionType_EmptyConnection:
 		return createEmptyDialer(cfg, url)
-	case types.ConnectionType_TCPConnection:
-		return createTCPDialer(cfg, url)
+	case types.ConnectionType_TCPConnection, types.ConnectionType_SecureTCPConnection:
+		return createPeerConn(cfg, url, publicKey)
 	default:
 		return nil, fmt.Errorf("unsupported connection type for dialer: %v", cfg.ConnectionType)
 	}
 }
 
-var _ typesP2P.Transport = &tcpConn{}
-
-type tcpConn struct {
-	address  *net.TCPAddr
-	listener *net.TCPListener
-}
-
-func createTCPListener(cfg *configs.P2PConfig) (*tcpConn, error) {
-	addr, err := net.ResolveTCPAddr(TCPNetworkLayerProtocol, fmt.Sprintf(":%d", cfg.ConsensusPort))
-	if err != nil {
-		return nil, err
-	}
-	l, err := net.ListenTCP(TCPNetworkLayerProtocol, addr)
-	if err != nil {
-		return nil, err
-	}
-	return &tcpConn{
-		address:  addr,
-		listener: l,
-	}, nil
-}
-
-func createTCPDialer(_ *configs.P2PConfig, url string) (*tcpConn, error) {
-	addr, err := net.ResolveTCPAddr(TCPNetworkLayerProtocol, url)
-	if err != nil {
-		return nil, err
-	}
-	return &tcpConn{
-		address: addr,
-	}, nil
-}
-
-func (c *tcpConn) IsListener() bool {
-	return c.listener != nil
-}
-
-func (c *tcpConn) Read() ([]byte, error) {
-	if !c.IsListener() {
-		return nil, fmt.Errorf("connection is not a listener")
-	}
-	conn, err := c.listener.Accept()
-	if err != nil {
-		return nil, fmt.Errorf("error accepting connection: %v", err)
-	}
-	defer conn.Close()
-
-	data, err := ioutil.ReadAll(conn)
-	if err != nil {
-		return nil, fmt.Errorf("error reading from conn: %v", err)
-	}
-
-	return data, nil
-}
-
-func (c *tcpConn) Write(data []byte) error {
-	if c.IsListener() {
-		return fmt.Errorf("connection is a listener")
-	}
-
-	client, err := net.DialTCP(TCPNetworkLayerProtocol, nil, c.address)
-	if err != nil {
-		return err
-	}
-	defer client.Close()
-
-	if _, err = client.Write(data); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (c *tcpConn) Close() error {
-	if c.IsListener() {
-		return c.listener.Close()
-	}
-	return nil
-}
-
 var _ typesP2P.Transport = &emptyConn{}
 
 type emptyConn struct {
@@ -132,11 +73,11 @@ func (c *emptyConn) IsListener() bool {
 	return false
 }
 
-func (c *emptyConn) Read() ([]byte, error) {
-	return nil, nil
+func (c *emptyConn) Read() ([]byte, cryptoPocket.PublicKey, typesP2P.Stream, error) {
+	return nil, nil, typesP2P.StreamDefault, nil
 }
 
-func (c *emptyConn) Write(data []byte) error {
+func (c *emptyConn) Write(data []byte, stream typesP2P.Stream) error {
 	return nil
 }
 
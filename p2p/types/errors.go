@@ -5,3 +5,23 @@ import "fmt"
 func ErrUnknownEventType(msg any) error {
 	return fmt.Errorf("unknown event type: %v", msg)
 }
+
+func ErrUnknownDiscoveryMessageType(msgType DiscoveryMessageType) error {
+	return fmt.Errorf("unknown discovery message type: %v", msgType)
+}
+
+func ErrInvalidBootstrapPeer(bootstrapPeer string, err error) error {
+	return fmt.Errorf("invalid bootstrap peer %q, expected `<hex public key>@<host>:<port>`: %v", bootstrapPeer, err)
+}
+
+func ErrUnauthenticatedSender(senderPublicKey, remotePublicKey string) error {
+	return fmt.Errorf("the sender %s is not the authenticated peer %s", senderPublicKey, remotePublicKey)
+}
+
+func ErrUnauthorizedPeer(address, contentType string) error {
+	return fmt.Errorf("the peer %s is not in the address book and cannot send %s messages", address, contentType)
+}
+
+func ErrPeerQueueFull(url string, stream Stream) error {
+	return fmt.Errorf("the %s queue of the peer at %s is full", stream, url)
+}
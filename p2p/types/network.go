@@ -21,7 +21,7 @@ type Network interface {
 	RemovePeerToAddrBook(peer *NetworkPeer) error
 
 	// This function was added to specifically support the RainTree implementation.
-	// Handles the raw data received from the network and returns the data to be processed
-	// by the application layer.
-	HandleNetworkData(data []byte) ([]byte, error)
+	// Handles the raw data received from the peer that authenticated with `remotePublicKey`, which is nil if the
+	// transport does not authenticate its peers, and returns the data to be processed by the application layer.
+	HandleNetworkData(data []byte, remotePublicKey cryptoPocket.PublicKey) ([]byte, error)
 }
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/pokt-network/pocket/shared/messaging"
+	"google.golang.org/protobuf/proto"
+)
+
+// Stream is a logical stream of messages multiplexed over the connection to a peer. The streams are written frame by
+// frame in turn, so a large message of one stream (e.g. a block sent by state sync) does not delay the others.
+type Stream uint8
+
+const (
+	StreamDefault Stream = iota
+	StreamConsensus
+	StreamStateSync
+	StreamTxGossip
+	StreamDiscovery
+)
+
+const NumStreams = int(StreamDiscovery) + 1
+
+// The stream of the messages of each content type; the messages of the other content types use the default stream
+var streamsByContentType = map[string]Stream{
+	"consensus.HotstuffMessage":        StreamConsensus,
+	"consensus.StateSyncMessage":       StreamStateSync,
+	"utility.TransactionGossipMessage": StreamTxGossip,
+	"p2p.DiscoveryMessage":             StreamDiscovery,
+}
+
+func (s Stream) String() string {
+	switch s {
+	case StreamConsensus:
+		return "consensus"
+	case StreamStateSync:
+		return "state_sync"
+	case StreamTxGossip:
+		return "tx_gossip"
+	case StreamDiscovery:
+		return "discovery"
+	default:
+		return "default"
+	}
+}
+
+// GetStream returns the stream of the messages of the content type
+func GetStream(contentType string) Stream {
+	return streamsByContentType[contentType]
+}
+
+// GetEnvelopeStream returns the stream of the serialized `PocketEnvelope`, or the default stream if it cannot be decoded
+func GetEnvelopeStream(data []byte) Stream {
+	envelope := &messaging.PocketEnvelope{}
+	if err := proto.Unmarshal(data, envelope); err != nil {
+		return StreamDefault
+	}
+	return GetStream(envelope.GetContentType())
+}
@@ -1,14 +1,26 @@
 package types
 
-import "github.com/pokt-network/pocket/runtime/configs"
+import (
+	"github.com/pokt-network/pocket/runtime/configs"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
 
 //go:generate mockgen -source=$GOFILE -destination=./mocks/transport_mock.go github.com/pokt-network/pocket/p2p/types Transport
 
 type Transport interface {
 	IsListener() bool
-	Read() ([]byte, error)
-	Write([]byte) error
+	// Read returns the next message received by a listener, along with the public key its sender authenticated with,
+	// which is nil if the transport does not authenticate its peers, and the stream it was received on
+	Read() ([]byte, cryptoPocket.PublicKey, Stream, error)
+	// Write queues the message for the stream of the dialed peer; it does not wait for the message to be sent, and
+	// fails if the queue of the stream is full
+	Write(data []byte, stream Stream) error
 	Close() error
 }
 
-type ConnectionFactory func(cfg *configs.P2PConfig, url string) (Transport, error)
+// PeerAuthorizer returns whether the messages that the peer which authenticated with `publicKey` sends on `stream`
+// are accepted
+type PeerAuthorizer func(publicKey cryptoPocket.PublicKey, stream Stream) bool
+
+// ConnectionFactory creates a transport to the peer reachable at `url`, which authenticates with `publicKey`
+type ConnectionFactory func(cfg *configs.P2PConfig, url string, publicKey cryptoPocket.PublicKey) (Transport, error)
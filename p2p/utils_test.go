@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/golang/mock/gomock"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
 	mocksP2P "github.com/pokt-network/pocket/p2p/types/mocks"
@@ -132,6 +133,7 @@ func createMockRuntimeMgrs(t *testing.T, numValidators int) []modules.RuntimeMgr
 		mockRuntimeMgr := mockModules.NewMockRuntimeMgr(ctrl)
 		mockRuntimeMgr.EXPECT().GetConfig().Return(cfg).AnyTimes()
 		mockRuntimeMgr.EXPECT().GetGenesis().Return(mockGenesisState).AnyTimes()
+		mockRuntimeMgr.EXPECT().GetClock().Return(clock.New()).AnyTimes()
 		mockRuntimeMgrs[i] = mockRuntimeMgr
 	}
 	return mockRuntimeMgrs
@@ -251,6 +253,10 @@ func prepareNoopTimeSeriesAgentMock(t *testing.T) *mockModules.MockTimeSeriesAge
 
 	timeseriesAgentMock.EXPECT().CounterRegister(gomock.Any(), gomock.Any()).AnyTimes()
 	timeseriesAgentMock.EXPECT().CounterIncrement(gomock.Any()).AnyTimes()
+	timeseriesAgentMock.EXPECT().GaugeRegister(gomock.Any(), gomock.Any()).AnyTimes()
+	timeseriesAgentMock.EXPECT().GaugeIncrement(gomock.Any()).AnyTimes()
+	timeseriesAgentMock.EXPECT().GaugeDecrement(gomock.Any()).AnyTimes()
+	timeseriesAgentMock.EXPECT().GaugeSet(gomock.Any(), gomock.Any()).AnyTimes()
 
 	return timeseriesAgentMock
 }
@@ -277,14 +283,14 @@ func prepareConnMock(t *testing.T, valId string, wg *sync.WaitGroup, expectedNum
 	ctrl := gomock.NewController(t)
 	connMock := mocksP2P.NewMockTransport(ctrl)
 
-	connMock.EXPECT().Read().DoAndReturn(func() ([]byte, error) {
+	connMock.EXPECT().Read().DoAndReturn(func() ([]byte, cryptoPocket.PublicKey, typesP2P.Stream, error) {
 		wg.Done()
 		log.Printf("[valId: %s] Read\n", valId)
 		data := <-eventsChannel
-		return data, nil
+		return data, nil, typesP2P.StreamDefault, nil
 	}).Times(expectedNumNetworkReads + 1) // +1 is necessary because there is one extra read of empty data by every channel when it starts
 
-	connMock.EXPECT().Write(gomock.Any()).DoAndReturn(func(data []byte) error {
+	connMock.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(data []byte, _ typesP2P.Stream) error {
 		eventsChannel <- data
 		return nil
 	}).Times(expectedNumNetworkReads)
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/pokt-network/pocket/persistence/types"
+	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 )
 
@@ -39,6 +41,70 @@ func (p PostgresContext) GetAccountsUpdated(height int64) (accounts []*coreTypes
 	return p.getAccountsUpdated(types.Account, height)
 }
 
+// --- Vesting Functions ---
+
+func (p PostgresContext) SetAccountVesting(address []byte, schedule *coreTypes.VestingSchedule) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	scheduleBz, err := codec.GetCodec().Marshal(schedule)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertVestingScheduleQuery(hex.EncodeToString(address), hex.EncodeToString(scheduleBz), height))
+	return err
+}
+
+// GetAccountVesting returns the vesting schedule of the account or nil if it never had one
+func (p PostgresContext) GetAccountVesting(address []byte, height int64) (*coreTypes.VestingSchedule, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	var scheduleHex string
+	if err := tx.QueryRow(ctx, types.GetVestingScheduleQuery(hex.EncodeToString(address), height)).Scan(&scheduleHex); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	scheduleBz, err := hex.DecodeString(scheduleHex)
+	if err != nil {
+		return nil, err
+	}
+	schedule := new(coreTypes.VestingSchedule)
+	if err := codec.GetCodec().Unmarshal(scheduleBz, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (p PostgresContext) getVestingAddressesUpdated(height int64) (addresses []string, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, types.GetVestingSchedulesUpdatedAtHeightQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var address string
+		if err = rows.Scan(&address); err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return
+}
+
 // --- Pool Functions ---
 
 func (p PostgresContext) InsertPool(name string, amount string) error {
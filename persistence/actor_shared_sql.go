@@ -334,3 +334,46 @@ func (p PostgresContext) getActorStakeAmount(actorSchema types.ProtocolActorSche
 	}
 	return stakeAmount, nil
 }
+
+// moveActor moves the stake record of the actor at `address` to `newAddress`, and tombstones the record at
+// `address` so it is no longer an actor
+func (p PostgresContext) moveActor(actorSchema types.ProtocolActorSchema, address, newAddress, newPublicKey []byte) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+
+	currentHeight, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+
+	addr, newAddr := hex.EncodeToString(address), hex.EncodeToString(newAddress)
+	if _, err = tx.Exec(ctx, actorSchema.MoveQuery(addr, newAddr, hex.EncodeToString(newPublicKey), currentHeight)); err != nil {
+		return err
+	}
+	if chainsTableName := actorSchema.GetChainsTableName(); chainsTableName != "" {
+		if _, err = tx.Exec(ctx, types.NullifyChains(newAddr, currentHeight, chainsTableName)); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, actorSchema.MoveChainsQuery(addr, newAddr, currentHeight)); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(ctx, actorSchema.TombstoneQuery(addr, currentHeight))
+	return err
+}
+
+func (p PostgresContext) setActorOutputAddress(actorSchema types.ProtocolActorSchema, address, outputAddress []byte) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+
+	currentHeight, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, actorSchema.UpdateOutputAddressQuery(hex.EncodeToString(address), hex.EncodeToString(outputAddress), currentHeight))
+	return err
+}
@@ -60,6 +60,14 @@ func (p PostgresContext) SetAppStakeAmount(address []byte, stakeAmount string) e
 	return p.setActorStakeAmount(types.ApplicationActor, address, stakeAmount)
 }
 
+func (p PostgresContext) RotateAppKey(address, newAddress, newPublicKey []byte) error {
+	return p.moveActor(types.ApplicationActor, address, newAddress, newPublicKey)
+}
+
+func (p PostgresContext) SetAppOutputAddress(address, outputAddress []byte) error {
+	return p.setActorOutputAddress(types.ApplicationActor, address, outputAddress)
+}
+
 func (p PostgresContext) GetAppsReadyToUnstake(height int64, _ int32) ([]modules.IUnstakingActor, error) {
 	return p.GetActorsReadyToUnstake(types.ApplicationActor, height)
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/pokt-network/pocket/persistence/types"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (p *persistenceModule) TransactionExists(transactionHash string) (bool, error) {
@@ -27,6 +29,41 @@ func (p *persistenceModule) TransactionExists(transactionHash string) (bool, err
 	return true, err
 }
 
+func (p *persistenceModule) GetTransactionsByHeight(height int64) ([]modules.TxResult, error) {
+	return p.txIndexer.GetByHeight(height, false)
+}
+
+func (p *persistenceModule) GetTransactionsByEvent(eventType coreTypes.EventType, key, value string) ([]modules.TxResult, error) {
+	return p.txIndexer.GetByEvent(eventType, key, value, false)
+}
+
+func (p *persistenceModule) GetBlock(height int64) (*coreTypes.Block, error) {
+	blockBz, err := p.GetBlockStore().Get(heightToBytes(height))
+	if err != nil {
+		return nil, err
+	}
+	block := new(coreTypes.Block)
+	if err := codec.GetCodec().Unmarshal(blockBz, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// GetBlockEvents returns the events emitted by the block lifecycle hooks at the given height
+func (p *persistenceModule) GetBlockEvents(height int64) ([]*coreTypes.Event, error) {
+	block, err := p.GetBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return block.Events, nil
+}
+
+// GetBlockEventsByType returns the events of `eventType` emitted by the block lifecycle hooks of all the committed
+// blocks, filtered by the attribute `key` if it is not empty
+func (p *persistenceModule) GetBlockEventsByType(eventType coreTypes.EventType, key, value string) ([]*coreTypes.BlockEvent, error) {
+	return p.txIndexer.GetBlockEventsByEvent(eventType, key, value, false)
+}
+
 func (p PostgresContext) GetLatestBlockHeight() (latestHeight uint64, err error) {
 	ctx, tx, err := p.getCtxAndTx()
 	if err != nil {
@@ -55,6 +92,19 @@ func (p PostgresContext) GetHeight() (int64, error) {
 	return p.Height, nil
 }
 
+// GetBlockTime returns the time of the committed block at the given height
+func (p PostgresContext) GetBlockTime(height int64) (*timestamppb.Timestamp, error) {
+	blockBz, err := p.blockStore.Get(heightToBytes(height))
+	if err != nil {
+		return nil, err
+	}
+	block := new(coreTypes.Block)
+	if err := codec.GetCodec().Unmarshal(blockBz, block); err != nil {
+		return nil, err
+	}
+	return block.GetBlockHeader().GetTime(), nil
+}
+
 // Creates a block protobuf object using the schema defined in the persistence module
 func (p *PostgresContext) prepareBlock(proposerAddr, quorumCert []byte) (*coreTypes.Block, error) {
 	var prevBlockHash string
@@ -78,9 +128,16 @@ func (p *PostgresContext) prepareBlock(proposerAddr, quorumCert []byte) (*coreTy
 		ProposerAddress:   proposerAddr,
 		QuorumCertificate: quorumCert,
 		TransactionsHash:  txsHash,
+		Time:              p.blockTime,
+		ValidatorSetHash:  p.validatorSet.Hash(),
 	}
 	block := &coreTypes.Block{
 		BlockHeader: blockHeader,
+		Events:      p.blockEvents,
+	}
+	// The snapshot of the validator set is stored once per epoch, in its first block
+	if p.validatorSet.GetStartHeight() == uint64(p.Height) {
+		block.ValidatorSet = p.validatorSet
 	}
 
 	return block, nil
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// --- BLS Key Functions ---
+
+// SetValidatorBLSKey registers the BLS public key of a validator at the current height, replacing the key
+// it registered previously. The proof of possession is expected to have been verified by the caller.
+func (p PostgresContext) SetValidatorBLSKey(address, publicKey, proofOfPossession []byte) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertBLSKeyQuery(hex.EncodeToString(address), hex.EncodeToString(publicKey), hex.EncodeToString(proofOfPossession), height))
+	return err
+}
+
+// clearValidatorBLSKey removes the BLS key of a validator from the current height on, keeping it at the previous heights
+func (p PostgresContext) clearValidatorBLSKey(address []byte) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertBLSKeyQuery(hex.EncodeToString(address), "", "", height))
+	return err
+}
+
+// GetValidatorBLSKey returns the BLS key registered by a validator or nil if it never registered one or it was cleared
+func (p PostgresContext) GetValidatorBLSKey(address []byte, height int64) (*coreTypes.BLSKey, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	var publicKeyHex, proofHex string
+	if err := tx.QueryRow(ctx, types.GetBLSKeyQuery(hex.EncodeToString(address), height)).Scan(&publicKeyHex, &proofHex); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// A cleared key is stored as an empty public key
+	if publicKeyHex == "" {
+		return nil, nil
+	}
+	return newBLSKey(hex.EncodeToString(address), publicKeyHex, proofHex)
+}
+
+func (p PostgresContext) getBLSKeysUpdated(height int64) (blsKeys []*coreTypes.BLSKey, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, types.GetBLSKeysUpdatedAtHeightQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var address, publicKeyHex, proofHex string
+		if err = rows.Scan(&address, &publicKeyHex, &proofHex); err != nil {
+			return nil, err
+		}
+		blsKey, err := newBLSKey(address, publicKeyHex, proofHex)
+		if err != nil {
+			return nil, err
+		}
+		blsKeys = append(blsKeys, blsKey)
+	}
+	return
+}
+
+func newBLSKey(address, publicKeyHex, proofHex string) (*coreTypes.BLSKey, error) {
+	publicKey, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := hex.DecodeString(proofHex)
+	if err != nil {
+		return nil, err
+	}
+	return &coreTypes.BLSKey{
+		Address:           address,
+		PublicKey:         publicKey,
+		ProofOfPossession: proof,
+	}, nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/pokt-network/pocket/persistence/indexer"
 	"github.com/pokt-network/pocket/persistence/kvstore"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/modules"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ modules.PersistenceRWContext = &PostgresContext{}
@@ -21,6 +23,12 @@ type PostgresContext struct {
 	tx     pgx.Tx
 
 	stateHash string
+	// The events emitted by the utility block lifecycle hooks; stored alongside the block on commit
+	blockEvents []*coreTypes.Event
+	// The time of the block proposal; stored in the block header on commit
+	blockTime *timestamppb.Timestamp
+	// The validator set of the epoch of the block; its hash is stored in the block header on commit
+	validatorSet *coreTypes.ValidatorSet
 
 	// TECHDEBT(#361): These three values are pointers to objects maintained by the PersistenceModule.
 	//                 Need to simply access them via the bus.
@@ -65,6 +73,12 @@ func (p PostgresContext) Commit(proposerAddr, quorumCert []byte) error {
 	if err := p.storeBlock(block); err != nil {
 		return err
 	}
+	if err := p.txIndexer.IndexBlockEvents(p.Height, block.GetEvents()); err != nil {
+		return err
+	}
+	if err := p.storeStateTreeRoots(); err != nil {
+		return err
+	}
 
 	// Insert the block into the SQL DB
 	if err := p.insertBlock(block); err != nil {
@@ -105,6 +119,21 @@ func (p PostgresContext) IndexTransaction(txResult modules.TxResult) error {
 	return p.txIndexer.Index(txResult)
 }
 
+func (p *PostgresContext) SetBlockEvents(events []*coreTypes.Event) error {
+	p.blockEvents = events
+	return nil
+}
+
+func (p *PostgresContext) SetBlockTime(blockTime *timestamppb.Timestamp) error {
+	p.blockTime = blockTime
+	return nil
+}
+
+func (p *PostgresContext) SetBlockValidatorSet(validatorSet *coreTypes.ValidatorSet) error {
+	p.validatorSet = validatorSet
+	return nil
+}
+
 func (p *PostgresContext) resetContext() (err error) {
 	if p == nil {
 		return nil
@@ -145,6 +145,22 @@ func initializeAllTables(ctx context.Context, db *pgx.Conn) error {
 		return err
 	}
 
+	if err := initializeGovernanceTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeDelegationTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeFeeMarketTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeBLSKeyTables(ctx, db); err != nil {
+		return err
+	}
+
 	for _, actor := range protocolActorSchemas {
 		if err := initializeProtocolActorTables(ctx, db, actor); err != nil {
 			return err
@@ -173,6 +189,9 @@ func initializeAccountTables(ctx context.Context, db *pgx.Conn) error {
 	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.PoolTableName, types.Pool.GetTableSchema())); err != nil {
 		return err
 	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.VestingSchedulesTableName, types.VestingSchedulesTableSchema)); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -199,5 +218,42 @@ func initializeBlockTables(ctx context.Context, db *pgx.Conn) error {
 	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.BlockTableName, types.BlockTableSchema)); err != nil {
 		return err
 	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.TransactionHashesTableName, types.TransactionHashesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeGovernanceTables(ctx context.Context, db *pgx.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.ProposalsTableName, types.ProposalsTableSchema)); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.ProposalVotesTableName, types.ProposalVotesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeDelegationTables(ctx context.Context, db *pgx.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.DelegationsTableName, types.DelegationsTableSchema)); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.CommissionsTableName, types.CommissionsTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeFeeMarketTables(ctx context.Context, db *pgx.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.BaseFeesTableName, types.BaseFeesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeBLSKeyTables(ctx context.Context, db *pgx.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.BLSKeysTableName, types.BLSKeysTableSchema)); err != nil {
+		return err
+	}
 	return nil
 }
@@ -16,9 +16,17 @@ import (
 var nonActorClearFunctions = []func() string{
 	types.Account.ClearAllAccounts,
 	types.Pool.ClearAllAccounts,
+	types.ClearAllVestingSchedulesQuery,
 	types.ClearAllGovParamsQuery,
 	types.ClearAllGovFlagsQuery,
 	types.ClearAllBlocksQuery,
+	types.ClearAllProposalsQuery,
+	types.ClearAllProposalVotesQuery,
+	types.ClearAllDelegationsQuery,
+	types.ClearAllCommissionsQuery,
+	types.ClearAllBaseFeesQuery,
+	types.ClearAllBLSKeysQuery,
+	types.ClearAllTransactionHashesQuery,
 }
 
 func (m *persistenceModule) HandleDebugMessage(debugMessage *messaging.DebugMessage) error {
@@ -0,0 +1,175 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/pokt-network/pocket/persistence/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// --- Delegation Functions ---
+
+// SetDelegation inserts or updates the delegation at the current height; withdrawn delegations are
+// kept with `DELEGATION_STATUS_WITHDRAWN` so the history remains queryable.
+func (p PostgresContext) SetDelegation(delegation *coreTypes.Delegation) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	delegationBz, err := codec.GetCodec().Marshal(delegation)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertDelegationQuery(delegation.Delegator, delegation.ActorAddress, int32(delegation.ActorType), int32(delegation.Status), delegation.UnbondingHeight, hex.EncodeToString(delegationBz), height))
+	return err
+}
+
+func (p PostgresContext) GetDelegationExists(delegator, actorAddress []byte, actorType coreTypes.ActorType, height int64) (exists bool, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return
+	}
+	err = tx.QueryRow(ctx, types.GetDelegationExistsQuery(hex.EncodeToString(delegator), hex.EncodeToString(actorAddress), int32(actorType), height)).Scan(&exists)
+	return
+}
+
+func (p PostgresContext) GetDelegation(delegator, actorAddress []byte, actorType coreTypes.ActorType, height int64) (*coreTypes.Delegation, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	var delegationHex string
+	if err := tx.QueryRow(ctx, types.GetDelegationQuery(hex.EncodeToString(delegator), hex.EncodeToString(actorAddress), int32(actorType), height)).Scan(&delegationHex); err != nil {
+		return nil, err
+	}
+	return delegationFromHex(delegationHex)
+}
+
+// GetActorDelegations returns the bonded and unbonding delegations to an actor of the given type
+func (p PostgresContext) GetActorDelegations(actorAddress []byte, actorType coreTypes.ActorType, height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(types.GetActorDelegationsQuery(hex.EncodeToString(actorAddress), int32(actorType), int32(coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN), height))
+}
+
+// GetDelegatorDelegations returns the bonded and unbonding delegations of a delegator
+func (p PostgresContext) GetDelegatorDelegations(delegator []byte, height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(types.GetDelegatorDelegationsQuery(hex.EncodeToString(delegator), int32(coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN), height))
+}
+
+// GetDelegationsReadyToWithdraw returns the unbonding delegations whose unbonding height has been reached
+func (p PostgresContext) GetDelegationsReadyToWithdraw(height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(types.GetDelegationsReadyToWithdrawQuery(int32(coreTypes.DelegationStatus_DELEGATION_STATUS_UNBONDING), height))
+}
+
+func (p PostgresContext) getDelegationsUpdated(height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(types.GetDelegationsUpdatedAtHeightQuery(height))
+}
+
+func (p PostgresContext) getDelegations(query string) (delegations []*coreTypes.Delegation, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var delegationHex string
+		if err = rows.Scan(&delegationHex); err != nil {
+			return nil, err
+		}
+		delegation, err := delegationFromHex(delegationHex)
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, delegation)
+	}
+	return
+}
+
+func delegationFromHex(delegationHex string) (*coreTypes.Delegation, error) {
+	delegationBz, err := hex.DecodeString(delegationHex)
+	if err != nil {
+		return nil, err
+	}
+	delegation := new(coreTypes.Delegation)
+	if err := codec.GetCodec().Unmarshal(delegationBz, delegation); err != nil {
+		return nil, err
+	}
+	return delegation, nil
+}
+
+// --- Commission Functions ---
+
+func (p PostgresContext) SetCommission(address []byte, actorType coreTypes.ActorType, percentage uint32) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertCommissionQuery(hex.EncodeToString(address), int32(actorType), percentage, height))
+	return err
+}
+
+// CopyCommissionHistory copies the commissions set by the actor at `address` to `newAddress`, at the heights they
+// were set, so the commission change period of the actor carries over when its operator key is rotated
+func (p PostgresContext) CopyCommissionHistory(address, newAddress []byte, actorType coreTypes.ActorType) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.CopyCommissionHistoryQuery(hex.EncodeToString(address), hex.EncodeToString(newAddress), int32(actorType), height))
+	return err
+}
+
+// GetCommission returns the commission percentage of an actor, which is zero if it was never set
+func (p PostgresContext) GetCommission(address []byte, actorType coreTypes.ActorType, height int64) (uint32, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return 0, err
+	}
+	var percentage int64
+	if err := tx.QueryRow(ctx, types.GetCommissionQuery(hex.EncodeToString(address), int32(actorType), height)).Scan(&percentage); err != nil {
+		return 0, err
+	}
+	return uint32(percentage), nil
+}
+
+func (p PostgresContext) getCommissionsUpdated(height int64) (commissions []*coreTypes.Commission, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, types.GetCommissionsUpdatedAtHeightQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		commission := new(coreTypes.Commission)
+		var actorType int32
+		var percentage int64
+		if err = rows.Scan(&commission.Address, &actorType, &percentage); err != nil {
+			return nil, err
+		}
+		commission.ActorType = coreTypes.ActorType(actorType)
+		commission.Percentage = uint32(percentage)
+		commissions = append(commissions, commission)
+	}
+	return
+}
@@ -0,0 +1,48 @@
+package persistence
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+)
+
+func (p PostgresContext) SetBaseFee(baseFee string) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertBaseFeeQuery(baseFee, height))
+	return err
+}
+
+// GetBaseFee returns the latest base fee computed at or before `height`, which is an empty string
+// if the dynamic fee market was never active
+func (p PostgresContext) GetBaseFee(height int64) (string, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return "", err
+	}
+	var baseFee string
+	if err := tx.QueryRow(ctx, types.GetBaseFeeQuery(height)).Scan(&baseFee); err != nil {
+		return "", err
+	}
+	return baseFee, nil
+}
+
+// getBaseFeeUpdated returns the base fee computed at exactly `height`, if any
+func (p PostgresContext) getBaseFeeUpdated(height int64) (baseFee string, updated bool, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return "", false, err
+	}
+	if err := tx.QueryRow(ctx, types.GetBaseFeeUpdatedAtHeightQuery(height)).Scan(&baseFee); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return baseFee, true, nil
+}
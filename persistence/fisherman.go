@@ -57,6 +57,14 @@ func (p PostgresContext) SetFishermanStakeAmount(address []byte, stakeAmount str
 	return p.setActorStakeAmount(types.FishermanActor, address, stakeAmount)
 }
 
+func (p PostgresContext) RotateFishermanKey(address, newAddress, newPublicKey []byte) error {
+	return p.moveActor(types.FishermanActor, address, newAddress, newPublicKey)
+}
+
+func (p PostgresContext) SetFishermanOutputAddress(address, outputAddress []byte) error {
+	return p.setActorOutputAddress(types.FishermanActor, address, outputAddress)
+}
+
 func (p PostgresContext) GetFishermenReadyToUnstake(height int64, status int32) ([]modules.IUnstakingActor, error) {
 	return p.GetActorsReadyToUnstake(types.FishermanActor, height)
 }
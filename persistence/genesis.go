@@ -9,6 +9,7 @@ import (
 	"github.com/pokt-network/pocket/runtime/genesis"
 	"github.com/pokt-network/pocket/shared/converters"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 )
 
 // CONSIDERATION: Should this return an error and let the caller decide if it should log a fatal error?
@@ -44,6 +45,14 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 		if err != nil {
 			log.Fatalf("an error occurred inserting an acc in the genesis state: %s", err.Error())
 		}
+		if vesting := acc.GetVesting(); vesting != nil {
+			if err := vesting.ValidateBasic(); err != nil {
+				log.Fatalf("invalid vesting schedule for acc %s in the genesis state: %s", acc.GetAddress(), err.Error())
+			}
+			if err := rwContext.SetAccountVesting(addrBz, vesting); err != nil {
+				log.Fatalf("an error occurred inserting the vesting schedule of an acc in the genesis state: %s", err.Error())
+			}
+		}
 	}
 	for _, pool := range state.GetPools() {
 		err = rwContext.InsertPool(pool.GetAddress(), pool.GetAmount()) // pool.GetAddress() returns the pool's semantic name
@@ -110,6 +119,19 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 		}
 	}
 
+	for _, blsKey := range state.GetValidatorBlsKeys() {
+		addrBz, err := hex.DecodeString(blsKey.GetAddress())
+		if err != nil {
+			log.Fatalf("an error occurred converting address to bytes %s", blsKey.GetAddress())
+		}
+		if !crypto.VerifyBLSPossession(blsKey.GetPublicKey(), blsKey.GetProofOfPossession()) {
+			log.Fatalf("invalid proof of possession for the BLS key of validator %s in the genesis state", blsKey.GetAddress())
+		}
+		if err := rwContext.SetValidatorBLSKey(addrBz, blsKey.GetPublicKey(), blsKey.GetProofOfPossession()); err != nil {
+			log.Fatalf("an error occurred inserting a BLS key in the genesis state: %s", err.Error())
+		}
+	}
+
 	if err = rwContext.InitGenesisParams(state.Params); err != nil {
 		log.Fatalf("an error occurred initializing params: %s", err.Error())
 	}
@@ -125,6 +147,11 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 	}
 	log.Println("PopulateGenesisState - computed state hash:", stateHash)
 
+	// The genesis block is the parent of the first block, whose time cannot be earlier
+	if err = rwContext.SetBlockTime(state.GetGenesisTime()); err != nil {
+		log.Fatalf("an error occurred setting the genesis block time: %s", err.Error())
+	}
+
 	// This updates the DB, blockstore, and commits the genesis state.
 	// Note that the `quorumCert for genesis` is nil.
 	if err = rwContext.Commit(nil, nil); err != nil {
@@ -105,6 +105,38 @@ func (p PostgresContext) GetBytesFlag(flagName string, height int64) (value []by
 	return getParamOrFlag[[]byte](p, types.FlagsTableName, flagName, height)
 }
 
+func (p PostgresContext) GetFlagExists(flagName string, height int64) (exists bool, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return false, err
+	}
+	err = tx.QueryRow(ctx, types.GetFlagExistsQuery(flagName, height)).Scan(&exists)
+	return
+}
+
+// GetAllFlags returns the latest value of every flag at the given height
+func (p PostgresContext) GetAllFlags(height int64) ([]*coreTypes.Flag, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, types.GetAllFlagsQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var flags []*coreTypes.Flag
+	for rows.Next() {
+		flag := new(coreTypes.Flag)
+		if err := rows.Scan(&flag.Name, &flag.Value, &flag.Enabled); err != nil {
+			return nil, err
+		}
+		flag.Height = height
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
 func (p PostgresContext) SetFlag(flagName string, value any, enabled bool) error {
 	return p.setParamOrFlag(flagName, value, &enabled)
 }
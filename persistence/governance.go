@@ -0,0 +1,160 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/pokt-network/pocket/persistence/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// --- Proposal Functions ---
+
+func (p PostgresContext) InsertProposal(proposal *coreTypes.Proposal) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	proposalBz, err := codec.GetCodec().Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertProposalQuery(proposal.Id, int32(proposal.Status), proposal.VotingEndHeight, hex.EncodeToString(proposalBz), height))
+	return err
+}
+
+func (p PostgresContext) SetProposalStatus(proposalId uint64, status coreTypes.ProposalStatus) error {
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	proposal, err := p.GetProposal(proposalId, height)
+	if err != nil {
+		return err
+	}
+	proposal.Status = status
+	return p.InsertProposal(proposal)
+}
+
+func (p PostgresContext) GetNextProposalId(height int64) (proposalId uint64, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return 0, err
+	}
+	err = tx.QueryRow(ctx, types.GetNextProposalIdQuery(height)).Scan(&proposalId)
+	return
+}
+
+func (p PostgresContext) GetProposal(proposalId uint64, height int64) (*coreTypes.Proposal, error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	var proposalHex string
+	if err := tx.QueryRow(ctx, types.GetProposalQuery(proposalId, height)).Scan(&proposalHex); err != nil {
+		return nil, err
+	}
+	return proposalFromHex(proposalHex)
+}
+
+func (p PostgresContext) GetAllProposals(height int64) ([]*coreTypes.Proposal, error) {
+	return p.getProposals(types.GetAllProposalsQuery(height))
+}
+
+// GetProposalsEndingAtHeight returns the proposals that are still being voted on and whose voting
+// period ends at `votingEndHeight`
+func (p PostgresContext) GetProposalsEndingAtHeight(votingEndHeight, height int64) ([]*coreTypes.Proposal, error) {
+	return p.getProposals(types.GetProposalsEndingAtHeightQuery(votingEndHeight, int32(coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING), height))
+}
+
+func (p PostgresContext) getProposalsUpdated(height int64) ([]*coreTypes.Proposal, error) {
+	return p.getProposals(types.GetProposalsUpdatedAtHeightQuery(height))
+}
+
+func (p PostgresContext) getProposals(query string) (proposals []*coreTypes.Proposal, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var proposalHex string
+		if err = rows.Scan(&proposalHex); err != nil {
+			return nil, err
+		}
+		proposal, err := proposalFromHex(proposalHex)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+	return
+}
+
+func proposalFromHex(proposalHex string) (*coreTypes.Proposal, error) {
+	proposalBz, err := hex.DecodeString(proposalHex)
+	if err != nil {
+		return nil, err
+	}
+	proposal := new(coreTypes.Proposal)
+	if err := codec.GetCodec().Unmarshal(proposalBz, proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// --- Vote Functions ---
+
+func (p PostgresContext) InsertProposalVote(proposalId uint64, voter []byte, option coreTypes.VoteOption) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertProposalVoteQuery(proposalId, hex.EncodeToString(voter), int32(option), height))
+	return err
+}
+
+// GetProposalVotes returns the latest vote of every voter on the proposal
+func (p PostgresContext) GetProposalVotes(proposalId uint64, height int64) ([]*coreTypes.ProposalVote, error) {
+	return p.getProposalVotes(types.GetProposalVotesQuery(proposalId, height))
+}
+
+func (p PostgresContext) getProposalVotesUpdated(height int64) ([]*coreTypes.ProposalVote, error) {
+	return p.getProposalVotes(types.GetProposalVotesUpdatedAtHeightQuery(height))
+}
+
+func (p PostgresContext) getProposalVotes(query string) (votes []*coreTypes.ProposalVote, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		vote := new(coreTypes.ProposalVote)
+		var option int32
+		if err = rows.Scan(&vote.ProposalId, &vote.Voter, &option, &vote.Height); err != nil {
+			return nil, err
+		}
+		vote.Option = coreTypes.VoteOption(option)
+		votes = append(votes, vote)
+	}
+	return
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	shared "github.com/pokt-network/pocket/shared/modules"
 
 	"github.com/jordanorelli/lexnum"
@@ -34,6 +35,17 @@ type TxIndexer interface {
 	// GetByRecipient returns all transactions *sent to address*; may be ordered descending/ascending
 	GetByRecipient(recipient string, descending bool) ([]shared.TxResult, error)
 
+	// `GetByEvent` returns all transactions that emitted an event of *eventType*; if *key* is not empty, only
+	// the transactions whose event has the attribute *key* with *value* are returned; ordered by height and index
+	GetByEvent(eventType coreTypes.EventType, key, value string, descending bool) ([]shared.TxResult, error)
+
+	// `IndexBlockEvents` indexes the events emitted by the block lifecycle hooks at *height* by event type and attribute
+	IndexBlockEvents(height int64, events []*coreTypes.Event) error
+
+	// `GetBlockEventsByEvent` returns all the events of *eventType* emitted by the block lifecycle hooks; if *key* is
+	// not empty, only the events with the attribute *key* with *value* are returned; ordered by height and index
+	GetBlockEventsByEvent(eventType coreTypes.EventType, key, value string, descending bool) ([]*coreTypes.BlockEvent, error)
+
 	// Close stops the underlying db connection
 	Close() error
 }
@@ -47,6 +59,11 @@ const (
 	heightPrefix    = 'b' // b for block
 	senderPrefix    = 's'
 	recipientPrefix = 'r'
+	eventPrefix     = 't' // t for type
+	attributePrefix = 'e' // e for event attribute
+
+	blockEventPrefix     = 'T' // T for the type of a block event
+	blockAttributePrefix = 'E' // E for the attribute of a block event
 )
 
 // =,- are the default parameters in the [example repository](https://github.com/jordanorelli/lexnum#example)
@@ -120,6 +137,9 @@ func (indexer *txIndexer) Index(result shared.TxResult) error {
 	if err := indexer.indexByRecipient(result.GetRecipientAddr(), hashKey); err != nil {
 		return err
 	}
+	if err := indexer.indexByEvents(result.GetHeight(), result.GetIndex(), result.GetEvents(), hashKey); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -139,6 +159,57 @@ func (indexer *txIndexer) GetByRecipient(recipient string, descending bool) ([]s
 	return indexer.getAll(indexer.recipientKey(recipient), descending)
 }
 
+func (indexer *txIndexer) GetByEvent(eventType coreTypes.EventType, key, value string, descending bool) ([]shared.TxResult, error) {
+	if key == "" {
+		return indexer.getAll(indexer.eventKey(eventType), descending)
+	}
+	return indexer.getAll(indexer.attributeKey(eventType, key, value), descending)
+}
+
+func (indexer *txIndexer) IndexBlockEvents(height int64, events []*coreTypes.Event) error {
+	for i, event := range events {
+		bz, err := codec.GetCodec().Marshal(&coreTypes.BlockEvent{
+			Height: height,
+			Index:  int32(i),
+			Event:  event,
+		})
+		if err != nil {
+			return err
+		}
+		suffix := indexer.heightAndIndexSuffix(height, int32(i))
+		if err := indexer.db.Set(append(indexer.blockEventKey(event.GetEventType()), suffix...), bz); err != nil {
+			return err
+		}
+		for _, attribute := range event.GetAttributes() {
+			key := indexer.blockAttributeKey(event.GetEventType(), attribute.GetKey(), attribute.GetValue())
+			if err := indexer.db.Set(append(key, suffix...), bz); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (indexer *txIndexer) GetBlockEventsByEvent(eventType coreTypes.EventType, key, value string, descending bool) ([]*coreTypes.BlockEvent, error) {
+	prefix := indexer.blockEventKey(eventType)
+	if key != "" {
+		prefix = indexer.blockAttributeKey(eventType, key, value)
+	}
+	_, values, err := indexer.db.GetAll(prefix, descending)
+	if err != nil {
+		return nil, err
+	}
+	blockEvents := make([]*coreTypes.BlockEvent, 0, len(values))
+	for _, bz := range values {
+		blockEvent := new(coreTypes.BlockEvent)
+		if err := codec.GetCodec().Unmarshal(bz, blockEvent); err != nil {
+			return nil, err
+		}
+		blockEvents = append(blockEvents, blockEvent)
+	}
+	return blockEvents, nil
+}
+
 func (indexer *txIndexer) Close() error {
 	return indexer.db.Stop()
 }
@@ -191,6 +262,25 @@ func (indexer *txIndexer) indexByRecipient(recipient string, bz []byte) error {
 	return indexer.db.Set(indexer.recipientKey(recipient), bz)
 }
 
+// indexByEvents stores the hashKey by event type and by event attribute; the keys are suffixed by the
+// height and index of the transaction so that a transaction emitting the same event several times is
+// only returned once and the results are sorted like the results of `GetByHeight`
+func (indexer *txIndexer) indexByEvents(height int64, index int32, events []*coreTypes.Event, bz []byte) error {
+	suffix := indexer.heightAndIndexSuffix(height, index)
+	for _, event := range events {
+		if err := indexer.db.Set(append(indexer.eventKey(event.GetEventType()), suffix...), bz); err != nil {
+			return err
+		}
+		for _, attribute := range event.GetAttributes() {
+			key := indexer.attributeKey(event.GetEventType(), attribute.GetKey(), attribute.GetValue())
+			if err := indexer.db.Set(append(key, suffix...), bz); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // key helper functions
 
 func (indexer *txIndexer) hashKey(hash []byte) []byte {
@@ -213,6 +303,26 @@ func (indexer *txIndexer) recipientKey(address string) []byte {
 	return indexer.key(recipientPrefix, address)
 }
 
+func (indexer *txIndexer) eventKey(eventType coreTypes.EventType) []byte {
+	return indexer.key(eventPrefix, eventType.String()+"/")
+}
+
+func (indexer *txIndexer) attributeKey(eventType coreTypes.EventType, key, value string) []byte {
+	return indexer.key(attributePrefix, fmt.Sprintf("%s/%s/%s/", eventType.String(), key, value))
+}
+
+func (indexer *txIndexer) blockEventKey(eventType coreTypes.EventType) []byte {
+	return indexer.key(blockEventPrefix, eventType.String()+"/")
+}
+
+func (indexer *txIndexer) blockAttributeKey(eventType coreTypes.EventType, key, value string) []byte {
+	return indexer.key(blockAttributePrefix, fmt.Sprintf("%s/%s/%s/", eventType.String(), key, value))
+}
+
+func (indexer *txIndexer) heightAndIndexSuffix(height int64, index int32) []byte {
+	return []byte(elenEncoder.EncodeInt(int(height)) + "/" + elenEncoder.EncodeInt(int(index)))
+}
+
 func (indexer *txIndexer) key(prefix rune, postfix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", string(prefix), postfix))
 }
@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 	shared "github.com/pokt-network/pocket/shared/modules"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func FuzzTxIndexer(f *testing.F) {
@@ -183,6 +185,97 @@ func TestGetByRecipient(t *testing.T) {
 	require.Equal(t, 0, len(txResultsFromSenderBad))
 }
 
+func TestGetByEvent(t *testing.T) {
+	txIndexer, err := NewMemTxIndexer()
+	require.NoError(t, err)
+	defer txIndexer.Close()
+	// setup transactions
+	txResult := NewTestingTransactionResult(t, 1, 0).(*TxRes)
+	txResult.Events = []*coreTypes.Event{
+		coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_STAKE,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, txResult.SignerAddr),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, "1000")),
+		coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_FEE,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, txResult.SignerAddr)),
+	}
+	txResult2 := NewTestingTransactionResult(t, 2, 0).(*TxRes)
+	txResult2.Events = []*coreTypes.Event{
+		coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_STAKE,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, txResult2.SignerAddr),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, "1000")),
+	}
+	// index transactions
+	require.NoError(t, txIndexer.Index(txResult))
+	require.NoError(t, txIndexer.Index(txResult2))
+	// check indexing by event type
+	stakeTxResults, err := txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_STAKE, "", "", false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stakeTxResults))
+	requireTxResultsEqual(t, txResult, stakeTxResults[0])
+	requireTxResultsEqual(t, txResult2, stakeTxResults[1])
+	stakeTxResults, err = txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_STAKE, "", "", true)
+	require.NoError(t, err)
+	requireTxResultsEqual(t, txResult2, stakeTxResults[0])
+	// check indexing by event attribute
+	amountTxResults, err := txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_STAKE, coreTypes.EventAttributeKeyAmount, "1000", false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(amountTxResults))
+	addressTxResults, err := txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_STAKE, coreTypes.EventAttributeKeyAddress, txResult2.SignerAddr, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(addressTxResults))
+	requireTxResultsEqual(t, txResult2, addressTxResults[0])
+	// ensure it's not indexed elsewhere
+	feeTxResults, err := txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_FEE, coreTypes.EventAttributeKeyAddress, txResult2.SignerAddr, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(feeTxResults))
+	// the attribute value must match exactly rather than by prefix
+	prefixTxResults, err := txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_STAKE, coreTypes.EventAttributeKeyAmount, "100", false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(prefixTxResults))
+}
+
+func TestGetBlockEventsByEvent(t *testing.T) {
+	txIndexer, err := NewMemTxIndexer()
+	require.NoError(t, err)
+	defer txIndexer.Close()
+	// setup block events
+	address, address2 := randomAddress(t), randomAddress(t)
+	missedBlock := coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, address),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyMissedBlocks, "1"))
+	missedBlock2 := coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, address2),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyMissedBlocks, "1"))
+	reward := coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_REWARD,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, address))
+	// index block events
+	require.NoError(t, txIndexer.IndexBlockEvents(1, []*coreTypes.Event{reward, missedBlock}))
+	require.NoError(t, txIndexer.IndexBlockEvents(2, []*coreTypes.Event{missedBlock2}))
+	// check indexing by event type
+	missedBlockEvents, err := txIndexer.GetBlockEventsByEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK, "", "", false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(missedBlockEvents))
+	require.Equal(t, int64(1), missedBlockEvents[0].GetHeight())
+	require.Equal(t, int32(1), missedBlockEvents[0].GetIndex())
+	require.True(t, proto.Equal(missedBlock, missedBlockEvents[0].GetEvent()))
+	require.Equal(t, int64(2), missedBlockEvents[1].GetHeight())
+	missedBlockEvents, err = txIndexer.GetBlockEventsByEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK, "", "", true)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), missedBlockEvents[0].GetHeight())
+	// check indexing by event attribute
+	addressEvents, err := txIndexer.GetBlockEventsByEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK, coreTypes.EventAttributeKeyAddress, address2, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(addressEvents))
+	require.True(t, proto.Equal(missedBlock2, addressEvents[0].GetEvent()))
+	// ensure the block events are not indexed as transactions nor under other types
+	txResults, err := txIndexer.GetByEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK, "", "", false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(txResults))
+	rewardEvents, err := txIndexer.GetBlockEventsByEvent(coreTypes.EventType_EVENT_TYPE_REWARD, coreTypes.EventAttributeKeyAddress, address2, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rewardEvents))
+}
+
 func requireTxResultsEqual(t *testing.T, txR1, txR2 shared.TxResult) {
 	bz, err := txR1.Bytes()
 	require.NoError(t, err)
@@ -57,6 +57,14 @@ func (p PostgresContext) SetServiceNodeStakeAmount(address []byte, stakeAmount s
 	return p.setActorStakeAmount(types.ServiceNodeActor, address, stakeAmount)
 }
 
+func (p PostgresContext) RotateServiceNodeKey(address, newAddress, newPublicKey []byte) error {
+	return p.moveActor(types.ServiceNodeActor, address, newAddress, newPublicKey)
+}
+
+func (p PostgresContext) SetServiceNodeOutputAddress(address, outputAddress []byte) error {
+	return p.setActorOutputAddress(types.ServiceNodeActor, address, outputAddress)
+}
+
 func (p PostgresContext) GetServiceNodeCount(chain string, height int64) (int, error) {
 	panic("GetServiceNodeCount not implemented")
 }
@@ -3,6 +3,7 @@ package persistence
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"log"
@@ -30,7 +31,8 @@ type stateTrees struct {
 const (
 	// IMPORTANT: The order in which these trees are defined is important and strict. It implicitly
 	// defines the index of the root hash each independent as they are concatenated together
-	// to generate the state hash.
+	// to generate the state hash. The indices of the trees proven to light clients are also
+	// defined in `shared/core/types/state_proof.go`.
 
 	// Actor Merkle Trees
 	appMerkleTree merkleTree = iota
@@ -47,6 +49,20 @@ const (
 	paramsMerkleTree
 	flagsMerkleTree
 
+	// Governance Merkle Trees
+	proposalsMerkleTree
+	proposalVotesMerkleTree
+
+	// Delegation Merkle Trees
+	delegationsMerkleTree
+	commissionsMerkleTree
+
+	// Fee Market Merkle Trees
+	baseFeeMerkleTree
+
+	// Consensus Merkle Trees
+	blsKeysMerkleTree
+
 	// Used for iteration purposes only; see https://stackoverflow.com/a/64178235/768439 as a reference
 	numMerkleTrees
 )
@@ -69,6 +85,16 @@ var merkleTreeToString = map[merkleTree]string{
 	transactionsMerkleTree: "transactions",
 	paramsMerkleTree:       "params",
 	flagsMerkleTree:        "flags",
+
+	proposalsMerkleTree:     "proposals",
+	proposalVotesMerkleTree: "proposalVotes",
+
+	delegationsMerkleTree: "delegations",
+	commissionsMerkleTree: "commissions",
+
+	baseFeeMerkleTree: "baseFee",
+
+	blsKeysMerkleTree: "blsKeys",
 }
 
 var actorTypeToMerkleTreeName = map[coreTypes.ActorType]merkleTree{
@@ -179,6 +205,38 @@ func (p *PostgresContext) updateMerkleTrees() (string, error) {
 				return "", err
 			}
 
+		// Governance Merkle Trees
+		case proposalsMerkleTree:
+			if err := p.updateProposalsTree(); err != nil {
+				return "", err
+			}
+		case proposalVotesMerkleTree:
+			if err := p.updateProposalVotesTree(); err != nil {
+				return "", err
+			}
+
+		// Delegation Merkle Trees
+		case delegationsMerkleTree:
+			if err := p.updateDelegationsTree(); err != nil {
+				return "", err
+			}
+		case commissionsMerkleTree:
+			if err := p.updateCommissionsTree(); err != nil {
+				return "", err
+			}
+
+		// Fee Market Merkle Trees
+		case baseFeeMerkleTree:
+			if err := p.updateBaseFeeTree(); err != nil {
+				return "", err
+			}
+
+		// Consensus Merkle Trees
+		case blsKeysMerkleTree:
+			if err := p.updateBLSKeysTree(); err != nil {
+				return "", err
+			}
+
 		// Default
 		default:
 			log.Fatalf("Not handled yet in state commitment update. Merkle tree #{%v}\n", treeType)
@@ -189,20 +247,23 @@ func (p *PostgresContext) updateMerkleTrees() (string, error) {
 }
 
 func (p *PostgresContext) getStateHash() string {
-	// Get the root of each Merkle Tree
-	roots := make([][]byte, 0)
-	for tree := merkleTree(0); tree < numMerkleTrees; tree++ {
-		roots = append(roots, p.stateTrees.merkleTrees[tree].Root())
-	}
-
 	// Get the state hash
-	rootsConcat := bytes.Join(roots, []byte{})
+	rootsConcat := bytes.Join(p.getStateTreeRoots(), []byte{})
 	stateHash := sha256.Sum256(rootsConcat)
 
 	// Convert the array to a slice and return it
 	return hex.EncodeToString(stateHash[:])
 }
 
+// Get the root of each Merkle Tree
+func (p *PostgresContext) getStateTreeRoots() [][]byte {
+	roots := make([][]byte, 0, numMerkleTrees)
+	for tree := merkleTree(0); tree < numMerkleTrees; tree++ {
+		roots = append(roots, p.stateTrees.merkleTrees[tree].Root())
+	}
+	return roots
+}
+
 // Transactions Hash Helpers
 
 // Returns a digest (a single hash) of all the transactions included in the block.
@@ -238,15 +299,23 @@ func (p *PostgresContext) updateActorsTree(actorType coreTypes.ActorType) error
 			return err
 		}
 
-		actorBz, err := codec.GetCodec().Marshal(actor)
-		if err != nil {
-			return err
-		}
-
 		merkleTreeName, ok := actorTypeToMerkleTreeName[actorType]
 		if !ok {
 			return fmt.Errorf("no merkle tree found for actor type: %s", actorType)
 		}
+
+		// The actor was tombstoned after its stake record was moved to a new key
+		if actor.GetPublicKey() == "" {
+			if _, err := p.stateTrees.merkleTrees[merkleTreeName].Delete(bzAddr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		actorBz, err := codec.GetCodec().Marshal(actor)
+		if err != nil {
+			return err
+		}
 		if _, err := p.stateTrees.merkleTrees[merkleTreeName].Update(bzAddr, actorBz); err != nil {
 			return err
 		}
@@ -286,18 +355,47 @@ func (p *PostgresContext) getActorsUpdatedAtHeight(actorType coreTypes.ActorType
 
 // Account Tree Helpers
 
+// The leaf of an account includes its vesting schedule, so accounts whose schedule changed at this
+// height are updated even if their balance did not.
 func (p *PostgresContext) updateAccountTrees() error {
 	accounts, err := p.GetAccountsUpdated(p.Height)
 	if err != nil {
 		return err
 	}
 
+	vestingAddresses, err := p.getVestingAddressesUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+	updatedAddresses := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		updatedAddresses[account.GetAddress()] = struct{}{}
+	}
+	for _, address := range vestingAddresses {
+		if _, ok := updatedAddresses[address]; ok {
+			continue
+		}
+		bzAddr, err := hex.DecodeString(address)
+		if err != nil {
+			return err
+		}
+		amount, err := p.GetAccountAmount(bzAddr, p.Height)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, &coreTypes.Account{Address: address, Amount: amount})
+	}
+
 	for _, account := range accounts {
 		bzAddr, err := hex.DecodeString(account.GetAddress())
 		if err != nil {
 			return err
 		}
 
+		if account.Vesting, err = p.GetAccountVesting(bzAddr, p.Height); err != nil {
+			return err
+		}
+
 		accBz, err := codec.GetCodec().Marshal(account)
 		if err != nil {
 			return err
@@ -392,3 +490,145 @@ func (p *PostgresContext) updateFlagsTree() error {
 
 	return nil
 }
+
+// Governance Tree Helpers
+
+func (p *PostgresContext) updateProposalsTree() error {
+	proposals, err := p.getProposalsUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range proposals {
+		proposalBz, err := codec.GetCodec().Marshal(proposal)
+		if err != nil {
+			return err
+		}
+		proposalKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(proposalKey, proposal.GetId())
+		if _, err := p.stateTrees.merkleTrees[proposalsMerkleTree].Update(proposalKey, proposalBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PostgresContext) updateProposalVotesTree() error {
+	votes, err := p.getProposalVotesUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		voteBz, err := codec.GetCodec().Marshal(vote)
+		if err != nil {
+			return err
+		}
+		// A voter can only have one vote per proposal, so the key is derived from both
+		voteKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(voteKey, vote.GetProposalId())
+		voteKey = crypto.SHA3Hash(append(voteKey, []byte(vote.GetVoter())...))
+		if _, err := p.stateTrees.merkleTrees[proposalVotesMerkleTree].Update(voteKey, voteBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delegation Tree Helpers
+
+func (p *PostgresContext) updateDelegationsTree() error {
+	delegations, err := p.getDelegationsUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		delegationBz, err := codec.GetCodec().Marshal(delegation)
+		if err != nil {
+			return err
+		}
+		// A delegator can only have one delegation per actor, so the key is derived from both and the actor type
+		delegator, err := hex.DecodeString(delegation.GetDelegator())
+		if err != nil {
+			return err
+		}
+		actorAddress, err := hex.DecodeString(delegation.GetActorAddress())
+		if err != nil {
+			return err
+		}
+		delegationKey := crypto.SHA3Hash(append(append(delegator, actorAddress...), byte(delegation.GetActorType())))
+		if _, err := p.stateTrees.merkleTrees[delegationsMerkleTree].Update(delegationKey, delegationBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PostgresContext) updateCommissionsTree() error {
+	commissions, err := p.getCommissionsUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, commission := range commissions {
+		commissionBz, err := codec.GetCodec().Marshal(commission)
+		if err != nil {
+			return err
+		}
+		address, err := hex.DecodeString(commission.GetAddress())
+		if err != nil {
+			return err
+		}
+		// An address can have a commission per actor type it is staked as
+		commissionKey := append(address, byte(commission.GetActorType()))
+		if _, err := p.stateTrees.merkleTrees[commissionsMerkleTree].Update(commissionKey, commissionBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// baseFeeKey is the only key of the base fee tree since a single base fee is in effect at a time
+var baseFeeKey = []byte("base_fee")
+
+func (p *PostgresContext) updateBaseFeeTree() error {
+	baseFee, updated, err := p.getBaseFeeUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+	if _, err := p.stateTrees.merkleTrees[baseFeeMerkleTree].Update(baseFeeKey, []byte(baseFee)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PostgresContext) updateBLSKeysTree() error {
+	blsKeys, err := p.getBLSKeysUpdated(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, blsKey := range blsKeys {
+		blsKeyBz, err := codec.GetCodec().Marshal(blsKey)
+		if err != nil {
+			return err
+		}
+		address, err := hex.DecodeString(blsKey.GetAddress())
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[blsKeysMerkleTree].Update(address, blsKeyBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
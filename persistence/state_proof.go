@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/celestiaorg/smt"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// The roots of the state trees are stored in the block store next to the blocks, since the trees only keep
+// the nodes of their latest version and light clients need the roots of past heights to verify their state.
+const stateTreeRootsKeyPrefix = "state_tree_roots/"
+
+func stateTreeRootsKey(height int64) []byte {
+	return append([]byte(stateTreeRootsKeyPrefix), heightToBytes(height)...)
+}
+
+// Stores the roots of the state trees hashed into the state hash of the block
+func (p PostgresContext) storeStateTreeRoots() error {
+	var rootsConcat []byte
+	for _, root := range p.getStateTreeRoots() {
+		rootsConcat = append(rootsConcat, root...)
+	}
+	return p.blockStore.Set(stateTreeRootsKey(p.Height), rootsConcat)
+}
+
+func (p PostgresContext) GetStateTreeRoots(height int64) ([][]byte, error) {
+	rootsConcat, err := p.blockStore.Get(stateTreeRootsKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if len(rootsConcat) != int(numMerkleTrees)*sha256.Size {
+		return nil, fmt.Errorf("no state tree roots stored at height %d", height)
+	}
+	roots := make([][]byte, 0, numMerkleTrees)
+	for i := 0; i < len(rootsConcat); i += sha256.Size {
+		roots = append(roots, rootsConcat[i:i+sha256.Size])
+	}
+	return roots, nil
+}
+
+// IMPROVE: The orphaned nodes of the trees are deleted when they are updated, so only the latest committed
+// height can be proven. Proofs of past heights need the trees to keep their previous versions.
+func (p PostgresContext) GetAccountProof(address []byte, height int64) (*coreTypes.StateProof, error) {
+	roots, err := p.GetStateTreeRoots(height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := p.stateTrees.merkleTrees[accountMerkleTree].ProveForRoot(address, roots[accountMerkleTree])
+	if err != nil {
+		return nil, err
+	}
+
+	// The leaf is rebuilt from the database the same way `updateAccountTrees` builds it
+	amount, err := p.GetAccountAmount(address, height)
+	if err != nil {
+		return nil, err
+	}
+	vesting, err := p.GetAccountVesting(address, height)
+	if err != nil {
+		return nil, err
+	}
+	accBz, err := codec.GetCodec().Marshal(&coreTypes.Account{
+		Address: hex.EncodeToString(address),
+		Amount:  amount,
+		Vesting: vesting,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The account is absent from the tree if it never held any funds
+	if !smt.VerifyProof(proof, roots[accountMerkleTree], address, accBz, sha256.New()) {
+		if !smt.VerifyProof(proof, roots[accountMerkleTree], address, nil, sha256.New()) {
+			return nil, fmt.Errorf("the account tree changed since height %d", height)
+		}
+		accBz = nil
+	}
+
+	return &coreTypes.StateProof{
+		Height:                uint64(height),
+		StateTreeRoots:        roots,
+		Key:                   address,
+		Value:                 accBz,
+		SideNodes:             proof.SideNodes,
+		NonMembershipLeafData: proof.NonMembershipLeafData,
+		SiblingData:           proof.SiblingData,
+	}, nil
+}
@@ -209,6 +209,44 @@ func TestSubAccountAmount(t *testing.T) {
 	require.Equal(t, expectedAccountAmount, accountAmount, "unexpected amount after sub")
 }
 
+func TestSetAccountVestingAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+	account := newTestAccount(t)
+	addrBz, err := hex.DecodeString(account.Address)
+	require.NoError(t, err)
+
+	vesting, err := db.GetAccountVesting(addrBz, db.Height)
+	require.NoError(t, err)
+	require.Nil(t, vesting, "accounts have no vesting schedule by default")
+
+	schedule := &coreTypes.VestingSchedule{
+		VestingType:    coreTypes.VestingType_VESTING_TYPE_CONTINUOUS,
+		OriginalAmount: DefaultStake,
+		StartHeight:    0,
+		EndHeight:      100,
+	}
+	require.NoError(t, db.SetAccountVesting(addrBz, schedule))
+
+	db.Height = 1
+	updatedSchedule := &coreTypes.VestingSchedule{
+		VestingType:    coreTypes.VestingType_VESTING_TYPE_DELAYED,
+		OriginalAmount: DefaultStake,
+		StartHeight:    0,
+		EndHeight:      200,
+	}
+	require.NoError(t, db.SetAccountVesting(addrBz, updatedSchedule))
+
+	vesting, err = db.GetAccountVesting(addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.VestingType_VESTING_TYPE_CONTINUOUS, vesting.VestingType)
+	require.Equal(t, int64(100), vesting.EndHeight)
+
+	vesting, err = db.GetAccountVesting(addrBz, 1)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.VestingType_VESTING_TYPE_DELAYED, vesting.VestingType)
+	require.Equal(t, int64(200), vesting.EndHeight)
+}
+
 func FuzzPoolAmount(f *testing.F) {
 	db := NewTestPostgresContext(f, 0)
 	operations := []string{
@@ -3,7 +3,9 @@ package test
 import (
 	"testing"
 
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestGetBlockBlockHash(t *testing.T) {
@@ -23,3 +25,35 @@ func TestGetBlockBlockHash(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func TestSetBlockEventsAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	events := []*coreTypes.Event{
+		coreTypes.NewEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, "00404a570febd061274f72b50d0a37f611dfe339"),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyMissedBlocks, "1")),
+	}
+	require.NoError(t, db.SetBlockEvents(events))
+
+	_, err := db.ComputeStateHash()
+	require.NoError(t, err)
+	require.NoError(t, db.Commit([]byte("placeholderProposer"), []byte("placeholderQuorumCert")))
+
+	blockEvents, err := testPersistenceMod.GetBlockEvents(1)
+	require.NoError(t, err)
+	require.Len(t, blockEvents, 1)
+	require.True(t, proto.Equal(events[0], blockEvents[0]))
+
+	// the events are also indexed by type and attribute
+	indexedEvents, err := testPersistenceMod.GetBlockEventsByType(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK,
+		coreTypes.EventAttributeKeyAddress, "00404a570febd061274f72b50d0a37f611dfe339")
+	require.NoError(t, err)
+	require.Len(t, indexedEvents, 1)
+	require.Equal(t, int64(1), indexedEvents[0].GetHeight())
+	require.True(t, proto.Equal(events[0], indexedEvents[0].GetEvent()))
+
+	indexedEvents, err = testPersistenceMod.GetBlockEventsByType(coreTypes.EventType_EVENT_TYPE_REWARD, "", "")
+	require.NoError(t, err)
+	require.Empty(t, indexedEvents)
+}
@@ -0,0 +1,50 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValidatorBLSKeyAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+	address, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	blsKey, err := db.GetValidatorBLSKey(address, 0)
+	require.NoError(t, err)
+	require.Nil(t, blsKey)
+
+	publicKey, proof := newTestBLSKey(t)
+	require.NoError(t, db.SetValidatorBLSKey(address, publicKey, proof))
+
+	blsKey, err = db.GetValidatorBLSKey(address, 0)
+	require.NoError(t, err)
+	require.Equal(t, address.String(), blsKey.GetAddress())
+	require.Equal(t, publicKey, blsKey.GetPublicKey())
+	require.Equal(t, proof, blsKey.GetProofOfPossession())
+
+	// A new key replaces the previous one from the height it is registered at
+	db.Height = 1
+	newPublicKey, newProof := newTestBLSKey(t)
+	require.NoError(t, db.SetValidatorBLSKey(address, newPublicKey, newProof))
+
+	blsKey, err = db.GetValidatorBLSKey(address, 0)
+	require.NoError(t, err)
+	require.Equal(t, publicKey, blsKey.GetPublicKey())
+
+	blsKey, err = db.GetValidatorBLSKey(address, 1)
+	require.NoError(t, err)
+	require.Equal(t, newPublicKey, blsKey.GetPublicKey())
+}
+
+func newTestBLSKey(t *testing.T) (publicKey, proofOfPossession []byte) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	blsKey, err := crypto.NewBLSPrivateKeyFromPrivateKey(privateKey)
+	require.NoError(t, err)
+	proofOfPossession, err = blsKey.ProvePossession()
+	require.NoError(t, err)
+	return blsKey.PublicKey(), proofOfPossession
+}
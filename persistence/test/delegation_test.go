@@ -0,0 +1,156 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDelegationAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+	delegation := newTestDelegation(t)
+	delegator, err := hex.DecodeString(delegation.Delegator)
+	require.NoError(t, err)
+	actorAddress, err := hex.DecodeString(delegation.ActorAddress)
+	require.NoError(t, err)
+
+	exists, err := db.GetDelegationExists(delegator, actorAddress, delegation.ActorType, 0)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, db.SetDelegation(delegation))
+
+	exists, err = db.GetDelegationExists(delegator, actorAddress, delegation.ActorType, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	got, err := db.GetDelegation(delegator, actorAddress, delegation.ActorType, 0)
+	require.NoError(t, err)
+	require.Equal(t, delegation.Amount, got.Amount)
+	require.Equal(t, coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED, got.Status)
+
+	actorDelegations, err := db.GetActorDelegations(actorAddress, delegation.ActorType, 0)
+	require.NoError(t, err)
+	require.Len(t, actorDelegations, 1)
+
+	// the delegations to the same address as another actor type are kept apart
+	actorDelegations, err = db.GetActorDelegations(actorAddress, coreTypes.ActorType_ACTOR_TYPE_SERVICENODE, 0)
+	require.NoError(t, err)
+	require.Empty(t, actorDelegations)
+
+	delegatorDelegations, err := db.GetDelegatorDelegations(delegator, 0)
+	require.NoError(t, err)
+	require.Len(t, delegatorDelegations, 1)
+}
+
+func TestGetDelegationsReadyToWithdraw(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+	delegation := newTestDelegation(t)
+	require.NoError(t, db.SetDelegation(delegation))
+
+	db.Height = 1
+	delegation.Status = coreTypes.DelegationStatus_DELEGATION_STATUS_UNBONDING
+	delegation.UnbondingHeight = 2
+	require.NoError(t, db.SetDelegation(delegation))
+
+	readyToWithdraw, err := db.GetDelegationsReadyToWithdraw(1)
+	require.NoError(t, err)
+	require.Empty(t, readyToWithdraw, "still unbonding")
+
+	db.Height = 2
+	readyToWithdraw, err = db.GetDelegationsReadyToWithdraw(2)
+	require.NoError(t, err)
+	require.Len(t, readyToWithdraw, 1)
+
+	delegation.Status = coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN
+	require.NoError(t, db.SetDelegation(delegation))
+
+	readyToWithdraw, err = db.GetDelegationsReadyToWithdraw(2)
+	require.NoError(t, err)
+	require.Empty(t, readyToWithdraw, "already withdrawn")
+
+	actorAddress, err := hex.DecodeString(delegation.ActorAddress)
+	require.NoError(t, err)
+	actorDelegations, err := db.GetActorDelegations(actorAddress, delegation.ActorType, 2)
+	require.NoError(t, err)
+	require.Empty(t, actorDelegations)
+
+	// the delegation is still bonded at the previous height
+	actorDelegations, err = db.GetActorDelegations(actorAddress, delegation.ActorType, 0)
+	require.NoError(t, err)
+	require.Len(t, actorDelegations, 1)
+	require.Equal(t, coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED, actorDelegations[0].Status)
+}
+
+func TestSetCommissionAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+	address, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	commission, err := db.GetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), commission)
+
+	require.NoError(t, db.SetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 5))
+
+	db.Height = 1
+	require.NoError(t, db.SetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 15))
+
+	commission, err = db.GetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), commission)
+
+	commission, err = db.GetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(15), commission)
+
+	commission, err = db.GetCommission(address, coreTypes.ActorType_ACTOR_TYPE_SERVICENODE, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), commission, "the commission is set per actor type")
+}
+
+func TestCopyCommissionHistory(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+	address, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	newAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	require.NoError(t, db.SetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 5))
+	db.Height = 1
+	require.NoError(t, db.SetCommission(address, coreTypes.ActorType_ACTOR_TYPE_VAL, 15))
+	require.NoError(t, db.SetCommission(address, coreTypes.ActorType_ACTOR_TYPE_SERVICENODE, 20))
+
+	db.Height = 2
+	require.NoError(t, db.CopyCommissionHistory(address, newAddress, coreTypes.ActorType_ACTOR_TYPE_VAL))
+
+	commission, err := db.GetCommission(newAddress, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), commission, "the commission history is not copied")
+
+	commission, err = db.GetCommission(newAddress, coreTypes.ActorType_ACTOR_TYPE_VAL, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(15), commission)
+
+	commission, err = db.GetCommission(newAddress, coreTypes.ActorType_ACTOR_TYPE_SERVICENODE, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), commission, "only the commission history of the actor type is copied")
+}
+
+func newTestDelegation(t *testing.T) *coreTypes.Delegation {
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	actorAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	return &coreTypes.Delegation{
+		Delegator:       hex.EncodeToString(delegator),
+		ActorAddress:    hex.EncodeToString(actorAddress),
+		ActorType:       coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Amount:          DefaultStake,
+		Status:          coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED,
+		UnbondingHeight: -1,
+	}
+}
@@ -0,0 +1,33 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBaseFeeAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	baseFee, err := db.GetBaseFee(0)
+	require.NoError(t, err)
+	require.Empty(t, baseFee, "the base fee defaults to empty if it was never computed")
+
+	require.NoError(t, db.SetBaseFee("10000"))
+
+	db.Height = 1
+	require.NoError(t, db.SetBaseFee("11250"))
+
+	baseFee, err = db.GetBaseFee(0)
+	require.NoError(t, err)
+	require.Equal(t, "10000", baseFee)
+
+	baseFee, err = db.GetBaseFee(1)
+	require.NoError(t, err)
+	require.Equal(t, "11250", baseFee)
+
+	// the latest base fee carries over to the heights without a new one
+	baseFee, err = db.GetBaseFee(5)
+	require.NoError(t, err)
+	require.Equal(t, "11250", baseFee)
+}
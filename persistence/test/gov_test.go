@@ -191,3 +191,38 @@ func TestGetSetToggleByteArrayFlag(t *testing.T) {
 	require.Equal(t, true, enabled)
 
 }
+
+func TestGetFlagExistsAndGetAllFlags(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	exists, err := db.GetFlagExists(AppMaxChainsParamName, 0)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, db.SetFlag(AppMaxChainsParamName, 10, true))
+	db.Height = 1
+	require.NoError(t, db.SetFlag(AppMaxChainsParamName, 11, true))
+	require.NoError(t, db.SetFlag(ServiceNodeMinimumStakeParamName, "100", false))
+
+	exists, err = db.GetFlagExists(AppMaxChainsParamName, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = db.GetFlagExists(ServiceNodeMinimumStakeParamName, 0)
+	require.NoError(t, err)
+	require.False(t, exists, "the flag was set at a later height")
+
+	flags, err := db.GetAllFlags(0)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	require.Equal(t, AppMaxChainsParamName, flags[0].Name)
+	require.Equal(t, "10", flags[0].Value)
+
+	// only the latest value of every flag is returned
+	flags, err = db.GetAllFlags(1)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	require.Equal(t, AppMaxChainsParamName, flags[0].Name)
+	require.Equal(t, "11", flags[0].Value)
+	require.Equal(t, ServiceNodeMinimumStakeParamName, flags[1].Name)
+}
@@ -0,0 +1,105 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertProposalAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	proposalId, err := db.GetNextProposalId(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proposalId)
+
+	proposal := newTestProposal(t, proposalId, 10)
+	require.NoError(t, db.InsertProposal(proposal))
+
+	got, err := db.GetProposal(proposalId, 0)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Proposer, got.Proposer)
+	require.Equal(t, proposal.Key, got.Key)
+	require.Equal(t, proposal.VotingEndHeight, got.VotingEndHeight)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, got.Status)
+
+	nextProposalId, err := db.GetNextProposalId(0)
+	require.NoError(t, err)
+	require.Equal(t, proposalId+1, nextProposalId)
+
+	proposals, err := db.GetAllProposals(0)
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+}
+
+func TestSetProposalStatusAtHeight(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	proposal := newTestProposal(t, 1, 1)
+	require.NoError(t, db.InsertProposal(proposal))
+
+	proposalsEnding, err := db.GetProposalsEndingAtHeight(1, 0)
+	require.NoError(t, err)
+	require.Len(t, proposalsEnding, 1)
+
+	db.Height = 1
+	require.NoError(t, db.SetProposalStatus(proposal.Id, coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED))
+
+	// the status at the previous height is unchanged
+	proposalAtPrevHeight, err := db.GetProposal(proposal.Id, 0)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, proposalAtPrevHeight.Status)
+
+	proposalAtHeight, err := db.GetProposal(proposal.Id, 1)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED, proposalAtHeight.Status)
+
+	// proposals that are no longer being voted on are not tallied again
+	proposalsEnding, err = db.GetProposalsEndingAtHeight(1, 1)
+	require.NoError(t, err)
+	require.Empty(t, proposalsEnding)
+}
+
+func TestInsertProposalVoteAndGet(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	proposal := newTestProposal(t, 1, 10)
+	require.NoError(t, db.InsertProposal(proposal))
+
+	voter, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	require.NoError(t, db.InsertProposalVote(proposal.Id, voter, coreTypes.VoteOption_VOTE_OPTION_NO))
+
+	// only the latest vote of a voter is returned
+	db.Height = 1
+	require.NoError(t, db.InsertProposalVote(proposal.Id, voter, coreTypes.VoteOption_VOTE_OPTION_YES))
+
+	votesAtPrevHeight, err := db.GetProposalVotes(proposal.Id, 0)
+	require.NoError(t, err)
+	require.Len(t, votesAtPrevHeight, 1)
+	require.Equal(t, coreTypes.VoteOption_VOTE_OPTION_NO, votesAtPrevHeight[0].Option)
+
+	votes, err := db.GetProposalVotes(proposal.Id, 1)
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+	require.Equal(t, hex.EncodeToString(voter), votes[0].Voter)
+	require.Equal(t, coreTypes.VoteOption_VOTE_OPTION_YES, votes[0].Option)
+	require.Equal(t, int64(1), votes[0].Height)
+}
+
+func newTestProposal(t *testing.T, id uint64, votingEndHeight int64) *coreTypes.Proposal {
+	proposer, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	return &coreTypes.Proposal{
+		Id:              id,
+		Proposer:        hex.EncodeToString(proposer),
+		ProposalType:    coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE,
+		Key:             "blocks_per_session",
+		Deposit:         DefaultStake,
+		VotingEndHeight: votingEndHeight,
+		Status:          coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}
+}
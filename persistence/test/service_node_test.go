@@ -220,6 +220,38 @@ func TestGetServiceNodeOutputAddress(t *testing.T) {
 	require.Equal(t, serviceNode.Output, hex.EncodeToString(output), "unexpected output address")
 }
 
+func TestRotateServiceNodeKey(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	serviceNode, err := createAndInsertDefaultTestServiceNode(db)
+	require.NoError(t, err)
+
+	addrBz, err := hex.DecodeString(serviceNode.Address)
+	require.NoError(t, err)
+
+	newKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	db.Height = 1
+
+	err = db.RotateServiceNodeKey(addrBz, newKey.Address(), newKey.Bytes())
+	require.NoError(t, err)
+
+	exists, err := db.GetServiceNodeExists(addrBz, 1)
+	require.NoError(t, err)
+	require.False(t, exists, "rotated actor should not exist at current height")
+
+	_, publicKey, stakedTokens, _, _, _, _, chains, err := db.GetServiceNode(newKey.Address(), 1)
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(newKey.Bytes()), publicKey, "public key not rotated")
+	require.Equal(t, DefaultStake, stakedTokens, "stake not moved")
+	require.Equal(t, DefaultChains, chains, "chains not moved")
+
+	_, _, _, _, _, _, _, chains, err = db.GetServiceNode(addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultChains, chains, "chains should not change at previous height")
+}
+
 func newTestServiceNode() (*coreTypes.Actor, error) {
 	operatorKey, err := crypto.GeneratePublicKey()
 	if err != nil {
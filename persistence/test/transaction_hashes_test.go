@@ -0,0 +1,40 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertTransactionHashAndPrune(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+	txHash := "0a1b2c3d"
+
+	exists, err := db.GetTransactionHashExists(txHash, 1)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, db.InsertTransactionHash(txHash, 3))
+
+	exists, err = db.GetTransactionHashExists(txHash, 1)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = db.GetTransactionHashExists(txHash, 0)
+	require.NoError(t, err)
+	require.False(t, exists, "the transaction was committed after height 0")
+
+	exists, err = db.GetTransactionHashExists(txHash, 4)
+	require.NoError(t, err)
+	require.False(t, exists, "the transaction is not valid after its max height")
+
+	require.NoError(t, db.PruneTransactionHashes(2))
+	exists, err = db.GetTransactionHashExists(txHash, 3)
+	require.NoError(t, err)
+	require.True(t, exists, "the transaction can still be included at its max height")
+
+	require.NoError(t, db.PruneTransactionHashes(3))
+	exists, err = db.GetTransactionHashExists(txHash, 3)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -218,6 +218,87 @@ func TestGetValidatorOutputAddress(t *testing.T) {
 	require.Equal(t, validator.Output, hex.EncodeToString(output), "unexpected output address")
 }
 
+func TestRotateValidatorKey(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	validator, err := createAndInsertDefaultTestValidator(db)
+	require.NoError(t, err)
+
+	addrBz, err := hex.DecodeString(validator.Address)
+	require.NoError(t, err)
+
+	publicKey, proof := newTestBLSKey(t)
+	require.NoError(t, db.SetValidatorBLSKey(addrBz, publicKey, proof))
+
+	newKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	db.Height = 1
+
+	err = db.RotateValidatorKey(addrBz, newKey.Address(), newKey.Bytes())
+	require.NoError(t, err)
+
+	// the BLS key derived from the old key is cleared and the new key has to register its own
+	blsKey, err := db.GetValidatorBLSKey(addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, publicKey, blsKey.GetPublicKey())
+	blsKey, err = db.GetValidatorBLSKey(addrBz, 1)
+	require.NoError(t, err)
+	require.Nil(t, blsKey, "BLS key of the rotated key should be cleared")
+	blsKey, err = db.GetValidatorBLSKey(newKey.Address(), 1)
+	require.NoError(t, err)
+	require.Nil(t, blsKey, "BLS key should not be moved to the new key")
+
+	// the old key is a validator until the rotation height only
+	exists, err := db.GetValidatorExists(addrBz, 0)
+	require.NoError(t, err)
+	require.True(t, exists, "actor that should exist at previous height does not")
+	exists, err = db.GetValidatorExists(addrBz, 1)
+	require.NoError(t, err)
+	require.False(t, exists, "rotated actor should not exist at current height")
+	exists, err = db.GetValidatorExists(newKey.Address(), 1)
+	require.NoError(t, err)
+	require.True(t, exists, "actor that should exist at its new address does not")
+
+	rotatedValidator, err := getTestValidator(db, newKey.Address())
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(newKey.Bytes()), rotatedValidator.PublicKey, "public key not rotated")
+	require.Equal(t, validator.StakedAmount, rotatedValidator.StakedAmount, "stake not moved")
+	require.Equal(t, validator.GenericParam, rotatedValidator.GenericParam, "service url not moved")
+	require.Equal(t, validator.Output, rotatedValidator.Output, "output address not moved")
+
+	validators, err := db.GetAllValidators(1)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, newKey.Address().String(), validators[0].Address)
+}
+
+func TestSetValidatorOutputAddress(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	validator, err := createAndInsertDefaultTestValidator(db)
+	require.NoError(t, err)
+
+	addrBz, err := hex.DecodeString(validator.Address)
+	require.NoError(t, err)
+
+	newOutput, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	db.Height = 1
+
+	err = db.SetValidatorOutputAddress(addrBz, newOutput)
+	require.NoError(t, err)
+
+	output, err := db.GetValidatorOutputAddress(addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, validator.Output, hex.EncodeToString(output), "output address should not change at previous height")
+
+	output, err = db.GetValidatorOutputAddress(addrBz, 1)
+	require.NoError(t, err)
+	require.Equal(t, newOutput, crypto.Address(output), "output address not updated at current height")
+}
+
 func newTestValidator() (*coreTypes.Actor, error) {
 	operatorKey, err := crypto.GeneratePublicKey()
 	if err != nil {
@@ -0,0 +1,39 @@
+package persistence
+
+import (
+	"github.com/pokt-network/pocket/persistence/types"
+)
+
+func (p PostgresContext) InsertTransactionHash(transactionHash string, maxHeight int64) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	height, err := p.GetHeight()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.InsertTransactionHashQuery(transactionHash, maxHeight, height))
+	return err
+}
+
+// PruneTransactionHashes forgets the hashes of the transactions whose max height is at or below `height`
+func (p PostgresContext) PruneTransactionHashes(height int64) error {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, types.PruneTransactionHashesQuery(height))
+	return err
+}
+
+// GetTransactionHashExists returns whether a transaction was committed at or before `height` and
+// would still be valid at `height`
+func (p PostgresContext) GetTransactionHashExists(transactionHash string, height int64) (exists bool, err error) {
+	ctx, tx, err := p.getCtxAndTx()
+	if err != nil {
+		return false, err
+	}
+	err = tx.QueryRow(ctx, types.GetTransactionHashExistsQuery(transactionHash, height)).Scan(&exists)
+	return
+}
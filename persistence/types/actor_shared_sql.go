@@ -83,12 +83,16 @@ func Select(selector, address string, height int64, tableName string) string {
 		selector, tableName, address, height)
 }
 
+// The actors whose stake record was moved to a new address (i.e. whose key was rotated) are tombstoned
+// with an empty public key, and are excluded from the queries below.
 func SelectActors(actorSpecificParam string, height int64, tableName string) string {
 	return fmt.Sprintf(`
-			SELECT DISTINCT ON (address) address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height
-			FROM %s
-			WHERE height<=%d
-			ORDER BY address, height DESC
+			SELECT * FROM (
+				SELECT DISTINCT ON (address) address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height
+				FROM %s
+				WHERE height<=%d
+				ORDER BY address, height DESC
+			) AS latest WHERE public_key<>''
        `, actorSpecificParam, tableName, height)
 }
 
@@ -98,7 +102,8 @@ func selectChains(selector, address string, height int64, actorTableName, chains
 }
 
 func Exists(address string, height int64, tableName string) string {
-	return fmt.Sprintf(`SELECT EXISTS(%s)`, Select(AnyValueSelector, address, height, tableName))
+	return fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM (%s) AS latest WHERE public_key<>'')`,
+		Select(AllColsSelector, address, height, tableName))
 }
 
 // Explainer:
@@ -237,6 +242,72 @@ func updateUnstakedHeightIfPausedBefore(actorSpecificParam string, unstakingHeig
 		constraintName)
 }
 
+// Copies the latest record of the actor at `address` to `newAddress` with `newPublicKey`. The record
+// is upserted in full since `newAddress` may have a tombstone at the same height.
+func moveActor(address, newAddress, newPublicKey, actorSpecificParam string, height int64, tableName, constraintName string) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		(
+			SELECT '%s', '%s', staked_tokens, %s, output_address, paused_height, unstaking_height, %d
+			FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
+		)
+		ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET public_key=EXCLUDED.public_key, staked_tokens=EXCLUDED.staked_tokens, %s=EXCLUDED.%s,
+						  output_address=EXCLUDED.output_address, paused_height=EXCLUDED.paused_height,
+						  unstaking_height=EXCLUDED.unstaking_height, height=EXCLUDED.height`,
+		tableName, actorSpecificParam,
+		newAddress, newPublicKey, actorSpecificParam, height,
+		tableName, address, height,
+		constraintName,
+		actorSpecificParam, actorSpecificParam)
+}
+
+func moveChains(address, newAddress string, height int64, actorTableName, chainsTableName, constraintName string) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (address, chain_id, height)
+		(
+			SELECT '%s', chain_id, %d FROM %s WHERE address='%s' AND height=(%s)
+		)
+		ON CONFLICT ON CONSTRAINT %s DO NOTHING`,
+		chainsTableName,
+		newAddress, height, chainsTableName, address, Select(HeightCol, address, height, actorTableName),
+		constraintName)
+}
+
+// Tombstones the actor at `address` after its record was moved: the tombstone is unstaked, holds no
+// tokens and has no public key.
+func tombstoneActor(address, actorSpecificParam string, height int64, tableName, constraintName string) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		(
+			SELECT address, '', '0', %s, output_address, %d, 0, %d
+			FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
+		)
+		ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET public_key=EXCLUDED.public_key, staked_tokens=EXCLUDED.staked_tokens,
+						  paused_height=EXCLUDED.paused_height, unstaking_height=EXCLUDED.unstaking_height,
+						  height=EXCLUDED.height`,
+		tableName, actorSpecificParam,
+		actorSpecificParam, DefaultBigInt, height,
+		tableName, address, height,
+		constraintName)
+}
+
+func updateOutputAddress(address, actorSpecificParam, outputAddress string, height int64, tableName, constraintName string) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		(
+			SELECT address, public_key, staked_tokens, %s, '%s', paused_height, unstaking_height, %d
+			FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
+		)
+		ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET output_address=EXCLUDED.output_address, height=EXCLUDED.height`,
+		tableName, actorSpecificParam,
+		actorSpecificParam, outputAddress, height,
+		tableName, address, height,
+		constraintName)
+}
+
 func NullifyChains(address string, height int64, tableName string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE address='%s' AND height=%d", tableName, address, height)
 }
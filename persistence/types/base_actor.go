@@ -131,6 +131,22 @@ func (actor *BaseProtocolActorSchema) SetStakeAmountQuery(address string, staked
 	return updateStakeAmount(address, actor.actorSpecificColName, stakedTokens, height, actor.tableName, actor.heightConstraintName)
 }
 
+func (actor *BaseProtocolActorSchema) MoveQuery(address, newAddress, newPublicKey string, height int64) string {
+	return moveActor(address, newAddress, newPublicKey, actor.actorSpecificColName, height, actor.tableName, actor.heightConstraintName)
+}
+
+func (actor *BaseProtocolActorSchema) MoveChainsQuery(address, newAddress string, height int64) string {
+	return moveChains(address, newAddress, height, actor.tableName, actor.chainsTableName, actor.chainsHeightConstraintName)
+}
+
+func (actor *BaseProtocolActorSchema) TombstoneQuery(address string, height int64) string {
+	return tombstoneActor(address, actor.actorSpecificColName, height, actor.tableName, actor.heightConstraintName)
+}
+
+func (actor *BaseProtocolActorSchema) UpdateOutputAddressQuery(address, outputAddress string, height int64) string {
+	return updateOutputAddress(address, actor.actorSpecificColName, outputAddress, height, actor.tableName, actor.heightConstraintName)
+}
+
 func (actor *BaseProtocolActorSchema) ClearAllQuery() string {
 	return ClearAll(actor.tableName)
 }
@@ -0,0 +1,41 @@
+package types
+
+// CLEANUP: Move SQL specific business logic into a `sql` package under `persistence`
+
+import "fmt"
+
+const (
+	BLSKeysTableName        = "bls_keys"
+	BLSKeysHeightConstraint = "bls_keys_create_height"
+	BLSKeysTableSchema      = `(
+			address             TEXT NOT NULL,
+			public_key          TEXT NOT NULL,
+			proof_of_possession TEXT NOT NULL,
+			height              BIGINT NOT NULL,
+
+			CONSTRAINT bls_keys_create_height UNIQUE (address, height)
+		)`
+)
+
+func InsertBLSKeyQuery(address, publicKey, proofOfPossession string, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (address, public_key, proof_of_possession, height)
+			VALUES ('%s', '%s', '%s', %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET public_key=EXCLUDED.public_key, proof_of_possession=EXCLUDED.proof_of_possession
+		`, BLSKeysTableName, address, publicKey, proofOfPossession, height, BLSKeysHeightConstraint)
+}
+
+func GetBLSKeyQuery(address string, height int64) string {
+	return fmt.Sprintf(`SELECT public_key, proof_of_possession FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1`,
+		BLSKeysTableName, address, height)
+}
+
+func GetBLSKeysUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT address, public_key, proof_of_possession FROM %s WHERE height=%d ORDER BY address ASC`,
+		BLSKeysTableName, height)
+}
+
+func ClearAllBLSKeysQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, BLSKeysTableName)
+}
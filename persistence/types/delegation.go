@@ -0,0 +1,124 @@
+package types
+
+// CLEANUP: Move SQL specific business logic into a `sql` package under `persistence`
+
+import "fmt"
+
+// IMPROVE: Like proposals, the delegation is stored as a hex encoded protobuf alongside the few
+// columns that need to be queried.
+const (
+	DelegationsTableName        = "delegations"
+	DelegationsHeightConstraint = "delegations_create_height"
+	DelegationsTableSchema      = `(
+			delegator        TEXT NOT NULL,
+			actor_address    TEXT NOT NULL,
+			actor_type       SMALLINT NOT NULL,
+			status           SMALLINT NOT NULL,
+			unbonding_height BIGINT NOT NULL,
+			delegation       TEXT NOT NULL,
+			height           BIGINT NOT NULL,
+
+			CONSTRAINT delegations_create_height UNIQUE (delegator, actor_address, actor_type, height)
+		)`
+
+	CommissionsTableName        = "commissions"
+	CommissionsHeightConstraint = "commissions_create_height"
+	CommissionsTableSchema      = `(
+			address    TEXT NOT NULL,
+			actor_type SMALLINT NOT NULL,
+			percentage BIGINT NOT NULL,
+			height     BIGINT NOT NULL,
+
+			CONSTRAINT commissions_create_height UNIQUE (address, actor_type, height)
+		)`
+)
+
+func InsertDelegationQuery(delegator, actorAddress string, actorType, status int32, unbondingHeight int64, delegation string, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (delegator, actor_address, actor_type, status, unbonding_height, delegation, height)
+			VALUES ('%s', '%s', %d, %d, %d, '%s', %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET status=EXCLUDED.status, unbonding_height=EXCLUDED.unbonding_height, delegation=EXCLUDED.delegation
+		`, DelegationsTableName, delegator, actorAddress, actorType, status, unbondingHeight, delegation, height, DelegationsHeightConstraint)
+}
+
+func GetDelegationQuery(delegator, actorAddress string, actorType int32, height int64) string {
+	return fmt.Sprintf(`SELECT delegation FROM %s WHERE delegator='%s' AND actor_address='%s' AND actor_type=%d AND height<=%d ORDER BY height DESC LIMIT 1`,
+		DelegationsTableName, delegator, actorAddress, actorType, height)
+}
+
+func GetDelegationExistsQuery(delegator, actorAddress string, actorType int32, height int64) string {
+	return fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE delegator='%s' AND actor_address='%s' AND actor_type=%d AND height<=%d)`,
+		DelegationsTableName, delegator, actorAddress, actorType, height)
+}
+
+func selectLatestDelegations(height int64) string {
+	return fmt.Sprintf(`
+			SELECT DISTINCT ON (delegator, actor_address, actor_type) delegator, actor_address, actor_type, status, unbonding_height, delegation
+			FROM %s
+			WHERE height<=%d
+			ORDER BY delegator ASC, actor_address ASC, actor_type ASC, height DESC
+		`, DelegationsTableName, height)
+}
+
+// GetActorDelegationsQuery returns the query for the delegations to an actor that have not been
+// withdrawn yet; i.e. the delegations that are either bonded or unbonding.
+func GetActorDelegationsQuery(actorAddress string, actorType, withdrawnStatus int32, height int64) string {
+	return fmt.Sprintf(`SELECT delegation FROM (%s) AS latest WHERE actor_address='%s' AND actor_type=%d AND status!=%d ORDER BY delegator ASC`,
+		selectLatestDelegations(height), actorAddress, actorType, withdrawnStatus)
+}
+
+func GetDelegatorDelegationsQuery(delegator string, withdrawnStatus int32, height int64) string {
+	return fmt.Sprintf(`SELECT delegation FROM (%s) AS latest WHERE delegator='%s' AND status!=%d ORDER BY actor_address ASC, actor_type ASC`,
+		selectLatestDelegations(height), delegator, withdrawnStatus)
+}
+
+func GetDelegationsReadyToWithdrawQuery(unbondingStatus int32, height int64) string {
+	return fmt.Sprintf(`SELECT delegation FROM (%s) AS latest WHERE status=%d AND unbonding_height<=%d ORDER BY delegator ASC, actor_address ASC, actor_type ASC`,
+		selectLatestDelegations(height), unbondingStatus, height)
+}
+
+func GetDelegationsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT delegation FROM %s WHERE height=%d ORDER BY delegator ASC, actor_address ASC, actor_type ASC`,
+		DelegationsTableName, height)
+}
+
+func InsertCommissionQuery(address string, actorType int32, percentage uint32, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (address, actor_type, percentage, height)
+			VALUES ('%s', %d, %d, %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET percentage=EXCLUDED.percentage
+		`, CommissionsTableName, address, actorType, percentage, height, CommissionsHeightConstraint)
+}
+
+// CopyCommissionHistoryQuery returns the query copying the commissions set by an actor up to `height` to
+// `newAddress`, so the commission history of the actor is kept when its operator key is rotated.
+func CopyCommissionHistoryQuery(address, newAddress string, actorType int32, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (address, actor_type, percentage, height)
+			SELECT '%s', actor_type, percentage, height FROM %s WHERE address='%s' AND actor_type=%d AND height<=%d
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET percentage=EXCLUDED.percentage
+		`, CommissionsTableName, newAddress, CommissionsTableName, address, actorType, height, CommissionsHeightConstraint)
+}
+
+// GetCommissionQuery returns the query for the commission percentage of an actor, which defaults
+// to zero if the actor never set one.
+func GetCommissionQuery(address string, actorType int32, height int64) string {
+	return fmt.Sprintf(`SELECT COALESCE((SELECT percentage FROM %s WHERE address='%s' AND actor_type=%d AND height<=%d ORDER BY height DESC LIMIT 1), 0)`,
+		CommissionsTableName, address, actorType, height)
+}
+
+func GetCommissionsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT address, actor_type, percentage FROM %s WHERE height=%d ORDER BY address ASC, actor_type ASC`,
+		CommissionsTableName, height)
+}
+
+func ClearAllDelegationsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, DelegationsTableName)
+}
+
+func ClearAllCommissionsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, CommissionsTableName)
+}
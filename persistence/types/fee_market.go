@@ -0,0 +1,42 @@
+package types
+
+// CLEANUP: Move SQL specific business logic into a `sql` package under `persistence`
+
+import "fmt"
+
+// The base fee of the dynamic fee market is recomputed by the utility module at the end of every
+// block; the value stored at height H is the base fee charged to the transactions of block H+1.
+const (
+	BaseFeesTableName        = "base_fees"
+	BaseFeesHeightConstraint = "base_fees_height"
+	BaseFeesTableSchema      = `(
+			base_fee TEXT NOT NULL,
+			height   BIGINT NOT NULL,
+
+			CONSTRAINT base_fees_height UNIQUE (height)
+		)`
+)
+
+func InsertBaseFeeQuery(baseFee string, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (base_fee, height)
+			VALUES ('%s', %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET base_fee=EXCLUDED.base_fee
+		`, BaseFeesTableName, baseFee, height, BaseFeesHeightConstraint)
+}
+
+// GetBaseFeeQuery returns the query for the latest base fee, which defaults to an empty string if
+// the dynamic fee market never computed one.
+func GetBaseFeeQuery(height int64) string {
+	return fmt.Sprintf(`SELECT COALESCE((SELECT base_fee FROM %s WHERE height<=%d ORDER BY height DESC LIMIT 1), '')`,
+		BaseFeesTableName, height)
+}
+
+func GetBaseFeeUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT base_fee FROM %s WHERE height=%d`, BaseFeesTableName, height)
+}
+
+func ClearAllBaseFeesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, BaseFeesTableName)
+}
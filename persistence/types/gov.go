@@ -108,6 +108,16 @@ func GetParamOrFlagQuery(tableName, flagName string, height int64) string {
 	return fmt.Sprintf(`SELECT %s FROM %s WHERE name='%s' AND height<=%d ORDER BY height DESC LIMIT 1`, fields, tableName, flagName, height)
 }
 
+func GetFlagExistsQuery(flagName string, height int64) string {
+	return fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE name='%s' AND height<=%d)`, FlagsTableName, flagName, height)
+}
+
+// GetAllFlagsQuery returns the query for the latest value of every flag at the given height
+func GetAllFlagsQuery(height int64) string {
+	return fmt.Sprintf(`SELECT DISTINCT ON (name) name,value,enabled FROM %s WHERE height<=%d ORDER BY name ASC, height DESC`,
+		FlagsTableName, height)
+}
+
 // SupportedParamTypes represents the types currently supported for the `value` property in params and flags
 type SupportedParamTypes interface {
 	int | int32 | int64 | []byte | string
@@ -131,7 +131,45 @@ func TestInsertParams(t *testing.T) {
 				"('message_unstake_service_node_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_pause_service_node_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_unpause_service_node_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
-				"('message_change_parameter_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45') " +
+				"('message_change_parameter_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_min_deposit', -1, 'STRING', '10000000000')," +
+				"('governance_voting_period_blocks', -1, 'BIGINT', 100)," +
+				"('governance_quorum_percentage', -1, 'SMALLINT', 33)," +
+				"('governance_pass_threshold_percentage', -1, 'SMALLINT', 50)," +
+				"('message_submit_proposal_fee', -1, 'STRING', '10000')," +
+				"('message_vote_fee', -1, 'STRING', '10000')," +
+				"('governance_min_deposit_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_voting_period_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_quorum_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_pass_threshold_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_submit_proposal_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_vote_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_upgrade_plan_fee', -1, 'STRING', '10000')," +
+				"('message_upgrade_plan_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_delegate_fee', -1, 'STRING', '10000')," +
+				"('message_undelegate_fee', -1, 'STRING', '10000')," +
+				"('message_set_commission_fee', -1, 'STRING', '10000')," +
+				"('message_delegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_undelegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_set_commission_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('fee_market_target_block_size_bytes', -1, 'BIGINT', 500000)," +
+				"('fee_market_base_fee_change_denominator', -1, 'SMALLINT', 8)," +
+				"('fee_market_min_base_fee', -1, 'STRING', '10000')," +
+				"('fee_market_target_block_size_bytes_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('fee_market_base_fee_change_denominator_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('fee_market_min_base_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('transaction_max_validity_blocks', -1, 'BIGINT', 120)," +
+				"('transaction_max_validity_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_rotate_key_fee', -1, 'STRING', '10000')," +
+				"('message_change_output_address_fee', -1, 'STRING', '10000')," +
+				"('message_rotate_key_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_change_output_address_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_register_bls_key_fee', -1, 'STRING', '10000')," +
+				"('message_register_bls_key_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('commission_max_change_percentage', -1, 'SMALLINT', 10)," +
+				"('commission_change_period_blocks', -1, 'BIGINT', 100)," +
+				"('commission_max_change_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('commission_change_period_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45') " +
 				"ON CONFLICT ON CONSTRAINT params_pkey DO UPDATE SET value=EXCLUDED.value, type=EXCLUDED.type",
 		},
 	}
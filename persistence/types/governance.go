@@ -0,0 +1,106 @@
+package types
+
+// CLEANUP: Move SQL specific business logic into a `sql` package under `persistence`
+
+import "fmt"
+
+// IMPROVE: The proposal is stored as a hex encoded protobuf alongside the few columns that need
+// to be queried. Consider normalizing the remaining fields into columns if they need to be queried.
+const (
+	ProposalsTableName        = "proposals"
+	ProposalsHeightConstraint = "proposals_create_height"
+	ProposalsTableSchema      = `(
+			id                BIGINT NOT NULL,
+			status            SMALLINT NOT NULL,
+			voting_end_height BIGINT NOT NULL,
+			proposal          TEXT NOT NULL,
+			height            BIGINT NOT NULL,
+
+			CONSTRAINT proposals_create_height UNIQUE (id, height)
+		)`
+
+	ProposalVotesTableName        = "proposal_votes"
+	ProposalVotesHeightConstraint = "proposal_votes_create_height"
+	ProposalVotesTableSchema      = `(
+			proposal_id BIGINT NOT NULL,
+			voter       TEXT NOT NULL,
+			option      SMALLINT NOT NULL,
+			height      BIGINT NOT NULL,
+
+			CONSTRAINT proposal_votes_create_height UNIQUE (proposal_id, voter, height)
+		)`
+)
+
+func InsertProposalQuery(id uint64, status int32, votingEndHeight int64, proposal string, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (id, status, voting_end_height, proposal, height)
+			VALUES (%d, %d, %d, '%s', %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET status=EXCLUDED.status, voting_end_height=EXCLUDED.voting_end_height, proposal=EXCLUDED.proposal
+		`, ProposalsTableName, id, status, votingEndHeight, proposal, height, ProposalsHeightConstraint)
+}
+
+func GetProposalQuery(id uint64, height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM %s WHERE id=%d AND height<=%d ORDER BY height DESC LIMIT 1`,
+		ProposalsTableName, id, height)
+}
+
+// GetNextProposalIdQuery returns the query for the id of the next proposal to be submitted; ids start at 1
+func GetNextProposalIdQuery(height int64) string {
+	return fmt.Sprintf(`SELECT COALESCE(MAX(id), 0) + 1 FROM %s WHERE height<=%d`, ProposalsTableName, height)
+}
+
+func selectLatestProposals(height int64) string {
+	return fmt.Sprintf(`
+			SELECT DISTINCT ON (id) id, status, voting_end_height, proposal
+			FROM %s
+			WHERE height<=%d
+			ORDER BY id ASC, height DESC
+		`, ProposalsTableName, height)
+}
+
+func GetAllProposalsQuery(height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM (%s) AS latest ORDER BY id ASC`, selectLatestProposals(height))
+}
+
+func GetProposalsEndingAtHeightQuery(votingEndHeight int64, status int32, height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM (%s) AS latest WHERE voting_end_height=%d AND status=%d ORDER BY id ASC`,
+		selectLatestProposals(height), votingEndHeight, status)
+}
+
+func GetProposalsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM %s WHERE height=%d ORDER BY id ASC`, ProposalsTableName, height)
+}
+
+func InsertProposalVoteQuery(proposalId uint64, voter string, option int32, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (proposal_id, voter, option, height)
+			VALUES (%d, '%s', %d, %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET option=EXCLUDED.option
+		`, ProposalVotesTableName, proposalId, voter, option, height, ProposalVotesHeightConstraint)
+}
+
+// GetProposalVotesQuery returns the query for the latest vote of every voter on a proposal, since
+// voters are allowed to change their vote during the voting period.
+func GetProposalVotesQuery(proposalId uint64, height int64) string {
+	return fmt.Sprintf(`
+			SELECT DISTINCT ON (voter) proposal_id, voter, option, height
+			FROM %s
+			WHERE proposal_id=%d AND height<=%d
+			ORDER BY voter ASC, height DESC
+		`, ProposalVotesTableName, proposalId, height)
+}
+
+func GetProposalVotesUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT proposal_id, voter, option, height FROM %s WHERE height=%d ORDER BY proposal_id ASC, voter ASC`,
+		ProposalVotesTableName, height)
+}
+
+func ClearAllProposalsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, ProposalsTableName)
+}
+
+func ClearAllProposalVotesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, ProposalVotesTableName)
+}
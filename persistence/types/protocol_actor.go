@@ -62,6 +62,14 @@ type ProtocolActorSchema interface {
 	UpdateUnstakedHeightIfPausedBeforeQuery(pauseBeforeHeight, unstakingHeight, height int64) string
 	// Returns a query to update the actor's stake amount
 	SetStakeAmountQuery(address string, stakeAmount string, height int64) string
+	// Returns a query to copy an Actor's record to a new address with a new public key.
+	MoveQuery(address, newAddress, newPublicKey string, height int64) string
+	// Returns a query to copy the chains an Actor is staked for to a new address.
+	MoveChainsQuery(address, newAddress string, height int64) string
+	// Returns a query to tombstone an Actor whose record was moved to a new address.
+	TombstoneQuery(address string, height int64) string
+	// Returns a query to update the output address of an Actor.
+	UpdateOutputAddressQuery(address, outputAddress string, height int64) string
 
 	/*** Debug Queries Only /***/
 
@@ -0,0 +1,46 @@
+package types
+
+// CLEANUP: Move SQL specific business logic into a `sql` package under `persistence`
+
+import "fmt"
+
+// The hashes of the committed transactions are kept until the `max_height` of the transaction is
+// reached, which is all that is needed to reject replays; the table is pruned every block so its
+// size is bounded by the validity window of the transactions rather than by the chain history.
+const (
+	TransactionHashesTableName      = "transaction_hashes"
+	TransactionHashesHashConstraint = "transaction_hashes_hash"
+	TransactionHashesTableSchema    = `(
+			hash       TEXT NOT NULL,
+			max_height BIGINT NOT NULL,
+			height     BIGINT NOT NULL,
+
+			CONSTRAINT transaction_hashes_hash UNIQUE (hash)
+		)`
+)
+
+func InsertTransactionHashQuery(hash string, maxHeight, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (hash, max_height, height)
+			VALUES ('%s', %d, %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO NOTHING
+		`, TransactionHashesTableName, hash, maxHeight, height, TransactionHashesHashConstraint)
+}
+
+// GetTransactionHashExistsQuery returns the query for whether a transaction committed at or before
+// `height` is still within its validity window at `height`
+func GetTransactionHashExistsQuery(hash string, height int64) string {
+	return fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE hash='%s' AND height<=%d AND max_height>=%d)`,
+		TransactionHashesTableName, hash, height, height)
+}
+
+// PruneTransactionHashesQuery returns the query deleting the hashes of the transactions that can no
+// longer be included in a block after `height`
+func PruneTransactionHashesQuery(height int64) string {
+	return fmt.Sprintf(`DELETE FROM %s WHERE max_height<=%d`, TransactionHashesTableName, height)
+}
+
+func ClearAllTransactionHashesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, TransactionHashesTableName)
+}
@@ -47,6 +47,9 @@ func (actor *ValidatorSchema) InsertQuery(address, publicKey, stakedTokens, maxR
 func (actor *ValidatorSchema) UpdateChainsQuery(_ string, _ []string, _ int64) string {
 	panic(ValidatorPanicMsg)
 }
+func (actor *ValidatorSchema) MoveChainsQuery(_, _ string, _ int64) string {
+	panic(ValidatorPanicMsg)
+}
 func (actor *ValidatorSchema) GetChainsTableSchema() string            { panic(ValidatorPanicMsg) }
 func (actor *ValidatorSchema) GetChainsQuery(_ string, _ int64) string { panic(ValidatorPanicMsg) }
 func (actor *ValidatorSchema) ClearAllChainsQuery() string             { panic(ValidatorPanicMsg) }
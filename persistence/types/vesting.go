@@ -0,0 +1,42 @@
+package types
+
+// CLEANUP: Move SQL specific business logic into a `sql` package under `persistence`
+
+import "fmt"
+
+// The vesting schedule of an account is stored as a hex encoded protobuf in its own table so the
+// balance updates of the `account` table are not affected by it.
+const (
+	VestingSchedulesTableName        = "vesting_schedules"
+	VestingSchedulesHeightConstraint = "vesting_schedules_create_height"
+	VestingSchedulesTableSchema      = `(
+			address  TEXT NOT NULL,
+			schedule TEXT NOT NULL,
+			height   BIGINT NOT NULL,
+
+			CONSTRAINT vesting_schedules_create_height UNIQUE (address, height)
+		)`
+)
+
+func InsertVestingScheduleQuery(address, schedule string, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (address, schedule, height)
+			VALUES ('%s', '%s', %d)
+			ON CONFLICT ON CONSTRAINT %s
+			DO UPDATE SET schedule=EXCLUDED.schedule
+		`, VestingSchedulesTableName, address, schedule, height, VestingSchedulesHeightConstraint)
+}
+
+func GetVestingScheduleQuery(address string, height int64) string {
+	return fmt.Sprintf(`SELECT schedule FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1`,
+		VestingSchedulesTableName, address, height)
+}
+
+func GetVestingSchedulesUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT address FROM %s WHERE height=%d ORDER BY address ASC`,
+		VestingSchedulesTableName, height)
+}
+
+func ClearAllVestingSchedulesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, VestingSchedulesTableName)
+}
@@ -54,6 +54,19 @@ func (p PostgresContext) SetValidatorStakeAmount(address []byte, stakeAmount str
 	return p.setActorStakeAmount(types.ValidatorActor, address, stakeAmount)
 }
 
+// RotateValidatorKey moves the stake record to the address of the new key and clears the BLS key of the old address.
+// The BLS key of a validator is derived from its operator key, so the validator registers a new one after rotating.
+func (p PostgresContext) RotateValidatorKey(address, newAddress, newPublicKey []byte) error {
+	if err := p.moveActor(types.ValidatorActor, address, newAddress, newPublicKey); err != nil {
+		return err
+	}
+	return p.clearValidatorBLSKey(address)
+}
+
+func (p PostgresContext) SetValidatorOutputAddress(address, outputAddress []byte) error {
+	return p.setActorOutputAddress(types.ValidatorActor, address, outputAddress)
+}
+
 func (p PostgresContext) GetValidatorsReadyToUnstake(height int64, status int32) ([]modules.IUnstakingActor, error) {
 	return p.GetActorsReadyToUnstake(types.ValidatorActor, height)
 }
@@ -3,11 +3,16 @@ package rpc
 import (
 	"encoding/hex"
 	"log"
+	"math/big"
 	"net/http"
+	"sort"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pokt-network/pocket/app"
 	"github.com/pokt-network/pocket/shared/codec"
+	"github.com/pokt-network/pocket/shared/converters"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
@@ -50,6 +55,452 @@ func (s *rpcServer) GetV1ConsensusState(ctx echo.Context) error {
 	})
 }
 
+func (s *rpcServer) GetV1QueryProposals(ctx echo.Context) error {
+	height := int64(s.GetBus().GetConsensusModule().CurrentHeight())
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Close()
+
+	proposals, err := readCtx.GetAllProposals(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	response := make([]Proposal, 0, len(proposals))
+	for _, proposal := range proposals {
+		votes, err := readCtx.GetProposalVotes(proposal.Id, height)
+		if err != nil {
+			return ctx.String(http.StatusInternalServerError, err.Error())
+		}
+		response = append(response, toRPCProposal(proposal, votes))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (s *rpcServer) PostV1QueryAccount(ctx echo.Context) error {
+	accountParams := new(AccountRequest)
+	if err := ctx.Bind(accountParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	address, err := hex.DecodeString(accountParams.Address)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode address")
+	}
+
+	height := int64(s.GetBus().GetConsensusModule().CurrentHeight())
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Close()
+
+	amount, err := readCtx.GetAccountAmount(address, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	vesting, err := readCtx.GetAccountVesting(address, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	account, err := toRPCAccount(accountParams.Address, amount, vesting, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, account)
+}
+
+// toRPCAccount splits the balance of the account into the part locked by its vesting schedule and the unlocked remainder
+func toRPCAccount(address, amount string, vesting *coreTypes.VestingSchedule, height int64) (Account, error) {
+	amountBig, err := converters.StringToBigInt(amount)
+	if err != nil {
+		return Account{}, err
+	}
+	vested, err := vesting.VestedAmount(height)
+	if err != nil {
+		return Account{}, err
+	}
+	locked, err := vesting.LockedAmount(height)
+	if err != nil {
+		return Account{}, err
+	}
+	unlocked := new(big.Int).Sub(amountBig, locked)
+	if unlocked.Sign() == -1 {
+		unlocked.SetInt64(0)
+	}
+	return Account{
+		Address:     address,
+		Amount:      amount,
+		VestingType: vesting.GetVestingType().String(),
+		Vested:      converters.BigIntToString(vested),
+		Locked:      converters.BigIntToString(locked),
+		Unlocked:    converters.BigIntToString(unlocked),
+	}, nil
+}
+
+func (s *rpcServer) PostV1QueryEvents(ctx echo.Context) error {
+	eventsParams := new(EventsRequest)
+	if err := ctx.Bind(eventsParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	persistenceModule := s.GetBus().GetPersistenceModule()
+	blockEvents, err := persistenceModule.GetBlockEvents(eventsParams.Height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	txResults, err := persistenceModule.GetTransactionsByHeight(eventsParams.Height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, BlockEvents{
+		Height:       eventsParams.Height,
+		BlockEvents:  toRPCEvents(blockEvents),
+		Transactions: toRPCTransactionEvents(txResults),
+	})
+}
+
+func (s *rpcServer) PostV1QueryTxsByEvent(ctx echo.Context) error {
+	eventParams := new(TxsByEventRequest)
+	if err := ctx.Bind(eventParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	eventType, ok := coreTypes.EventType_value[eventParams.EventType]
+	if !ok {
+		return ctx.String(http.StatusBadRequest, "unknown event type")
+	}
+	var key, value string
+	if eventParams.AttributeKey != nil {
+		key = *eventParams.AttributeKey
+	}
+	if eventParams.AttributeValue != nil {
+		value = *eventParams.AttributeValue
+	}
+
+	txResults, err := s.GetBus().GetPersistenceModule().GetTransactionsByEvent(coreTypes.EventType(eventType), key, value)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, toRPCTransactionEvents(txResults))
+}
+
+func (s *rpcServer) PostV1QueryBlockEventsByEvent(ctx echo.Context) error {
+	eventParams := new(TxsByEventRequest)
+	if err := ctx.Bind(eventParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	eventType, ok := coreTypes.EventType_value[eventParams.EventType]
+	if !ok {
+		return ctx.String(http.StatusBadRequest, "unknown event type")
+	}
+	var key, value string
+	if eventParams.AttributeKey != nil {
+		key = *eventParams.AttributeKey
+	}
+	if eventParams.AttributeValue != nil {
+		value = *eventParams.AttributeValue
+	}
+
+	blockEvents, err := s.GetBus().GetPersistenceModule().GetBlockEventsByType(coreTypes.EventType(eventType), key, value)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	rpcBlockEvents := make([]BlockEvent, 0, len(blockEvents))
+	for _, blockEvent := range blockEvents {
+		rpcBlockEvents = append(rpcBlockEvents, BlockEvent{
+			Height: blockEvent.GetHeight(),
+			Index:  blockEvent.GetIndex(),
+			Event:  toRPCEvents([]*coreTypes.Event{blockEvent.GetEvent()})[0],
+		})
+	}
+	return ctx.JSON(http.StatusOK, rpcBlockEvents)
+}
+
+func (s *rpcServer) PostV1QueryBlockHeader(ctx echo.Context) error {
+	heightParams := new(HeightRequest)
+	if err := ctx.Bind(heightParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	block, err := s.GetBus().GetPersistenceModule().GetBlock(heightParams.Height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	headerBz, err := codec.GetCodec().Marshal(block.GetBlockHeader())
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, BlockHeader{
+		Height: heightParams.Height,
+		Header: hex.EncodeToString(headerBz),
+	})
+}
+
+func (s *rpcServer) PostV1QueryValidatorSet(ctx echo.Context) error {
+	heightParams := new(HeightRequest)
+	if err := ctx.Bind(heightParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+	height := heightParams.Height
+
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Close()
+
+	roots, err := readCtx.GetStateTreeRoots(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	validators, err := readCtx.GetAllValidators(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	rpcValidators := make([]string, 0, len(validators))
+	for _, validator := range validators {
+		validatorBz, err := codec.GetCodec().Marshal(validator)
+		if err != nil {
+			return ctx.String(http.StatusInternalServerError, err.Error())
+		}
+		rpcValidators = append(rpcValidators, hex.EncodeToString(validatorBz))
+	}
+	return ctx.JSON(http.StatusOK, ValidatorSet{
+		Height:         height,
+		StateTreeRoots: toHexStrings(roots),
+		Validators:     rpcValidators,
+	})
+}
+
+func (s *rpcServer) PostV1QueryAccountProof(ctx echo.Context) error {
+	accountParams := new(AccountRequest)
+	if err := ctx.Bind(accountParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	address, err := hex.DecodeString(accountParams.Address)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode address")
+	}
+
+	height := int64(s.GetBus().GetConsensusModule().CurrentHeight())
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Close()
+
+	latestHeight, err := readCtx.GetLatestBlockHeight()
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	proof, err := readCtx.GetAccountProof(address, int64(latestHeight))
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, StateProof{
+		Height:                int64(proof.GetHeight()),
+		StateTreeRoots:        toHexStrings(proof.GetStateTreeRoots()),
+		Key:                   hex.EncodeToString(proof.GetKey()),
+		Value:                 hex.EncodeToString(proof.GetValue()),
+		SideNodes:             toHexStrings(proof.GetSideNodes()),
+		NonMembershipLeafData: hex.EncodeToString(proof.GetNonMembershipLeafData()),
+		SiblingData:           hex.EncodeToString(proof.GetSiblingData()),
+	})
+}
+
+func (s *rpcServer) GetV1QueryFeeEstimate(ctx echo.Context) error {
+	height := int64(s.GetBus().GetConsensusModule().CurrentHeight())
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Close()
+
+	estimate := FeeEstimate{
+		Height:          height,
+		BaseFee:         "0",
+		SuggestedTip:    "0",
+		SuggestedMaxFee: "0",
+	}
+	dynamicFees, err := isUpgradeActive(readCtx, typesUtil.DynamicFeesUpgrade, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	if !dynamicFees {
+		return ctx.JSON(http.StatusOK, estimate)
+	}
+
+	// the base fee of the next block is computed at the end of the previous one
+	baseFee, err := readCtx.GetBaseFee(height - 1)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	if baseFee == "" {
+		if baseFee, err = readCtx.GetStringParam(typesUtil.FeeMarketMinBaseFeeParamName, height); err != nil {
+			return ctx.String(http.StatusInternalServerError, err.Error())
+		}
+	}
+	baseFeeBig, err := converters.StringToBigInt(baseFee)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	txResults, err := s.GetBus().GetPersistenceModule().GetTransactionsByHeight(height - 1)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	tip := medianTip(txResults)
+	// twice the base fee keeps the transaction valid through several consecutive congested blocks
+	maxFee := new(big.Int).Mul(baseFeeBig, big.NewInt(2))
+	maxFee.Add(maxFee, tip)
+
+	estimate.DynamicFees = true
+	estimate.BaseFee = baseFee
+	estimate.SuggestedTip = typesUtil.BigIntToString(tip)
+	estimate.SuggestedMaxFee = typesUtil.BigIntToString(maxFee)
+	return ctx.JSON(http.StatusOK, estimate)
+}
+
+func (s *rpcServer) GetV1DebugPeerScores(ctx echo.Context) error {
+	p2pModule := s.GetBus().GetP2PModule()
+	scores := p2pModule.GetPeerScores()
+	bans := p2pModule.GetPeerBans()
+
+	// A banned peer starts over, so it may only have a ban
+	addresses := make([]string, 0, len(scores)+len(bans))
+	for address := range scores {
+		addresses = append(addresses, address)
+	}
+	for address := range bans {
+		if _, ok := scores[address]; !ok {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	response := make([]PeerScore, 0, len(addresses))
+	for _, address := range addresses {
+		peerScore := PeerScore{
+			Address: address,
+			Score:   scores[address],
+		}
+		if bannedUntil, ok := bans[address]; ok {
+			peerScore.BannedUntil = bannedUntil.Unix()
+		}
+		response = append(response, peerScore)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// isUpgradeActive mirrors the utility module's `IsFeatureActive` on a read context
+func isUpgradeActive(readCtx modules.PersistenceReadContext, upgradeName string, height int64) (bool, error) {
+	flagName := typesUtil.UpgradeFlagName(upgradeName)
+	exists, err := readCtx.GetFlagExists(flagName, height)
+	if err != nil || !exists {
+		return false, err
+	}
+	activationHeight, enabled, err := readCtx.GetIntFlag(flagName, height)
+	if err != nil {
+		return false, err
+	}
+	return enabled && int64(activationHeight) <= height, nil
+}
+
+// medianTip returns the median of the tips paid by the successful transactions of a block
+func medianTip(txResults []modules.TxResult) *big.Int {
+	tips := make([]*big.Int, 0, len(txResults))
+	for _, txResult := range txResults {
+		if txResult.GetResultCode() != 0 {
+			continue
+		}
+		tx, err := typesUtil.TransactionFromBytes(txResult.GetTx())
+		if err != nil {
+			continue
+		}
+		tip, err := tx.GetTipAmount()
+		if err != nil {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+	if len(tips) == 0 {
+		return big.NewInt(0)
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) == -1 })
+	return tips[len(tips)/2]
+}
+
+func toRPCTransactionEvents(txResults []modules.TxResult) []TransactionEvents {
+	rpcTxs := make([]TransactionEvents, 0, len(txResults))
+	for _, txResult := range txResults {
+		rpcTxs = append(rpcTxs, TransactionEvents{
+			Hash:        typesUtil.TransactionHash(txResult.GetTx()),
+			Height:      txResult.GetHeight(),
+			Index:       txResult.GetIndex(),
+			ResultCode:  txResult.GetResultCode(),
+			MessageType: txResult.GetMessageType(),
+			Events:      toRPCEvents(txResult.GetEvents()),
+		})
+	}
+	return rpcTxs
+}
+
+func toHexStrings(bzs [][]byte) []string {
+	hexStrings := make([]string, 0, len(bzs))
+	for _, bz := range bzs {
+		hexStrings = append(hexStrings, hex.EncodeToString(bz))
+	}
+	return hexStrings
+}
+
+func toRPCEvents(events []*coreTypes.Event) []Event {
+	rpcEvents := make([]Event, 0, len(events))
+	for _, event := range events {
+		attributes := make([]EventAttribute, 0, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes = append(attributes, EventAttribute{
+				Key:   attribute.Key,
+				Value: attribute.Value,
+			})
+		}
+		rpcEvents = append(rpcEvents, Event{
+			EventType:  event.EventType.String(),
+			Attributes: attributes,
+		})
+	}
+	return rpcEvents
+}
+
+func toRPCProposal(proposal *coreTypes.Proposal, votes []*coreTypes.ProposalVote) Proposal {
+	rpcVotes := make([]ProposalVote, 0, len(votes))
+	for _, vote := range votes {
+		rpcVotes = append(rpcVotes, ProposalVote{
+			Voter:  vote.Voter,
+			Option: vote.Option.String(),
+			Height: vote.Height,
+		})
+	}
+	return Proposal{
+		Id:              proposal.Id,
+		Proposer:        proposal.Proposer,
+		ProposalType:    proposal.ProposalType.String(),
+		Key:             proposal.Key,
+		FlagEnabled:     proposal.FlagEnabled,
+		PoolName:        proposal.PoolName,
+		Recipient:       proposal.Recipient,
+		Amount:          proposal.Amount,
+		Deposit:         proposal.Deposit,
+		SubmitHeight:    proposal.SubmitHeight,
+		VotingEndHeight: proposal.VotingEndHeight,
+		Status:          proposal.Status.String(),
+		Votes:           rpcVotes,
+	}
+}
+
 // Broadcast to the entire validator set
 func (s *rpcServer) broadcastMessage(msgBz []byte) error {
 	utilMsg := &typesUtil.TransactionGossipMessage{
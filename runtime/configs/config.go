@@ -20,7 +20,12 @@ func NewDefaultConfig(options ...func(*Config)) *Config {
 	cfg := &Config{
 		RootDirectory: "/go/src/github.com/pocket-network",
 		Consensus: &ConsensusConfig{
-			MaxMempoolBytes: defaults.DefaultConsensusMaxMempoolBytes,
+			MaxMempoolBytes:         defaults.DefaultConsensusMaxMempoolBytes,
+			WalPath:                 defaults.DefaultConsensusWALPath,
+			TargetBlockIntervalMsec: defaults.DefaultConsensusTargetBlockIntervalMsec,
+			EmptyBlockIntervalMsec:  defaults.DefaultConsensusEmptyBlockIntervalMsec,
+			MaxBlockTimeDriftMsec:   defaults.DefaultConsensusMaxBlockTimeDriftMsec,
+			EpochLength:             defaults.DefaultConsensusEpochLength,
 			PacemakerConfig: &PacemakerConfig{
 				TimeoutMsec:               defaults.DefaultPacemakerTimeoutMsec,
 				Manual:                    defaults.DefaultPacemakerManual,
@@ -36,10 +41,18 @@ func NewDefaultConfig(options ...func(*Config)) *Config {
 			BlockStorePath: defaults.DefaultPersistenceBlockStorePath,
 		},
 		P2P: &P2PConfig{
-			ConsensusPort:   defaults.DefaultP2PConsensusPort,
-			UseRainTree:     defaults.DefaultP2PUseRainTree,
-			ConnectionType:  defaults.DefaultP2PConnectionType,
-			MaxMempoolCount: defaults.DefaultP2PMaxMempoolCount,
+			ConsensusPort:              defaults.DefaultP2PConsensusPort,
+			UseRainTree:                defaults.DefaultP2PUseRainTree,
+			ConnectionType:             defaults.DefaultP2PConnectionType,
+			MaxMempoolCount:            defaults.DefaultP2PMaxMempoolCount,
+			PeerstorePath:              defaults.DefaultP2PPeerstorePath,
+			DiscoveryIntervalMsec:      defaults.DefaultP2PDiscoveryIntervalMsec,
+			MaxMissedPings:             defaults.DefaultP2PMaxMissedPings,
+			UseRainTreeRedundancyLayer: defaults.DefaultP2PUseRainTreeRedundancyLayer,
+			RainTreeAckTimeoutMsec:     defaults.DefaultP2PRainTreeAckTimeoutMsec,
+			PeerBanScoreThreshold:      defaults.DefaultP2PPeerBanScoreThreshold,
+			PeerBanDurationSec:         defaults.DefaultP2PPeerBanDurationSec,
+			BanstorePath:               defaults.DefaultP2PBanstorePath,
 		},
 		Telemetry: &TelemetryConfig{
 			Enabled:  defaults.DefaultTelemetryEnabled,
@@ -73,6 +86,13 @@ func WithPK(pk string) func(*Config) {
 	}
 }
 
+// WithServiceURL is an option to configure the URL the node advertises to its peers.
+func WithServiceURL(serviceURL string) func(*Config) {
+	return func(cfg *Config) {
+		cfg.P2P.ServiceUrl = serviceURL
+	}
+}
+
 // WithNodeSchema is an option to configure the schema for the node's database.
 func WithNodeSchema(schema string) func(*Config) {
 	return func(cfg *Config) {
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"io"
 	"log"
+	"math/rand"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/mitchellh/mapstructure"
@@ -24,6 +26,7 @@ type Manager struct {
 	genesisState *genesis.GenesisState
 
 	clock clock.Clock
+	rand  *rand.Rand
 	bus   modules.Bus
 }
 
@@ -37,6 +40,7 @@ func NewManager(config *configs.Config, genesis *genesis.GenesisState, options .
 	mgr.config = config
 	mgr.genesisState = genesis
 	mgr.clock = clock.New()
+	mgr.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
 	mgr.bus = bus
 
 	for _, o := range options {
@@ -83,6 +87,10 @@ func (m *Manager) GetClock() clock.Clock {
 	return m.clock
 }
 
+func (m *Manager) GetRand() *rand.Rand {
+	return m.rand
+}
+
 func parseFiles(configJSONPath, genesisJSONPath string) (config *configs.Config, genesisState *genesis.GenesisState, err error) {
 	config = configs.NewDefaultConfig()
 
@@ -159,6 +167,12 @@ func WithClock(clockMgr clock.Clock) func(*Manager) {
 	}
 }
 
+func WithRand(rng *rand.Rand) func(*Manager) {
+	return func(b *Manager) {
+		b.rand = rng
+	}
+}
+
 func WithClientDebugMode() func(*Manager) {
 	return func(b *Manager) {
 		b.config.ClientDebugMode = true
@@ -202,8 +202,13 @@ func TestNewManagerFromReaders(t *testing.T) {
 					RootDirectory: "/go/src/github.com/pocket-network",
 					PrivateKey:    "c6c136d010d07d7f5e9944aa3594a10f9210dd3e26ebc1bc1516a6d957fd0df353ee26c82826694ffe1773d7b60d5f20dd9e91bdf8745544711bec5ff9c6fb4a",
 					Consensus: &configs.ConsensusConfig{
-						PrivateKey:      "c6c136d010d07d7f5e9944aa3594a10f9210dd3e26ebc1bc1516a6d957fd0df353ee26c82826694ffe1773d7b60d5f20dd9e91bdf8745544711bec5ff9c6fb4a",
-						MaxMempoolBytes: 500000000,
+						PrivateKey:              "c6c136d010d07d7f5e9944aa3594a10f9210dd3e26ebc1bc1516a6d957fd0df353ee26c82826694ffe1773d7b60d5f20dd9e91bdf8745544711bec5ff9c6fb4a",
+						MaxMempoolBytes:         500000000,
+						WalPath:                 "/var/consensus/wal",
+						TargetBlockIntervalMsec: defaults.DefaultConsensusTargetBlockIntervalMsec,
+						EmptyBlockIntervalMsec:  defaults.DefaultConsensusEmptyBlockIntervalMsec,
+						MaxBlockTimeDriftMsec:   defaults.DefaultConsensusMaxBlockTimeDriftMsec,
+						EpochLength:             defaults.DefaultConsensusEpochLength,
 						PacemakerConfig: &configs.PacemakerConfig{
 							TimeoutMsec:               5000,
 							Manual:                    true,
@@ -227,11 +232,20 @@ func TestNewManagerFromReaders(t *testing.T) {
 						HealthCheckPeriod: "5m",
 					},
 					P2P: &configs.P2PConfig{
-						PrivateKey:      "c6c136d010d07d7f5e9944aa3594a10f9210dd3e26ebc1bc1516a6d957fd0df353ee26c82826694ffe1773d7b60d5f20dd9e91bdf8745544711bec5ff9c6fb4a",
-						ConsensusPort:   8080,
-						UseRainTree:     true,
-						ConnectionType:  configTypes.ConnectionType_TCPConnection,
-						MaxMempoolCount: 1e5,
+						PrivateKey:                 "c6c136d010d07d7f5e9944aa3594a10f9210dd3e26ebc1bc1516a6d957fd0df353ee26c82826694ffe1773d7b60d5f20dd9e91bdf8745544711bec5ff9c6fb4a",
+						ConsensusPort:              8080,
+						UseRainTree:                true,
+						ConnectionType:             configTypes.ConnectionType_SecureTCPConnection,
+						MaxMempoolCount:            1e5,
+						ServiceUrl:                 "node1.consensus:8080",
+						PeerstorePath:              defaults.DefaultP2PPeerstorePath,
+						DiscoveryIntervalMsec:      defaults.DefaultP2PDiscoveryIntervalMsec,
+						MaxMissedPings:             defaults.DefaultP2PMaxMissedPings,
+						UseRainTreeRedundancyLayer: defaults.DefaultP2PUseRainTreeRedundancyLayer,
+						RainTreeAckTimeoutMsec:     defaults.DefaultP2PRainTreeAckTimeoutMsec,
+						PeerBanScoreThreshold:      defaults.DefaultP2PPeerBanScoreThreshold,
+						PeerBanDurationSec:         defaults.DefaultP2PPeerBanDurationSec,
+						BanstorePath:               defaults.DefaultP2PBanstorePath,
 					},
 					Telemetry: &configs.TelemetryConfig{
 						Enabled:  true,
@@ -273,11 +287,19 @@ func TestNewManagerFromReaders(t *testing.T) {
 			want: &Manager{
 				config: &configs.Config{
 					P2P: &configs.P2PConfig{
-						PrivateKey:      "6fd0bc54cc2dd205eaf226eebdb0451629b321f11d279013ce6fdd5a33059256b2eda2232ffb2750bf761141f70f75a03a025f65b2b2b417c7f8b3c9ca91e8e4",
-						ConsensusPort:   8080,
-						UseRainTree:     true,
-						ConnectionType:  configTypes.ConnectionType_TCPConnection,
-						MaxMempoolCount: defaults.DefaultP2PMaxMempoolCount,
+						PrivateKey:                 "6fd0bc54cc2dd205eaf226eebdb0451629b321f11d279013ce6fdd5a33059256b2eda2232ffb2750bf761141f70f75a03a025f65b2b2b417c7f8b3c9ca91e8e4",
+						ConsensusPort:              8080,
+						UseRainTree:                true,
+						ConnectionType:             configTypes.ConnectionType_SecureTCPConnection,
+						MaxMempoolCount:            defaults.DefaultP2PMaxMempoolCount,
+						PeerstorePath:              defaults.DefaultP2PPeerstorePath,
+						DiscoveryIntervalMsec:      defaults.DefaultP2PDiscoveryIntervalMsec,
+						MaxMissedPings:             defaults.DefaultP2PMaxMissedPings,
+						UseRainTreeRedundancyLayer: defaults.DefaultP2PUseRainTreeRedundancyLayer,
+						RainTreeAckTimeoutMsec:     defaults.DefaultP2PRainTreeAckTimeoutMsec,
+						PeerBanScoreThreshold:      defaults.DefaultP2PPeerBanScoreThreshold,
+						PeerBanDurationSec:         defaults.DefaultP2PPeerBanDurationSec,
+						BanstorePath:               defaults.DefaultP2PBanstorePath,
 					},
 				},
 				genesisState: expectedGenesis,
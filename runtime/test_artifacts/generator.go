@@ -31,6 +31,8 @@ func NewGenesisState(numValidators, numServiceNodes, numApplications, numFisherm
 		ServiceNodes:  serviceNodes,
 		Fishermen:     fish,
 		Params:        DefaultParams(),
+
+		ValidatorBlsKeys: NewBLSKeys(validatorPrivateKeys),
 	}
 
 	// TODO: Generalize this to all actors and not just validators
@@ -42,11 +44,27 @@ func NewDefaultConfigs(privateKeys []string) (cfgs []*configs.Config) {
 		cfgs = append(cfgs, configs.NewDefaultConfig(
 			configs.WithPK(pk),
 			configs.WithNodeSchema("node"+strconv.Itoa(i+1)),
+			configs.WithServiceURL(fmt.Sprintf(ServiceUrlFormat, i+1)),
 		))
 	}
 	return
 }
 
+// NewBLSKeys registers the BLS keys derived from the private keys of the validators
+func NewBLSKeys(privateKeys []string) (blsKeys []*coreTypes.BLSKey) {
+	for _, privateKey := range privateKeys {
+		pk, _ := crypto.NewPrivateKey(privateKey)
+		blsKey, _ := crypto.NewBLSPrivateKeyFromPrivateKey(pk)
+		proof, _ := blsKey.ProvePossession()
+		blsKeys = append(blsKeys, &coreTypes.BLSKey{
+			Address:           pk.Address().String(),
+			PublicKey:         blsKey.PublicKey(),
+			ProofOfPossession: proof,
+		})
+	}
+	return
+}
+
 // REFACTOR: Test artifact generator should reflect the sum of the initial account values to populate the initial pool values
 func NewPools() (pools []*coreTypes.Account) {
 	for _, name := range coreTypes.Pools_name {
@@ -126,5 +126,43 @@ func DefaultParams() *genesis.Params {
 		MessagePauseServiceNodeFeeOwner:          DefaultParamsOwner.Address().String(),
 		MessageUnpauseServiceNodeFeeOwner:        DefaultParamsOwner.Address().String(),
 		MessageChangeParameterFeeOwner:           DefaultParamsOwner.Address().String(),
+		GovernanceMinDeposit:                     types.BigIntToString(big.NewInt(10000000000)),
+		GovernanceVotingPeriodBlocks:             100,
+		GovernanceQuorumPercentage:               33,
+		GovernancePassThresholdPercentage:        50,
+		MessageSubmitProposalFee:                 types.BigIntToString(big.NewInt(10000)),
+		MessageVoteFee:                           types.BigIntToString(big.NewInt(10000)),
+		GovernanceMinDepositOwner:                DefaultParamsOwner.Address().String(),
+		GovernanceVotingPeriodBlocksOwner:        DefaultParamsOwner.Address().String(),
+		GovernanceQuorumPercentageOwner:          DefaultParamsOwner.Address().String(),
+		GovernancePassThresholdPercentageOwner:   DefaultParamsOwner.Address().String(),
+		MessageSubmitProposalFeeOwner:            DefaultParamsOwner.Address().String(),
+		MessageVoteFeeOwner:                      DefaultParamsOwner.Address().String(),
+		MessageUpgradePlanFee:                    types.BigIntToString(big.NewInt(10000)),
+		MessageUpgradePlanFeeOwner:               DefaultParamsOwner.Address().String(),
+		MessageDelegateFee:                       types.BigIntToString(big.NewInt(10000)),
+		MessageUndelegateFee:                     types.BigIntToString(big.NewInt(10000)),
+		MessageSetCommissionFee:                  types.BigIntToString(big.NewInt(10000)),
+		MessageDelegateFeeOwner:                  DefaultParamsOwner.Address().String(),
+		MessageUndelegateFeeOwner:                DefaultParamsOwner.Address().String(),
+		MessageSetCommissionFeeOwner:             DefaultParamsOwner.Address().String(),
+		FeeMarketTargetBlockSizeBytes:            500000,
+		FeeMarketBaseFeeChangeDenominator:        8,
+		FeeMarketMinBaseFee:                      types.BigIntToString(big.NewInt(10000)),
+		FeeMarketTargetBlockSizeBytesOwner:       DefaultParamsOwner.Address().String(),
+		FeeMarketBaseFeeChangeDenominatorOwner:   DefaultParamsOwner.Address().String(),
+		FeeMarketMinBaseFeeOwner:                 DefaultParamsOwner.Address().String(),
+		TransactionMaxValidityBlocks:             120,
+		TransactionMaxValidityBlocksOwner:        DefaultParamsOwner.Address().String(),
+		MessageRotateKeyFee:                      types.BigIntToString(big.NewInt(10000)),
+		MessageChangeOutputAddressFee:            types.BigIntToString(big.NewInt(10000)),
+		MessageRotateKeyFeeOwner:                 DefaultParamsOwner.Address().String(),
+		MessageChangeOutputAddressFeeOwner:       DefaultParamsOwner.Address().String(),
+		MessageRegisterBlsKeyFee:                 types.BigIntToString(big.NewInt(10000)),
+		MessageRegisterBlsKeyFeeOwner:            DefaultParamsOwner.Address().String(),
+		CommissionMaxChangePercentage:            10,
+		CommissionChangePeriodBlocks:             100,
+		CommissionMaxChangePercentageOwner:       DefaultParamsOwner.Address().String(),
+		CommissionChangePeriodBlocksOwner:        DefaultParamsOwner.Address().String(),
 	}
 }
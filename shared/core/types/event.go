@@ -0,0 +1,49 @@
+package types
+
+// The keys of the event attributes; values are always strings, addresses are hex encoded and amounts are in uPOKT
+const (
+	EventAttributeKeyAddress         = "address"
+	EventAttributeKeyActorType       = "actor_type"
+	EventAttributeKeyAmount          = "amount"
+	EventAttributeKeySender          = "sender"
+	EventAttributeKeyRecipient       = "recipient"
+	EventAttributeKeyOutputAddress   = "output_address"
+	EventAttributeKeyPool            = "pool"
+	EventAttributeKeyHeight          = "height"
+	EventAttributeKeyPercentage      = "percentage"
+	EventAttributeKeyParam           = "param"
+	EventAttributeKeyProposalId      = "proposal_id"
+	EventAttributeKeyProposalStatus  = "proposal_status"
+	EventAttributeKeyVoteOption      = "vote_option"
+	EventAttributeKeyDelegator       = "delegator"
+	EventAttributeKeyUpgradeName     = "upgrade_name"
+	EventAttributeKeyMissedBlocks    = "missed_blocks"
+	EventAttributeKeyUnstakingHeight = "unstaking_height"
+	EventAttributeKeyBaseFee         = "base_fee"
+	EventAttributeKeyTip             = "tip"
+	EventAttributeKeyNewAddress      = "new_address"
+)
+
+func NewEvent(eventType EventType, attributes ...*EventAttribute) *Event {
+	return &Event{
+		EventType:  eventType,
+		Attributes: attributes,
+	}
+}
+
+func NewEventAttribute(key, value string) *EventAttribute {
+	return &EventAttribute{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// GetAttribute returns the value of the first attribute with the given key
+func (e *Event) GetAttribute(key string) (value string, ok bool) {
+	for _, attribute := range e.GetAttributes() {
+		if attribute.GetKey() == key {
+			return attribute.GetValue(), true
+		}
+	}
+	return "", false
+}
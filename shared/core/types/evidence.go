@@ -0,0 +1,47 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+// ValidateBasic checks that the evidence is self-consistent; i.e. that the two votes are different and
+// were both signed by the double signer. Whether the votes are for the same (height, round, step) is
+// checked by the consensus module, which owns the format of the votes.
+func (e *DoubleSignEvidence) ValidateBasic() error {
+	pubKey, err := crypto.NewPublicKeyFromBytes(e.GetPublicKey())
+	if err != nil {
+		return fmt.Errorf("invalid double signer public key: %w", err)
+	}
+	if len(e.GetVoteA()) == 0 || len(e.GetVoteB()) == 0 {
+		return fmt.Errorf("double sign evidence is missing a vote")
+	}
+	if bytes.Equal(e.GetVoteA(), e.GetVoteB()) {
+		return fmt.Errorf("double sign evidence votes are identical")
+	}
+	if !pubKey.Verify(e.GetVoteA(), e.GetSignatureA()) {
+		return fmt.Errorf("invalid signature for the first vote of %s", pubKey.Address())
+	}
+	if !pubKey.Verify(e.GetVoteB(), e.GetSignatureB()) {
+		return fmt.Errorf("invalid signature for the second vote of %s", pubKey.Address())
+	}
+	return nil
+}
+
+// Address returns the address of the double signer
+func (e *DoubleSignEvidence) Address() (crypto.Address, error) {
+	pubKey, err := crypto.NewPublicKeyFromBytes(e.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	return pubKey.Address(), nil
+}
+
+// Hash identifies the double sign rather than the votes, so the same double sign cannot be handled twice
+// with a different pair of votes
+func (e *DoubleSignEvidence) Hash() string {
+	offence := fmt.Sprintf("%x/%d/%d/%d", e.GetPublicKey(), e.GetHeight(), e.GetRound(), e.GetStep())
+	return crypto.GetHashStringFromBytes([]byte(offence))
+}
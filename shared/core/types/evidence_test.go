@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoubleSignEvidence_ValidateBasic(t *testing.T) {
+	privKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	evidence := newTestingDoubleSignEvidence(t, privKey)
+	require.NoError(t, evidence.ValidateBasic())
+
+	address, err := evidence.Address()
+	require.NoError(t, err)
+	require.Equal(t, privKey.Address(), address)
+
+	// the same vote signed twice is not a double sign
+	identicalVotes := newTestingDoubleSignEvidence(t, privKey)
+	identicalVotes.VoteB, identicalVotes.SignatureB = identicalVotes.VoteA, identicalVotes.SignatureA
+	require.Error(t, identicalVotes.ValidateBasic())
+
+	// the votes must be signed by the double signer
+	otherPrivKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	forgedVote := newTestingDoubleSignEvidence(t, privKey)
+	forgedVote.SignatureB, err = otherPrivKey.Sign(forgedVote.VoteB)
+	require.NoError(t, err)
+	require.Error(t, forgedVote.ValidateBasic())
+}
+
+func newTestingDoubleSignEvidence(t *testing.T, privKey crypto.PrivateKey) *DoubleSignEvidence {
+	voteA, voteB := []byte("vote for block A"), []byte("vote for block B")
+	signatureA, err := privKey.Sign(voteA)
+	require.NoError(t, err)
+	signatureB, err := privKey.Sign(voteB)
+	require.NoError(t, err)
+	return &DoubleSignEvidence{
+		PublicKey:  privKey.PublicKey().Bytes(),
+		Height:     1,
+		Round:      0,
+		Step:       2,
+		VoteA:      voteA,
+		SignatureA: signatureA,
+		VoteB:      voteB,
+		SignatureB: signatureB,
+	}
+}
@@ -10,6 +10,8 @@ func init() {
 		Pools_POOLS_APP_STAKE:          "AppStakePool",
 		Pools_POOLS_VALIDATOR_STAKE:    "ValidatorStakePool",
 		Pools_POOLS_SERVICE_NODE_STAKE: "ServiceNodeStakePool",
+		Pools_POOLS_GOVERNANCE:         "GovernancePool",
+		Pools_POOLS_DELEGATION:         "DelegationPool",
 	}
 }
 
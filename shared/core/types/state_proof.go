@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/celestiaorg/smt"
+)
+
+// The index of the root of a state tree among the roots hashed into the state hash. The order of the trees
+// is defined by the persistence module and must be kept in sync with it.
+const (
+	ValidatorsStateTreeIndex = 1
+	AccountsStateTreeIndex   = 4
+)
+
+// VerifyStateTreeRoots checks that the roots of the state trees are the ones committed by `stateHash`
+func VerifyStateTreeRoots(roots [][]byte, stateHash string) error {
+	rootsHash := sha256.Sum256(bytes.Join(roots, []byte{}))
+	if hex.EncodeToString(rootsHash[:]) != stateHash {
+		return fmt.Errorf("the state tree roots do not hash to the state hash %s", stateHash)
+	}
+	return nil
+}
+
+// Verify checks that the proof is of the key in the state tree at `stateTreeIndex` committed by `stateHash`
+func (p *StateProof) Verify(stateHash string, stateTreeIndex int) error {
+	roots := p.GetStateTreeRoots()
+	if stateTreeIndex < 0 || stateTreeIndex >= len(roots) {
+		return fmt.Errorf("the state proof has no root for the state tree #%d", stateTreeIndex)
+	}
+	if err := VerifyStateTreeRoots(roots, stateHash); err != nil {
+		return err
+	}
+	proof := smt.SparseMerkleProof{
+		SideNodes:             p.GetSideNodes(),
+		NonMembershipLeafData: p.GetNonMembershipLeafData(),
+		SiblingData:           p.GetSiblingData(),
+	}
+	if !smt.VerifyProof(proof, roots[stateTreeIndex], p.GetKey(), p.GetValue(), sha256.New()) {
+		return fmt.Errorf("invalid state proof for key %x in the state tree #%d", p.GetKey(), stateTreeIndex)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+)
+
+// Blocks are grouped into epochs of `epochLength` blocks, starting at height 1; the genesis block belongs to
+// epoch 0. An epoch length of 0 is treated as 1 (i.e. the validator set can change at every height).
+
+// GetEpoch returns the epoch of the block at `height`
+func GetEpoch(height, epochLength uint64) uint64 {
+	if height == 0 {
+		return 0
+	}
+	return (height - 1) / normalizeEpochLength(epochLength)
+}
+
+// GetEpochStartHeight returns the height of the first block of `epoch`
+func GetEpochStartHeight(epoch, epochLength uint64) uint64 {
+	return epoch*normalizeEpochLength(epochLength) + 1
+}
+
+// GetEpochSnapshotHeight returns the height of the committed state the validator set of the epoch of the block
+// at `height` is snapshotted from, i.e. the height of the last block of the previous epoch
+func GetEpochSnapshotHeight(height, epochLength uint64) uint64 {
+	return GetEpochStartHeight(GetEpoch(height, epochLength), epochLength) - 1
+}
+
+// ChainedEpochSnapshotDelay is the number of blocks the validator set snapshot of an epoch lags behind the last block
+// of the previous epoch with chained HotStuff, which commits a block only once the QCs of the next two blocks are
+// formed. The snapshot must be committed before the first block of the epoch is proposed, so the delay gives
+// the chain room to commit it even if some rounds before the epoch boundary time out.
+const ChainedEpochSnapshotDelay = 10
+
+// GetChainedEpochSnapshotHeight returns the height of the committed state the validator set of the epoch of the
+// block at `height` is snapshotted from with chained HotStuff (see `ChainedEpochSnapshotDelay`)
+func GetChainedEpochSnapshotHeight(height, epochLength uint64) uint64 {
+	snapshotHeight := GetEpochSnapshotHeight(height, epochLength)
+	if snapshotHeight < ChainedEpochSnapshotDelay {
+		return 0
+	}
+	return snapshotHeight - ChainedEpochSnapshotDelay
+}
+
+func normalizeEpochLength(epochLength uint64) uint64 {
+	if epochLength == 0 {
+		return 1
+	}
+	return epochLength
+}
+
+// Hash returns the hash committed in the header of the blocks of the epoch; the hash of a nil validator set is empty
+func (vs *ValidatorSet) Hash() string {
+	if vs == nil {
+		return ""
+	}
+	return HashValidators(vs.GetValidators())
+}
+
+// HashValidators hashes the address, public key and voting power of the validators, in the order of their address,
+// so the hash does not depend on how the validators were read from the state
+func HashValidators(validators []*Actor) string {
+	sortedValidators := make([]*Actor, len(validators))
+	copy(sortedValidators, validators)
+	sort.Slice(sortedValidators, func(i, j int) bool {
+		return sortedValidators[i].GetAddress() < sortedValidators[j].GetAddress()
+	})
+
+	hasher := sha256.New()
+	for _, validator := range sortedValidators {
+		for _, field := range []string{validator.GetAddress(), validator.GetPublicKey(), validator.GetStakedAmount()} {
+			fieldHash := sha256.Sum256([]byte(field))
+			hasher.Write(fieldHash[:])
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEpoch(t *testing.T) {
+	tests := []struct {
+		name           string
+		height         uint64
+		epochLength    uint64
+		epoch          uint64
+		snapshotHeight uint64
+	}{
+		{"genesis", 0, 10, 0, 0},
+		{"first block", 1, 10, 0, 0},
+		{"last block of the first epoch", 10, 10, 0, 0},
+		{"first block of the second epoch", 11, 10, 1, 10},
+		{"last block of the second epoch", 20, 10, 1, 10},
+		{"epoch of one block", 7, 1, 6, 6},
+		{"epoch length of zero", 7, 0, 6, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.epoch, GetEpoch(tt.height, tt.epochLength))
+			require.Equal(t, tt.snapshotHeight, GetEpochSnapshotHeight(tt.height, tt.epochLength))
+			if tt.height > 0 {
+				startHeight := GetEpochStartHeight(tt.epoch, tt.epochLength)
+				require.LessOrEqual(t, startHeight, tt.height)
+				require.Equal(t, tt.epoch, GetEpoch(startHeight, tt.epochLength))
+			}
+		})
+	}
+}
+
+func TestGetChainedEpochSnapshotHeight(t *testing.T) {
+	require.Equal(t, uint64(0), GetChainedEpochSnapshotHeight(1, 10))
+	require.Equal(t, uint64(0), GetChainedEpochSnapshotHeight(11, 10))
+	require.Equal(t, uint64(10), GetChainedEpochSnapshotHeight(21, 10))
+	require.Equal(t, uint64(10), GetChainedEpochSnapshotHeight(30, 10))
+	require.Equal(t, uint64(6), GetChainedEpochSnapshotHeight(17, 1))
+}
+
+func TestValidatorSet_Hash(t *testing.T) {
+	val1 := &Actor{Address: "01", PublicKey: "aa", StakedAmount: "100"}
+	val2 := &Actor{Address: "02", PublicKey: "bb", StakedAmount: "100"}
+
+	validatorSet := &ValidatorSet{Epoch: 1, StartHeight: 11, Validators: []*Actor{val1, val2}}
+	require.Equal(t, validatorSet.Hash(), HashValidators([]*Actor{val2, val1}), "the hash should not depend on the order of the validators")
+
+	// The voting power of the validators is committed by the hash
+	val2Restaked := &Actor{Address: "02", PublicKey: "bb", StakedAmount: "200"}
+	require.NotEqual(t, validatorSet.Hash(), HashValidators([]*Actor{val1, val2Restaked}))
+
+	// Fields that are not relevant to consensus are not committed by the hash
+	val2Paused := &Actor{Address: "02", PublicKey: "bb", StakedAmount: "100", PausedHeight: 5}
+	require.Equal(t, validatorSet.Hash(), HashValidators([]*Actor{val1, val2Paused}))
+
+	var nilValidatorSet *ValidatorSet
+	require.Empty(t, nilValidatorSet.Hash())
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/converters"
+)
+
+// ValidateBasic checks that the vesting schedule is well formed; i.e. that the heights are ordered
+// and that the periods of a periodic schedule add up to its original amount and end height.
+func (v *VestingSchedule) ValidateBasic() error {
+	originalAmount, err := converters.StringToBigInt(v.GetOriginalAmount())
+	if err != nil {
+		return fmt.Errorf("invalid vesting original amount %q: %w", v.GetOriginalAmount(), err)
+	}
+	if originalAmount.Sign() < 0 {
+		return fmt.Errorf("negative vesting original amount %q", v.GetOriginalAmount())
+	}
+	if v.GetStartHeight() < 0 || v.GetEndHeight() < v.GetStartHeight() {
+		return fmt.Errorf("invalid vesting heights: start %d, end %d", v.GetStartHeight(), v.GetEndHeight())
+	}
+	switch v.GetVestingType() {
+	case VestingType_VESTING_TYPE_DELAYED, VestingType_VESTING_TYPE_CONTINUOUS:
+		if len(v.GetPeriods()) != 0 {
+			return fmt.Errorf("vesting periods are only allowed on periodic schedules")
+		}
+	case VestingType_VESTING_TYPE_PERIODIC:
+		if len(v.GetPeriods()) == 0 {
+			return fmt.Errorf("periodic vesting schedule has no periods")
+		}
+		total := big.NewInt(0)
+		endHeight := v.GetStartHeight()
+		for _, period := range v.GetPeriods() {
+			if period.GetLength() <= 0 {
+				return fmt.Errorf("invalid vesting period length %d", period.GetLength())
+			}
+			amount, err := converters.StringToBigInt(period.GetAmount())
+			if err != nil {
+				return fmt.Errorf("invalid vesting period amount %q: %w", period.GetAmount(), err)
+			}
+			if amount.Sign() < 0 {
+				return fmt.Errorf("negative vesting period amount %q", period.GetAmount())
+			}
+			total.Add(total, amount)
+			endHeight += period.GetLength()
+		}
+		if total.Cmp(originalAmount) != 0 {
+			return fmt.Errorf("vesting periods add up to %s instead of %s", converters.BigIntToString(total), v.GetOriginalAmount())
+		}
+		if endHeight != v.GetEndHeight() {
+			return fmt.Errorf("vesting periods end at height %d instead of %d", endHeight, v.GetEndHeight())
+		}
+	default:
+		return fmt.Errorf("unknown vesting type %s", v.GetVestingType())
+	}
+	return nil
+}
+
+// LockedAmount returns the part of the original amount that has not vested yet at the given height.
+// A nil schedule has nothing locked.
+func (v *VestingSchedule) LockedAmount(height int64) (*big.Int, error) {
+	if v == nil {
+		return big.NewInt(0), nil
+	}
+	originalAmount, err := converters.StringToBigInt(v.GetOriginalAmount())
+	if err != nil {
+		return nil, err
+	}
+	if height >= v.GetEndHeight() {
+		return big.NewInt(0), nil
+	}
+	if height < v.GetStartHeight() {
+		return originalAmount, nil
+	}
+	switch v.GetVestingType() {
+	case VestingType_VESTING_TYPE_DELAYED:
+		return originalAmount, nil
+	case VestingType_VESTING_TYPE_CONTINUOUS:
+		// locked = original * (end - height) / (end - start), rounded up so that nothing unlocks early
+		remaining := big.NewInt(v.GetEndHeight() - height)
+		duration := big.NewInt(v.GetEndHeight() - v.GetStartHeight())
+		locked := new(big.Int).Mul(originalAmount, remaining)
+		locked.Add(locked, new(big.Int).Sub(duration, big.NewInt(1)))
+		return locked.Quo(locked, duration), nil
+	case VestingType_VESTING_TYPE_PERIODIC:
+		locked := new(big.Int).Set(originalAmount)
+		periodEnd := v.GetStartHeight()
+		for _, period := range v.GetPeriods() {
+			periodEnd += period.GetLength()
+			if height < periodEnd {
+				break
+			}
+			amount, err := converters.StringToBigInt(period.GetAmount())
+			if err != nil {
+				return nil, err
+			}
+			locked.Sub(locked, amount)
+		}
+		return locked, nil
+	default:
+		return nil, fmt.Errorf("unknown vesting type %s", v.GetVestingType())
+	}
+}
+
+// VestedAmount returns the part of the original amount that has vested at the given height
+func (v *VestingSchedule) VestedAmount(height int64) (*big.Int, error) {
+	if v == nil {
+		return big.NewInt(0), nil
+	}
+	originalAmount, err := converters.StringToBigInt(v.GetOriginalAmount())
+	if err != nil {
+		return nil, err
+	}
+	locked, err := v.LockedAmount(height)
+	if err != nil {
+		return nil, err
+	}
+	return originalAmount.Sub(originalAmount, locked), nil
+}
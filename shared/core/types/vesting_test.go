@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingSchedule_LockedAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *VestingSchedule
+		height   int64
+		locked   string
+	}{
+		{"no schedule", nil, 5, "0"},
+		{"delayed before start", delayedSchedule(), 5, "1000"},
+		{"delayed before end", delayedSchedule(), 19, "1000"},
+		{"delayed at end", delayedSchedule(), 20, "0"},
+		{"continuous at start", continuousSchedule(), 10, "1000"},
+		{"continuous halfway", continuousSchedule(), 15, "500"},
+		{"continuous partially vested", continuousSchedule(), 17, "300"},
+		{"continuous at end", continuousSchedule(), 20, "0"},
+		{"periodic first period", periodicSchedule(), 12, "1000"},
+		{"periodic after first period", periodicSchedule(), 13, "900"},
+		{"periodic after second period", periodicSchedule(), 17, "400"},
+		{"periodic at end", periodicSchedule(), 20, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.schedule != nil {
+				require.NoError(t, tt.schedule.ValidateBasic())
+			}
+			locked, err := tt.schedule.LockedAmount(tt.height)
+			require.NoError(t, err)
+			require.Equal(t, tt.locked, locked.String())
+		})
+	}
+}
+
+func TestVestingSchedule_ValidateBasic(t *testing.T) {
+	schedule := periodicSchedule()
+	schedule.OriginalAmount = "999"
+	require.Error(t, schedule.ValidateBasic(), "the periods must add up to the original amount")
+
+	schedule = periodicSchedule()
+	schedule.EndHeight = 21
+	require.Error(t, schedule.ValidateBasic(), "the periods must add up to the end height")
+
+	schedule = continuousSchedule()
+	schedule.EndHeight = 5
+	require.Error(t, schedule.ValidateBasic(), "the end height must not precede the start height")
+
+	schedule = delayedSchedule()
+	schedule.Periods = periodicSchedule().Periods
+	require.Error(t, schedule.ValidateBasic(), "only periodic schedules have periods")
+}
+
+func delayedSchedule() *VestingSchedule {
+	return &VestingSchedule{
+		VestingType:    VestingType_VESTING_TYPE_DELAYED,
+		OriginalAmount: "1000",
+		StartHeight:    10,
+		EndHeight:      20,
+	}
+}
+
+func continuousSchedule() *VestingSchedule {
+	return &VestingSchedule{
+		VestingType:    VestingType_VESTING_TYPE_CONTINUOUS,
+		OriginalAmount: "1000",
+		StartHeight:    10,
+		EndHeight:      20,
+	}
+}
+
+func periodicSchedule() *VestingSchedule {
+	return &VestingSchedule{
+		VestingType:    VestingType_VESTING_TYPE_PERIODIC,
+		OriginalAmount: "1000",
+		StartHeight:    10,
+		EndHeight:      20,
+		Periods: []*VestingPeriod{
+			{Length: 3, Amount: "100"},
+			{Length: 4, Amount: "500"},
+			{Length: 3, Amount: "400"},
+		},
+	}
+}
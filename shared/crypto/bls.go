@@ -0,0 +1,181 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// BLS signatures over BLS12-381 with public keys in G1 and signatures in G2, following the proof of possession
+// scheme of the IETF BLS signature draft. Signatures of the same message by different keys can be aggregated into
+// a single signature that is verified against the aggregate of the public keys, which is only secure if every
+// public key was registered with a proof of possession of its private key.
+
+const (
+	BLSPublicKeyLen = 48
+	BLSSignatureLen = 96
+)
+
+var (
+	blsSignatureDST  = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	blsPossessionDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	blsKeyGenSalt    = []byte("POKT_BLS_KEYGEN")
+)
+
+type BLSPrivateKey struct {
+	scalar *big.Int
+}
+
+// NewBLSPrivateKeyFromSeed deterministically derives a BLS private key from a seed, which allows validators to
+// derive their BLS key from the seed of their ed25519 key instead of managing a second secret
+func NewBLSPrivateKeyFromSeed(seed []byte) (*BLSPrivateKey, error) {
+	if len(seed) < SeedSize {
+		return nil, ErrInvalidPrivateKeySeedLenError(len(seed))
+	}
+	order := bls12381.NewG1().Q()
+	counter := make([]byte, 4)
+	for i := uint32(0); ; i++ {
+		binary.BigEndian.PutUint32(counter, i)
+		hasher := sha256.New()
+		hasher.Write(blsKeyGenSalt)
+		hasher.Write(seed)
+		hasher.Write(counter)
+		scalar := new(big.Int).SetBytes(hasher.Sum(nil))
+		scalar.Mod(scalar, order)
+		if scalar.Sign() != 0 {
+			return &BLSPrivateKey{scalar: scalar}, nil
+		}
+	}
+}
+
+// NewBLSPrivateKeyFromPrivateKey derives the BLS private key of the owner of an ed25519 private key
+func NewBLSPrivateKeyFromPrivateKey(privKey PrivateKey) (*BLSPrivateKey, error) {
+	return NewBLSPrivateKeyFromSeed(privKey.Seed())
+}
+
+// PublicKey returns the compressed public key of the private key
+func (k *BLSPrivateKey) PublicKey() []byte {
+	g1 := bls12381.NewG1()
+	return g1.ToCompressed(g1.MulScalarBig(g1.New(), g1.One(), k.scalar))
+}
+
+// Sign returns the compressed signature of the message
+func (k *BLSPrivateKey) Sign(msg []byte) ([]byte, error) {
+	return k.sign(msg, blsSignatureDST)
+}
+
+// ProvePossession returns the proof that the owner of the public key knows its private key, which must be
+// verified before the public key is used to verify aggregate signatures
+func (k *BLSPrivateKey) ProvePossession() ([]byte, error) {
+	return k.sign(k.PublicKey(), blsPossessionDST)
+}
+
+func (k *BLSPrivateKey) sign(msg, dst []byte) ([]byte, error) {
+	g2 := bls12381.NewG2()
+	point, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return nil, ErrCreateBLSSignature(err)
+	}
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), point, k.scalar)), nil
+}
+
+// ValidateBLSPublicKey checks that the bytes are a valid compressed public key
+func ValidateBLSPublicKey(pubKey []byte) error {
+	_, err := newBLSPublicKeyPoint(pubKey)
+	return err
+}
+
+// VerifyBLSSignature verifies the signature of a message by the owner of the public key
+func VerifyBLSSignature(pubKey, msg, signature []byte) bool {
+	return VerifyBLSAggregateSignature([][]byte{pubKey}, msg, signature)
+}
+
+// VerifyBLSPossession verifies the proof that the owner of the public key knows its private key
+func VerifyBLSPossession(pubKey, proof []byte) bool {
+	point, err := newBLSPublicKeyPoint(pubKey)
+	if err != nil {
+		return false
+	}
+	return verifyBLSPairing(point, pubKey, proof, blsPossessionDST)
+}
+
+// AggregateBLSSignatures aggregates the signatures into a single signature of the same size
+func AggregateBLSSignatures(signatures [][]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, ErrEmptyBLSAggregate()
+	}
+	g2 := bls12381.NewG2()
+	aggregate := g2.Zero()
+	for _, signature := range signatures {
+		point, err := newBLSSignaturePoint(signature)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(aggregate, aggregate, point)
+	}
+	return g2.ToCompressed(aggregate), nil
+}
+
+// VerifyBLSAggregateSignature verifies that the aggregate signature was produced by the owners of all the public
+// keys signing the same message. The public keys must have been registered with a proof of possession.
+func VerifyBLSAggregateSignature(pubKeys [][]byte, msg, aggregateSignature []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+	g1 := bls12381.NewG1()
+	aggregatePubKey := g1.Zero()
+	for _, pubKey := range pubKeys {
+		point, err := newBLSPublicKeyPoint(pubKey)
+		if err != nil {
+			return false
+		}
+		g1.Add(aggregatePubKey, aggregatePubKey, point)
+	}
+	return verifyBLSPairing(aggregatePubKey, msg, aggregateSignature, blsSignatureDST)
+}
+
+// verifyBLSPairing checks that e(pubKey, H(msg)) == e(G1, signature)
+func verifyBLSPairing(pubKey *bls12381.PointG1, msg, signature, dst []byte) bool {
+	sigPoint, err := newBLSSignaturePoint(signature)
+	if err != nil {
+		return false
+	}
+	g2 := bls12381.NewG2()
+	msgPoint, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewEngine()
+	engine.AddPair(pubKey, msgPoint)
+	engine.AddPairInv(bls12381.NewG1().One(), sigPoint)
+	return engine.Check()
+}
+
+// The points are checked to be in the correct subgroup when they are decompressed
+func newBLSPublicKeyPoint(pubKey []byte) (*bls12381.PointG1, error) {
+	if len(pubKey) != BLSPublicKeyLen {
+		return nil, ErrInvalidBLSPublicKeyLen(len(pubKey))
+	}
+	g1 := bls12381.NewG1()
+	point, err := g1.FromCompressed(pubKey)
+	if err != nil {
+		return nil, ErrCreateBLSPublicKey(err)
+	}
+	if g1.IsZero(point) {
+		return nil, ErrCreateBLSPublicKey(errBLSIdentityPoint)
+	}
+	return point, nil
+}
+
+func newBLSSignaturePoint(signature []byte) (*bls12381.PointG2, error) {
+	if len(signature) != BLSSignatureLen {
+		return nil, ErrInvalidBLSSignatureLen(len(signature))
+	}
+	point, err := bls12381.NewG2().FromCompressed(signature)
+	if err != nil {
+		return nil, ErrCreateBLSSignature(err)
+	}
+	return point, nil
+}
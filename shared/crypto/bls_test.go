@@ -0,0 +1,100 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLS_SignAndVerify(t *testing.T) {
+	blsKey := newTestBLSPrivateKey(t)
+	pubKey := blsKey.PublicKey()
+	require.Len(t, pubKey, BLSPublicKeyLen)
+	require.NoError(t, ValidateBLSPublicKey(pubKey))
+
+	msg := []byte("message")
+	signature, err := blsKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, signature, BLSSignatureLen)
+
+	require.True(t, VerifyBLSSignature(pubKey, msg, signature))
+	require.False(t, VerifyBLSSignature(pubKey, []byte("other message"), signature))
+	require.False(t, VerifyBLSSignature(newTestBLSPrivateKey(t).PublicKey(), msg, signature))
+}
+
+func TestBLS_KeyIsDerivedFromPrivateKey(t *testing.T) {
+	privKey, err := GeneratePrivateKey()
+	require.NoError(t, err)
+
+	blsKey1, err := NewBLSPrivateKeyFromPrivateKey(privKey)
+	require.NoError(t, err)
+	blsKey2, err := NewBLSPrivateKeyFromPrivateKey(privKey)
+	require.NoError(t, err)
+	require.Equal(t, blsKey1.PublicKey(), blsKey2.PublicKey())
+
+	_, err = NewBLSPrivateKeyFromSeed([]byte("short"))
+	require.Error(t, err)
+}
+
+func TestBLS_ProofOfPossession(t *testing.T) {
+	blsKey := newTestBLSPrivateKey(t)
+	proof, err := blsKey.ProvePossession()
+	require.NoError(t, err)
+	require.True(t, VerifyBLSPossession(blsKey.PublicKey(), proof))
+
+	// A proof of possession is not a signature of the public key and cannot be replayed for another key
+	signature, err := blsKey.Sign(blsKey.PublicKey())
+	require.NoError(t, err)
+	require.False(t, VerifyBLSPossession(blsKey.PublicKey(), signature))
+	require.False(t, VerifyBLSPossession(newTestBLSPrivateKey(t).PublicKey(), proof))
+}
+
+func TestBLS_AggregateSignature(t *testing.T) {
+	msg := []byte("message")
+	numSigners := 7
+
+	pubKeys := make([][]byte, 0, numSigners)
+	signatures := make([][]byte, 0, numSigners)
+	for i := 0; i < numSigners; i++ {
+		blsKey := newTestBLSPrivateKey(t)
+		signature, err := blsKey.Sign(msg)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, blsKey.PublicKey())
+		signatures = append(signatures, signature)
+	}
+
+	aggregateSignature, err := AggregateBLSSignatures(signatures)
+	require.NoError(t, err)
+	require.Len(t, aggregateSignature, BLSSignatureLen)
+	require.True(t, VerifyBLSAggregateSignature(pubKeys, msg, aggregateSignature))
+
+	// The aggregate is only valid for the exact set of signers
+	require.False(t, VerifyBLSAggregateSignature(pubKeys[1:], msg, aggregateSignature))
+	require.False(t, VerifyBLSAggregateSignature(append(pubKeys, newTestBLSPrivateKey(t).PublicKey()), msg, aggregateSignature))
+	require.False(t, VerifyBLSAggregateSignature(pubKeys, []byte("other message"), aggregateSignature))
+	require.False(t, VerifyBLSAggregateSignature(nil, msg, aggregateSignature))
+
+	_, err = AggregateBLSSignatures(nil)
+	require.Error(t, err)
+}
+
+func TestBLS_InvalidEncodings(t *testing.T) {
+	require.Error(t, ValidateBLSPublicKey(make([]byte, BLSPublicKeyLen-1)))
+	require.Error(t, ValidateBLSPublicKey(make([]byte, BLSPublicKeyLen)))
+
+	// The compressed point at infinity is rejected as a public key
+	identity := make([]byte, BLSPublicKeyLen)
+	identity[0] = 0xc0
+	require.Error(t, ValidateBLSPublicKey(identity))
+
+	_, err := AggregateBLSSignatures([][]byte{make([]byte, BLSSignatureLen)})
+	require.Error(t, err)
+}
+
+func newTestBLSPrivateKey(t *testing.T) *BLSPrivateKey {
+	privKey, err := GeneratePrivateKey()
+	require.NoError(t, err)
+	blsKey, err := NewBLSPrivateKeyFromPrivateKey(privKey)
+	require.NoError(t, err)
+	return blsKey
+}
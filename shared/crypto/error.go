@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ed25519"
+	"errors"
 	"fmt"
 )
 
@@ -14,8 +15,15 @@ const (
 	CreatePrivateKeyError         = "an error occurred creating the private key"
 	InvalidPublicKeyLenError      = "the public key length is not valid"
 	CreatePublicKeyError          = "an error occurred creating the public key"
+	InvalidBLSPublicKeyLenError   = "the BLS public key length is not valid"
+	CreateBLSPublicKeyError       = "an error occurred creating the BLS public key"
+	InvalidBLSSignatureLenError   = "the BLS signature length is not valid"
+	CreateBLSSignatureError       = "an error occurred creating the BLS signature"
+	EmptyBLSAggregateError        = "there are no BLS signatures to aggregate"
 )
 
+var errBLSIdentityPoint = errors.New("the point at infinity is not a valid public key")
+
 func ErrInvalidAddressLen(len int) error {
 	return fmt.Errorf("%s, expected length %d, actual length %d", InvalidAddressLenError, AddressLen, len)
 }
@@ -47,3 +55,23 @@ func ErrInvalidPublicKeyLen(len int) error {
 func ErrCreatePublicKey(err error) error {
 	return fmt.Errorf("%s; %s", CreatePublicKeyError, err.Error())
 }
+
+func ErrInvalidBLSPublicKeyLen(len int) error {
+	return fmt.Errorf("%s, expected length %d, actual length: %d", InvalidBLSPublicKeyLenError, BLSPublicKeyLen, len)
+}
+
+func ErrCreateBLSPublicKey(err error) error {
+	return fmt.Errorf("%s; %s", CreateBLSPublicKeyError, err.Error())
+}
+
+func ErrInvalidBLSSignatureLen(len int) error {
+	return fmt.Errorf("%s, expected length %d, actual length: %d", InvalidBLSSignatureLenError, BLSSignatureLen, len)
+}
+
+func ErrCreateBLSSignature(err error) error {
+	return fmt.Errorf("%s; %s", CreateBLSSignatureError, err.Error())
+}
+
+func ErrEmptyBLSAggregate() error {
+	return errors.New(EmptyBLSAggregateError)
+}
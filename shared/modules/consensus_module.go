@@ -3,6 +3,7 @@ package modules
 //go:generate mockgen -source=$GOFILE -destination=./mocks/consensus_module_mock.go -aux_files=github.com/pokt-network/pocket/shared/modules=module.go
 
 import (
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/messaging"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -11,6 +12,7 @@ const (
 	ConsensusModuleName      = "consensus"
 	PacemakerModuleName      = "pacemaker"
 	LeaderElectionModuleName = "leader_election"
+	StateSyncModuleName      = "state_sync"
 )
 
 // NOTE: Consensus is the core of the replicated state machine and is driven by various asynchronous events.
@@ -20,9 +22,13 @@ type ConsensusModule interface {
 	Module
 	KeyholderModule
 	ConsensusPacemaker
+	ConsensusStateSync
 
 	// Consensus Engine Handlers
 	HandleMessage(*anypb.Any) error
+	// Handles a state sync message received from the peer at `sender`, the address it authenticated with to the P2P
+	// module, which is empty if the transport does not authenticate its peers
+	HandleStateSyncMessage(message *anypb.Any, sender string) error
 	// TODO(gokhan): move it into a debug module
 	HandleDebugMessage(*messaging.DebugMessage) error
 
@@ -49,6 +55,7 @@ type ConsensusPacemaker interface {
 
 	// Communicators
 	BroadcastMessageToValidators(*anypb.Any) error
+	BroadcastTimeoutMessage() error // Signs and broadcasts a TIMEOUT message for the current round
 
 	// Leader helpers
 	IsLeader() bool
@@ -60,3 +67,21 @@ type ConsensusPacemaker interface {
 	GetPrepareQC() (*anypb.Any, error)
 	GetNodeId() uint64
 }
+
+// This interface represents functions exposed by the Consensus module for StateSync specific business logic.
+// These functions are intended to only be called by the StateSync module.
+type ConsensusStateSync interface {
+	// Returns the address used by this node to identify itself to its peers
+	GetNodeAddress() string
+	// Returns the height of the next block to sync: the current height, or the height after the last committed
+	// block with chained HotStuff, which decides the heights after it before they are committed
+	GetSyncHeight() uint64
+	// Validates the commit quorum certificate of a block synced from a peer against the validator set at its
+	// height, applies and commits the certified block, and moves the node to the next height
+	ApplySyncedBlock(block *coreTypes.Block) error
+	// Hands control back to HotStuff once the node caught up with its peers
+	ResumeHotstuff()
+	// Requests the blocks whose state sync requests timed out from other peers; called by the retry timer of state
+	// sync, which does not hold the lock of the consensus module
+	RetryBlockRequests()
+}
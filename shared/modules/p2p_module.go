@@ -3,6 +3,8 @@ package modules
 //go:generate mockgen -source=$GOFILE -destination=./mocks/p2p_module_mock.go -aux_files=github.com/pokt-network/pocket/shared/modules=module.go
 
 import (
+	"time"
+
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -21,6 +23,14 @@ type P2PModule interface {
 	// A direct asynchronous
 	Send(addr cryptoPocket.Address, msg *anypb.Any) error
 
+	// Reports a message received from the network that the module found invalid (e.g. a bad signature), so the peers
+	// that relayed it are penalized
+	ReportInvalidMessage(msg *anypb.Any)
+
+	// Returns the reputation score of the peers, by address, and the end of the ban of the banned peers
+	GetPeerScores() map[string]float64
+	GetPeerBans() map[string]time.Time
+
 	// CONSIDERATION: The P2P module currently does implement a synchronous "request-response" pattern
 	//                for core business logic between nodes. Rather, all communication is done
 	//                asynchronously via a "fire-and-forget" pattern using `Send` and `Broadcast`.
@@ -7,6 +7,7 @@ import (
 	"github.com/pokt-network/pocket/runtime/genesis"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/messaging"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const PersistenceModuleName = "persistence"
@@ -21,10 +22,17 @@ type PersistenceModule interface {
 
 	// BlockStore operations
 	GetBlockStore() kvstore.KVStore
+	GetBlock(height int64) (*coreTypes.Block, error) // Returns the committed block at the given height from the block store
 	NewWriteContext() PersistenceRWContext
 
 	// Indexer Queries
 	TransactionExists(transactionHash string) (bool, error)
+	GetTransactionsByHeight(height int64) ([]TxResult, error)
+	// Returns the transactions that emitted an event of `eventType`, filtered by the attribute `key` if it is not empty
+	GetTransactionsByEvent(eventType coreTypes.EventType, key, value string) ([]TxResult, error)
+	GetBlockEvents(height int64) ([]*coreTypes.Event, error)
+	// Returns the events of `eventType` emitted by the block lifecycle hooks, filtered by the attribute `key` if it is not empty
+	GetBlockEventsByType(eventType coreTypes.EventType, key, value string) ([]*coreTypes.BlockEvent, error)
 
 	// Debugging / development only
 	HandleDebugMessage(*messaging.DebugMessage) error
@@ -63,8 +71,13 @@ type PersistenceWriteContext interface {
 	// Indexer Operations
 
 	// Block Operations
-	ComputeStateHash() (string, error)        // Update the merkle trees, computes the new state hash, and returns it
-	IndexTransaction(txResult TxResult) error // TODO(#361): Look into an approach to remove `TxResult` from shared interfaces
+	ComputeStateHash() (string, error)                   // Update the merkle trees, computes the new state hash, and returns it
+	IndexTransaction(txResult TxResult) error            // TODO(#361): Look into an approach to remove `TxResult` from shared interfaces
+	SetBlockEvents(events []*coreTypes.Event) error      // The events emitted by the block lifecycle hooks, stored with the block on commit
+	SetBlockTime(blockTime *timestamppb.Timestamp) error // The time of the block proposal, stored in its header on commit
+	// The validator set of the epoch of the block; its hash is stored in the block header, and the snapshot itself in
+	// the first block of the epoch
+	SetBlockValidatorSet(validatorSet *coreTypes.ValidatorSet) error
 
 	// Pool Operations
 	AddPoolAmount(name string, amount string) error
@@ -76,11 +89,14 @@ type PersistenceWriteContext interface {
 	AddAccountAmount(address []byte, amount string) error
 	SubtractAccountAmount(address []byte, amount string) error
 	SetAccountAmount(address []byte, amount string) error // NOTE: same as (insert)
+	SetAccountVesting(address []byte, schedule *coreTypes.VestingSchedule) error
 
 	// App Operations
 	InsertApp(address []byte, publicKey []byte, output []byte, paused bool, status int32, maxRelays string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
 	UpdateApp(address []byte, maxRelaysToAdd string, amount string, chainsToUpdate []string) error
 	SetAppStakeAmount(address []byte, stakeAmount string) error
+	RotateAppKey(address, newAddress, newPublicKey []byte) error // Moves the stake record to the address of the new key
+	SetAppOutputAddress(address, outputAddress []byte) error
 	SetAppUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetAppStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
 	SetAppPauseHeight(address []byte, height int64) error
@@ -89,6 +105,8 @@ type PersistenceWriteContext interface {
 	InsertServiceNode(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
 	UpdateServiceNode(address []byte, serviceURL string, amount string, chains []string) error
 	SetServiceNodeStakeAmount(address []byte, stakeAmount string) error
+	RotateServiceNodeKey(address, newAddress, newPublicKey []byte) error // Moves the stake record to the address of the new key
+	SetServiceNodeOutputAddress(address, outputAddress []byte) error
 	SetServiceNodeUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetServiceNodeStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
 	SetServiceNodePauseHeight(address []byte, height int64) error
@@ -97,6 +115,8 @@ type PersistenceWriteContext interface {
 	InsertFisherman(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
 	UpdateFisherman(address []byte, serviceURL string, amount string, chains []string) error
 	SetFishermanStakeAmount(address []byte, stakeAmount string) error
+	RotateFishermanKey(address, newAddress, newPublicKey []byte) error // Moves the stake record to the address of the new key
+	SetFishermanOutputAddress(address, outputAddress []byte) error
 	SetFishermanUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetFishermanStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
 	SetFishermanPauseHeight(address []byte, height int64) error
@@ -105,6 +125,8 @@ type PersistenceWriteContext interface {
 	InsertValidator(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, pausedHeight int64, unstakingHeight int64) error
 	UpdateValidator(address []byte, serviceURL string, amount string) error
 	SetValidatorStakeAmount(address []byte, stakeAmount string) error
+	RotateValidatorKey(address, newAddress, newPublicKey []byte) error // Moves the stake record to the address of the new key and clears the BLS key of the old one
+	SetValidatorOutputAddress(address, outputAddress []byte) error
 	SetValidatorUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetValidatorsStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
 	SetValidatorPauseHeight(address []byte, height int64) error
@@ -118,6 +140,26 @@ type PersistenceWriteContext interface {
 	// Flag Operations
 	InitFlags() error
 	SetFlag(paramName string, value any, enabled bool) error
+
+	// Governance Operations
+	InsertProposal(proposal *coreTypes.Proposal) error
+	SetProposalStatus(proposalId uint64, status coreTypes.ProposalStatus) error
+	InsertProposalVote(proposalId uint64, voter []byte, option coreTypes.VoteOption) error
+
+	// Delegation Operations
+	SetDelegation(delegation *coreTypes.Delegation) error
+	SetCommission(address []byte, actorType coreTypes.ActorType, percentage uint32) error
+	CopyCommissionHistory(address, newAddress []byte, actorType coreTypes.ActorType) error // Keeps the commission history of an actor whose operator key is rotated
+
+	// Fee Market Operations
+	SetBaseFee(baseFee string) error
+
+	// BLS Key Operations
+	SetValidatorBLSKey(address, publicKey, proofOfPossession []byte) error
+
+	// Replay Protection Operations
+	InsertTransactionHash(transactionHash string, maxHeight int64) error
+	PruneTransactionHashes(height int64) error // Forgets the transactions whose max height is at or below `height`
 }
 
 type PersistenceReadContext interface {
@@ -129,6 +171,13 @@ type PersistenceReadContext interface {
 	// Block Queries
 	GetLatestBlockHeight() (uint64, error)     // Returns the height of the latest block in the persistence layer
 	GetBlockHash(height int64) (string, error) // Returns the app hash corresponding to the height provided
+	// Returns the time of the committed block at the height
+	GetBlockTime(height int64) (*timestamppb.Timestamp, error)
+	// Returns the roots of the state trees committed at the height, which hash to the state hash of its block
+	GetStateTreeRoots(height int64) ([][]byte, error)
+	// Returns the proof of the account (or of its absence) in the state committed at the height; only the
+	// latest committed height can be proven
+	GetAccountProof(address []byte, height int64) (*coreTypes.StateProof, error)
 
 	// Pool Queries
 
@@ -141,6 +190,8 @@ type PersistenceReadContext interface {
 	// Returns "0" if the account does not exist
 	GetAccountAmount(address []byte, height int64) (string, error)
 	GetAllAccounts(height int64) ([]*coreTypes.Account, error)
+	// Returns nil if the account has no vesting schedule
+	GetAccountVesting(address []byte, height int64) (*coreTypes.VestingSchedule, error)
 
 	// App Queries
 	GetAllApps(height int64) ([]*coreTypes.Actor, error)
@@ -193,4 +244,30 @@ type PersistenceReadContext interface {
 	GetIntFlag(paramName string, height int64) (int, bool, error)
 	GetStringFlag(paramName string, height int64) (string, bool, error)
 	GetBytesFlag(paramName string, height int64) ([]byte, bool, error)
+	GetFlagExists(flagName string, height int64) (bool, error)
+	GetAllFlags(height int64) ([]*coreTypes.Flag, error)
+
+	// Governance Queries
+	GetNextProposalId(height int64) (uint64, error)
+	GetProposal(proposalId uint64, height int64) (*coreTypes.Proposal, error)
+	GetAllProposals(height int64) ([]*coreTypes.Proposal, error)
+	GetProposalsEndingAtHeight(votingEndHeight, height int64) ([]*coreTypes.Proposal, error) // Only returns the proposals still being voted on
+	GetProposalVotes(proposalId uint64, height int64) ([]*coreTypes.ProposalVote, error)     // Returns the latest vote of every voter
+
+	// Delegation Queries
+	GetDelegationExists(delegator, actorAddress []byte, actorType coreTypes.ActorType, height int64) (bool, error)
+	GetDelegation(delegator, actorAddress []byte, actorType coreTypes.ActorType, height int64) (*coreTypes.Delegation, error)
+	GetActorDelegations(actorAddress []byte, actorType coreTypes.ActorType, height int64) ([]*coreTypes.Delegation, error) // Excludes withdrawn delegations
+	GetDelegatorDelegations(delegator []byte, height int64) ([]*coreTypes.Delegation, error)                               // Excludes withdrawn delegations
+	GetDelegationsReadyToWithdraw(height int64) ([]*coreTypes.Delegation, error)
+	GetCommission(address []byte, actorType coreTypes.ActorType, height int64) (uint32, error) // Defaults to 0 if never set
+
+	// Fee Market Queries
+	GetBaseFee(height int64) (string, error) // Defaults to an empty string if the dynamic fee market was never active
+
+	// BLS Key Queries
+	GetValidatorBLSKey(address []byte, height int64) (*coreTypes.BLSKey, error) // Returns nil if the validator never registered a BLS key
+
+	// Replay Protection Queries
+	GetTransactionHashExists(transactionHash string, height int64) (bool, error) // Only the transactions still within their validity window at `height` are remembered
 }
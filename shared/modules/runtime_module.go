@@ -1,6 +1,8 @@
 package modules
 
 import (
+	"math/rand"
+
 	"github.com/benbjohnson/clock"
 	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/runtime/genesis"
@@ -12,4 +14,7 @@ type RuntimeMgr interface {
 	GetConfig() *configs.Config
 	GetGenesis() *genesis.GenesisState
 	GetClock() clock.Clock
+	// Returns the generator of the random choices that do not need to be secure (e.g. picking a peer), so they
+	// can be seeded while testing. It is not safe for concurrent use, so callers synchronize their access to it.
+	GetRand() *rand.Rand
 }
@@ -1,5 +1,7 @@
 package modules
 
+import coreTypes "github.com/pokt-network/pocket/shared/core/types"
+
 type IUnstakingActor interface {
 	GetAddress() []byte
 	SetAddress(address string)
@@ -19,6 +21,7 @@ type TxResult interface {
 	GetSignerAddr() string                // get the address of who signed (i.e. sent) the transaction
 	GetRecipientAddr() string             // get the address of who received the transaction; may be empty
 	GetMessageType() string               // corresponds to type of message (validator-stake, app-unjail, node-stake, etc) // IMPROVE: Add an enum for message types
+	GetEvents() []*coreTypes.Event        // the events emitted while applying the transaction
 	Hash() ([]byte, error)                // the hash of the tx bytes
 	HashFromBytes([]byte) ([]byte, error) // same operation as `Hash`, but avoid re-serializing the tx
 	Bytes() ([]byte, error)               // returns the serialized transaction bytes
@@ -3,7 +3,9 @@ package modules
 //go:generate mockgen -source=$GOFILE -destination=./mocks/utility_module_mock.go -aux_files=github.com/pokt-network/pocket/shared/modules=module.go
 
 import (
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const UtilityModuleName = "utility"
@@ -18,6 +20,18 @@ type UtilityModule interface {
 
 	// Basic Transaction validation. SIDE EFFECT: Adds the transaction to the mempool if valid.
 	CheckTransaction(tx []byte) error
+
+	// Reaps the mempool for the transactions of a block that is only applied once it is committed (i.e. by chained
+	// HotStuff), skipping the transactions in `excludedTxs`, keyed by hash, that are in blocks not committed yet
+	ReapMempool(maxTransactionBytes int, excludedTxs map[string]struct{}) ([][]byte, error)
+
+	// Validates the transactions of a block proposal that is voted on before it is applied (i.e. by chained HotStuff):
+	// they must be valid by themselves, not be committed already, and not be in the same block or in `excludedTxs`,
+	// keyed by hash, that are in the blocks not committed yet that the proposal extends
+	ValidateProposalTransactions(transactions [][]byte, excludedTxs map[string]struct{}) error
+
+	// Returns whether the mempool has no transaction to propose; used to skip empty blocks
+	IsMempoolEmpty() bool
 }
 
 // Interface defining the context within which the node can operate with the utility layer.
@@ -31,6 +45,25 @@ type UtilityContext interface {
 	// both block proposers and verifiers will use it to create a context (before finalizing it) during consensus,
 	// and all verifiers will call it during state sync.
 	SetProposalBlock(blockHash string, proposerAddr []byte, transactions [][]byte) error
+	// Sets the double sign evidence handled at the beginning of the proposal block. The block proposer
+	// sets the evidence collected by consensus, while the verifiers set the evidence of the proposed block.
+	SetProposalEvidence(evidence []*coreTypes.DoubleSignEvidence) error
+	// Sets the time of the proposal block, stored in its header on commit. The block proposer sets its local time,
+	// while the verifiers set the time of the proposed block.
+	SetProposalBlockTime(blockTime *timestamppb.Timestamp) error
+	// Makes `ApplyBlock` record the transactions of the proposal block that cannot be applied anymore (e.g. because an
+	// ancestor of the block spent the balance paying their fee) as failed results, and drop its evidence that cannot be
+	// handled anymore, instead of failing the block. Used for the blocks that are voted on before they are applied
+	// (i.e. by chained HotStuff), which every node then applies the same way.
+	SetProposalRecordFailures(recordFailures bool) error
+	// Sets the validator set of the epoch of the proposal block, committed by its header
+	SetProposalValidatorSet(validatorSet *coreTypes.ValidatorSet) error
+	// Sets the validators of the previous block whose signatures are missing from its quorum certificate; their missed
+	// blocks are counted at the beginning of the proposal block, and they are paused after `validator_max_missed_blocks`
+	SetProposalMissingSigners(missingSigners [][]byte) error
+	// Returns the evidence handled by the proposal block; after `CreateAndApplyProposalBlock`, only the
+	// evidence that is still valid at the height of the context is kept.
+	GetProposalEvidence() []*coreTypes.DoubleSignEvidence
 	// Reaps the mempool for transactions to be proposed in a new block, and applies them to this
 	// context; intended to be used by the block proposer.
 	CreateAndApplyProposalBlock(proposer []byte, maxTransactionBytes int) (stateHash string, transactions [][]byte, err error)
@@ -41,6 +74,14 @@ type UtilityContext interface {
 	// TECHDEBT: `CreateAndApplyProposalBlock` and `ApplyBlock` should be be refactored into a
 	// `GetProposalBlock` and `ApplyProposalBlock` functions
 
+	// Upgrade operations
+
+	// Returns whether the upgrade `featureName` has been activated at the height of the context;
+	// used to switch between the code paths of the different protocol versions
+	IsFeatureActive(featureName string) (bool, error)
+	// Returns an error if an upgrade unknown to this binary is active at the height of the context
+	CheckScheduledUpgrades() error
+
 	// Context operations
 
 	// Releases the utility context and any underlying contexts it references
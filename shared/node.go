@@ -1,9 +1,11 @@
 package shared
 
 import (
+	"errors"
 	"log"
 
 	"github.com/pokt-network/pocket/consensus"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/logger"
 	"github.com/pokt-network/pocket/p2p"
 	"github.com/pokt-network/pocket/persistence"
@@ -100,6 +102,14 @@ func (node *Node) Start() error {
 	for {
 		event := node.GetBus().GetBusEvent()
 		if err := node.handleEvent(event); err != nil {
+			// A node halted for an upgrade it does not support cannot make progress, so it is stopped cleanly
+			if errors.Is(err, typesCons.ErrUpgradeRequired) {
+				log.Println("Halting pocket node:", err)
+				if stopErr := node.Stop(); stopErr != nil {
+					log.Println("Error stopping pocket node:", stopErr)
+				}
+				return err
+			}
 			log.Println("Error handling event:", err)
 		}
 	}
@@ -107,7 +117,26 @@ func (node *Node) Start() error {
 
 func (node *Node) Stop() error {
 	log.Println("Stopping pocket node...")
-	return nil
+
+	// IMPORTANT: Modules are stopped in the reverse order of their startup
+
+	var firstErr error
+	for _, module := range []modules.Module{
+		node.GetBus().GetRPCModule(),
+		node.GetBus().GetConsensusModule(),
+		node.GetBus().GetUtilityModule(),
+		node.GetBus().GetP2PModule(),
+		node.GetBus().GetPersistenceModule(),
+		node.GetBus().GetTelemetryModule(),
+	} {
+		if err := module.Stop(); err != nil {
+			log.Printf("Error stopping the %s module: %v\n", module.GetModuleName(), err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
 }
 
 func (m *Node) SetBus(bus modules.Bus) {
@@ -128,6 +157,8 @@ func (node *Node) handleEvent(message *messaging.PocketEnvelope) error {
 		log.Println("[NOOP] Received NodeStartedEvent")
 	case consensus.HotstuffMessageContentType:
 		return node.GetBus().GetConsensusModule().HandleMessage(message.Content)
+	case consensus.StateSyncMessageContentType:
+		return node.GetBus().GetConsensusModule().HandleStateSyncMessage(message.Content, message.GetSender())
 	case utility.TransactionGossipMessageContentType:
 		return node.GetBus().GetUtilityModule().HandleMessage(message.Content)
 	case messaging.DebugMessageEventType:
@@ -5,14 +5,30 @@ const (
 	P2P_NODE_STARTED_TIMESERIES_METRIC_NAME        = "p2p_nodes_started_counter"
 	P2P_NODE_STARTED_TIMESERIES_METRIC_DESCRIPTION = "the counter to track the number of nodes online"
 
+	P2P_CONNECTED_PEERS_GAUGE_NAME        = "p2p_connected_peers_gauge"
+	P2P_CONNECTED_PEERS_GAUGE_DESCRIPTION = "the gauge to track the number of peers a node holds an open connection to"
+
+	P2P_BANNED_PEERS_GAUGE_NAME        = "p2p_banned_peers_gauge"
+	P2P_BANNED_PEERS_GAUGE_DESCRIPTION = "the gauge to track the number of peers a node currently bans"
+
 	// Event Metrics
 	P2P_EVENT_METRICS_NAMESPACE = "event_metrics_namespace_p2p"
 
 	P2P_BROADCAST_MESSAGE_REDUNDANCY_PER_BLOCK_EVENT_METRIC_NAME = "broadcast_message_redundancy_per_block_event_metric"
 	P2P_RAINTREE_MESSAGE_EVENT_METRIC_NAME                       = "raintree_message_event_metric"
+	P2P_PEER_CONNECTION_STATE_EVENT_METRIC_NAME                  = "peer_connection_state_event_metric"
+	P2P_PEER_SCORE_EVENT_METRIC_NAME                             = "peer_score_event_metric"
+	P2P_PEER_BANNED_EVENT_METRIC_NAME                            = "peer_banned_event_metric"
 
 	// Attributes
 	P2P_RAINTREE_MESSAGE_EVENT_METRIC_SEND_LABEL   = "send"
 	P2P_RAINTREE_MESSAGE_EVENT_METRIC_HEIGHT_LABEL = "height"
 	P2P_RAINTREE_MESSAGE_EVENT_METRIC_NONCE_LABEL  = "nonce"
+
+	P2P_PEER_CONNECTION_EVENT_METRIC_PEER_LABEL  = "peer"
+	P2P_PEER_CONNECTION_EVENT_METRIC_STATE_LABEL = "state"
+
+	P2P_PEER_SCORE_EVENT_METRIC_PEER_LABEL   = "peer"
+	P2P_PEER_SCORE_EVENT_METRIC_REASON_LABEL = "reason"
+	P2P_PEER_SCORE_EVENT_METRIC_SCORE_LABEL  = "score"
 )
@@ -3,6 +3,7 @@ package utility
 import (
 	"math"
 	"math/big"
+	"strconv"
 
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -94,6 +95,55 @@ func (u *UtilityContext) SetActorPauseHeight(actorType coreTypes.ActorType, addr
 	return nil
 }
 
+// RotateActorKey moves the stake record of the actor at `address` to the address of its new operator key
+func (u *UtilityContext) RotateActorKey(actorType coreTypes.ActorType, address, newAddress, newPublicKey []byte) typesUtil.Error {
+	store := u.Store()
+
+	var err error
+	switch actorType {
+	case coreTypes.ActorType_ACTOR_TYPE_APP:
+		err = store.RotateAppKey(address, newAddress, newPublicKey)
+	case coreTypes.ActorType_ACTOR_TYPE_FISH:
+		err = store.RotateFishermanKey(address, newAddress, newPublicKey)
+	case coreTypes.ActorType_ACTOR_TYPE_SERVICENODE:
+		err = store.RotateServiceNodeKey(address, newAddress, newPublicKey)
+	case coreTypes.ActorType_ACTOR_TYPE_VAL:
+		err = store.RotateValidatorKey(address, newAddress, newPublicKey)
+	default:
+		err = typesUtil.ErrUnknownActorType(actorType.String())
+	}
+
+	if err != nil {
+		return typesUtil.ErrRotateKey(err)
+	}
+
+	return nil
+}
+
+func (u *UtilityContext) SetActorOutputAddress(actorType coreTypes.ActorType, address, outputAddress []byte) typesUtil.Error {
+	store := u.Store()
+
+	var err error
+	switch actorType {
+	case coreTypes.ActorType_ACTOR_TYPE_APP:
+		err = store.SetAppOutputAddress(address, outputAddress)
+	case coreTypes.ActorType_ACTOR_TYPE_FISH:
+		err = store.SetFishermanOutputAddress(address, outputAddress)
+	case coreTypes.ActorType_ACTOR_TYPE_SERVICENODE:
+		err = store.SetServiceNodeOutputAddress(address, outputAddress)
+	case coreTypes.ActorType_ACTOR_TYPE_VAL:
+		err = store.SetValidatorOutputAddress(address, outputAddress)
+	default:
+		err = typesUtil.ErrUnknownActorType(actorType.String())
+	}
+
+	if err != nil {
+		return typesUtil.ErrSetOutputAddress(err)
+	}
+
+	return nil
+}
+
 // getters
 
 func (u *UtilityContext) GetActorStakedTokens(actorType coreTypes.ActorType, address []byte) (*big.Int, typesUtil.Error) {
@@ -432,6 +482,15 @@ func (u *UtilityContext) BurnActor(actorType coreTypes.ActorType, percentage int
 	if err := u.SetActorStakedTokens(actorType, newTokensAfterBurn, address); err != nil {
 		return err
 	}
+	// slash the actor's delegators by the same percentage
+	if err := u.slashDelegations(actorType, address, percentage); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_BURN,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, address),
+		actorTypeAttribute(actorType),
+		amountAttribute(truncatedTokens),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyPercentage, strconv.Itoa(percentage)))
 	// check to see if they fell below minimum stake
 	minStake, err := u.GetValidatorMinimumStake()
 	if err != nil {
@@ -446,6 +505,10 @@ func (u *UtilityContext) BurnActor(actorType coreTypes.ActorType, percentage int
 		if err := u.SetActorUnstaking(actorType, unstakingHeight, address); err != nil {
 			return err
 		}
+		u.emitEvent(coreTypes.EventType_EVENT_TYPE_UNSTAKE_BEGIN,
+			addressAttribute(coreTypes.EventAttributeKeyAddress, address),
+			actorTypeAttribute(actorType),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyUnstakingHeight, strconv.FormatInt(unstakingHeight, 10)))
 	}
 	return nil
 }
@@ -520,11 +583,6 @@ func (u *UtilityContext) CheckBelowMaxChains(actorType coreTypes.ActorType, chai
 	return nil
 }
 
-func (u *UtilityContext) GetLastBlockByzantineValidators() ([][]byte, error) {
-	// TODO(#271): Need to retrieve byzantine validators from the persistence module
-	return nil, nil
-}
-
 func (u *UtilityContext) CalculateUnstakingHeight(unstakingBlocks int64) (int64, typesUtil.Error) {
 	latestHeight, err := u.GetLatestBlockHeight()
 	if err != nil {
@@ -3,6 +3,7 @@ package utility
 import (
 	"log"
 	"math/big"
+	"strconv"
 
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/modules"
@@ -21,8 +22,9 @@ import (
 	operation that executes at the end of every block.
 */
 
-// TODO: Make sure to call `utility.CheckTransaction`, which calls `persistence.TransactionExists`
 func (u *UtilityContext) CreateAndApplyProposalBlock(proposer []byte, maxTransactionBytes int) (string, [][]byte, error) {
+	// only propose the evidence the verifiers will accept
+	u.filterProposalEvidence()
 	lastBlockByzantineVals, err := u.GetLastBlockByzantineValidators()
 	if err != nil {
 		return "", nil, err
@@ -43,6 +45,11 @@ func (u *UtilityContext) CreateAndApplyProposalBlock(proposer []byte, maxTransac
 		if err != nil {
 			return "", nil, err
 		}
+		// transactions that expired or were committed since they entered the mempool are dropped
+		txHash := typesUtil.TransactionHash(txBytes)
+		if err := u.CheckTransactionReplay(txHash, transaction); err != nil {
+			continue
+		}
 		txTxsSizeInBytes := len(txBytes)
 		totalTxsSizeInBytes += txTxsSizeInBytes
 		if totalTxsSizeInBytes >= maxTransactionBytes {
@@ -66,14 +73,21 @@ func (u *UtilityContext) CreateAndApplyProposalBlock(proposer []byte, maxTransac
 		if err := u.Context.IndexTransaction(txResult); err != nil {
 			log.Fatalf("TODO(#327): We can apply the transaction but not index it. Crash the process for now: %v\n", err)
 		}
+		if err := u.rememberTransaction(txHash, transaction); err != nil {
+			return "", nil, err
+		}
 
 		transactions = append(transactions, txBytes)
 		txIndex++
 	}
+	u.blockSizeInBytes = totalTxsSizeInBytes
 
 	if err := u.EndBlock(proposer); err != nil {
 		return "", nil, err
 	}
+	if err := u.storeBlockEvents(); err != nil {
+		return "", nil, err
+	}
 	// return the app hash (consensus module will get the validator set directly)
 	stateHash, err := u.Context.ComputeStateHash()
 	if err != nil {
@@ -84,9 +98,12 @@ func (u *UtilityContext) CreateAndApplyProposalBlock(proposer []byte, maxTransac
 	return stateHash, transactions, err
 }
 
-// TODO: Make sure to call `utility.CheckTransaction`, which calls `persistence.TransactionExists`
 // CLEANUP: code re-use ApplyBlock() for CreateAndApplyBlock()
 func (u *UtilityContext) ApplyBlock() (string, error) {
+	// the evidence of a block voted on before it is applied may not be valid anymore at its height
+	if u.proposalRecordFailures {
+		u.filterProposalEvidence()
+	}
 	lastByzantineValidators, err := u.GetLastBlockByzantineValidators()
 	if err != nil {
 		return "", err
@@ -106,12 +123,10 @@ func (u *UtilityContext) ApplyBlock() (string, error) {
 		if err := tx.ValidateBasic(); err != nil {
 			return "", err
 		}
-		// TODO(#346): Currently, the pattern is allowing nil err with an error transaction...
-		//             Should we terminate applyBlock immediately if there's an invalid transaction?
-		//             Or wait until the entire lifecycle is over to evaluate an 'invalid' block
+		txHash := typesUtil.TransactionHash(transactionProtoBytes)
 
 		// Validate and apply the transaction to the Postgres database
-		txResult, err := u.ApplyTransaction(index, tx)
+		txResult, err := u.applyBlockTransaction(index, txHash, tx)
 		if err != nil {
 			return "", err
 		}
@@ -119,6 +134,10 @@ func (u *UtilityContext) ApplyBlock() (string, error) {
 		if err := u.Context.IndexTransaction(txResult); err != nil {
 			log.Fatalf("TODO(#327): We can apply the transaction but not index it. Crash the process for now: %v\n", err)
 		}
+		if err := u.rememberTransaction(txHash, tx); err != nil {
+			return "", err
+		}
+		u.blockSizeInBytes += len(transactionProtoBytes)
 
 		// TODO: if found, remove transaction from mempool.
 		// DISCUSS: What if the context is rolled back or cancelled. Do we add it back to the mempool?
@@ -131,6 +150,9 @@ func (u *UtilityContext) ApplyBlock() (string, error) {
 	if err := u.EndBlock(u.proposalProposerAddr); err != nil {
 		return "", err
 	}
+	if err := u.storeBlockEvents(); err != nil {
+		return "", err
+	}
 	// return the app hash (consensus module will get the validator set directly)
 	stateHash, err := u.Context.ComputeStateHash()
 	if err != nil {
@@ -143,10 +165,44 @@ func (u *UtilityContext) ApplyBlock() (string, error) {
 	return stateHash, nil
 }
 
+// applyBlockTransaction applies a transaction of the proposal block. A transaction that fails the replay protection or
+// the ante handler (e.g. its signer cannot pay the fee) fails the block, unless the context records failures, in which
+// case it is recorded as a failed result without being applied. A transaction that was already committed always fails
+// the block, since it cannot be indexed twice.
+func (u *UtilityContext) applyBlockTransaction(index int, txHash string, tx *typesUtil.Transaction) (modules.TxResult, typesUtil.Error) {
+	txErr := u.CheckTransactionReplay(txHash, tx)
+	if txErr == nil {
+		txResult, err := u.ApplyTransaction(index, tx)
+		if err == nil {
+			return txResult, nil
+		}
+		txErr = err
+	}
+	if !u.proposalRecordFailures || txErr.Code() == typesUtil.CodeTransactionAlreadyCommittedError {
+		return nil, txErr
+	}
+	msg, err := tx.Message()
+	if err != nil {
+		return nil, err
+	}
+	return tx.ToTxResult(u.Height, index, "", msg.GetMessageRecipient(), msg.GetMessageName(), nil, txErr)
+}
+
 func (u *UtilityContext) BeginBlock(previousBlockByzantineValidators [][]byte) typesUtil.Error {
+	// refuse to apply the block if it activates an upgrade this binary does not implement
+	if err := u.checkScheduledUpgrades(); err != nil {
+		return err
+	}
 	if err := u.HandleByzantineValidators(previousBlockByzantineValidators); err != nil {
 		return err
 	}
+	if err := u.HandleMissedBlocks(u.proposalMissingSigners); err != nil {
+		return err
+	}
+	// the double signs handled in this block cannot be handled again
+	if err := u.rememberProposalEvidence(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -159,15 +215,53 @@ func (u *UtilityContext) EndBlock(proposer []byte) typesUtil.Error {
 	if err := u.UnstakeActorsThatAreReady(); err != nil {
 		return err
 	}
+	// return the delegations that have been 'unbonding' for the <Actor>UnstakingBlocks to their delegators
+	if err := u.WithdrawUnbondedDelegations(); err != nil {
+		return err
+	}
 	// begin unstaking the actors who have been paused for MaxPauseBlocks
 	if err := u.BeginUnstakingMaxPaused(); err != nil {
 		return err
 	}
+	// tally the governance proposals whose voting period ends at this height
+	if err := u.HandleProposalTallies(); err != nil {
+		return err
+	}
+	// adjust the base fee of the next block to how full this one was
+	if err := u.UpdateBaseFee(u.blockSizeInBytes); err != nil {
+		return err
+	}
+	// forget the transactions that cannot be included in the next block anymore
+	if err := u.PruneExpiredTransactionHashes(); err != nil {
+		return err
+	}
 	return nil
 }
 
-// HandleByzantineValidators handles the validators who either didn't sign at all or disagreed with the 2/3+ majority
+// HandleByzantineValidators burns the stake of the validators proven to have double signed
 func (u *UtilityContext) HandleByzantineValidators(lastBlockByzantineValidators [][]byte) typesUtil.Error {
+	burnPercentage, err := u.GetDoubleSignBurnPercentage()
+	if err != nil {
+		return err
+	}
+	for _, address := range lastBlockByzantineValidators {
+		u.emitEvent(coreTypes.EventType_EVENT_TYPE_DOUBLE_SIGN,
+			addressAttribute(coreTypes.EventAttributeKeyAddress, address))
+		if err := u.BurnActor(coreTypes.ActorType_ACTOR_TYPE_VAL, burnPercentage, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *UtilityContext) SetProposalMissingSigners(missingSigners [][]byte) error {
+	u.proposalMissingSigners = missingSigners
+	return nil
+}
+
+// HandleMissedBlocks handles the validators who didn't sign the previous block; the validators that are not staked
+// or already paused anymore (e.g. they rotated their key or were paused by the previous block) are skipped
+func (u *UtilityContext) HandleMissedBlocks(lastBlockMissingSigners [][]byte) typesUtil.Error {
 	latestBlockHeight, err := u.GetLatestBlockHeight()
 	if err != nil {
 		return err
@@ -176,19 +270,40 @@ func (u *UtilityContext) HandleByzantineValidators(lastBlockByzantineValidators
 	if err != nil {
 		return err
 	}
-	for _, address := range lastBlockByzantineValidators {
+	for _, address := range lastBlockMissingSigners {
+		exists, err := u.GetActorExists(coreTypes.ActorType_ACTOR_TYPE_VAL, address)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		pauseHeight, err := u.GetPauseHeight(coreTypes.ActorType_ACTOR_TYPE_VAL, address)
+		if err != nil {
+			return err
+		}
+		if pauseHeight != typesUtil.HeightNotUsed {
+			continue
+		}
 		numberOfMissedBlocks, err := u.GetValidatorMissedBlocks(address)
 		if err != nil {
 			return err
 		}
 		// increment missed blocks
 		numberOfMissedBlocks++
+		u.emitEvent(coreTypes.EventType_EVENT_TYPE_MISSED_BLOCK,
+			addressAttribute(coreTypes.EventAttributeKeyAddress, address),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyMissedBlocks, strconv.Itoa(numberOfMissedBlocks)))
 		// handle if over the threshold
 		if numberOfMissedBlocks >= maxMissedBlocks {
 			// pause the validator and reset missed blocks
 			if err = u.PauseValidatorAndSetMissedBlocks(address, latestBlockHeight, int(typesUtil.HeightNotUsed)); err != nil {
 				return err
 			}
+			u.emitEvent(coreTypes.EventType_EVENT_TYPE_PAUSE,
+				addressAttribute(coreTypes.EventAttributeKeyAddress, address),
+				actorTypeAttribute(coreTypes.ActorType_ACTOR_TYPE_VAL),
+				coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyHeight, strconv.FormatInt(latestBlockHeight, 10)))
 			// burn validator for missing blocks
 			burnPercentage, err := u.GetMissedBlocksBurnPercentage()
 			if err != nil {
@@ -241,6 +356,11 @@ func (u *UtilityContext) UnstakeActorsThatAreReady() (err typesUtil.Error) {
 			if err = u.AddAccountAmountString(actor.GetOutputAddress(), actor.GetStakeAmount()); err != nil {
 				return err
 			}
+			u.emitEvent(coreTypes.EventType_EVENT_TYPE_UNSTAKE_COMPLETE,
+				addressAttribute(coreTypes.EventAttributeKeyAddress, actor.GetAddress()),
+				actorTypeAttribute(actorType),
+				coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, actor.GetStakeAmount()),
+				addressAttribute(coreTypes.EventAttributeKeyOutputAddress, actor.GetOutputAddress()))
 		}
 	}
 	return nil
@@ -317,7 +437,8 @@ func (u *UtilityContext) HandleProposalRewards(proposer []byte) typesUtil.Error
 	amountToProposerFloat.Quo(amountToProposerFloat, big.NewFloat(100))
 	amountToProposer, _ := amountToProposerFloat.Int(nil)
 	amountToDAO := feesAndRewardsCollected.Sub(feesAndRewardsCollected, amountToProposer)
-	if err = u.AddAccountAmount(proposer, amountToProposer); err != nil {
+	// the proposer shares its cut with its delegators
+	if err = u.distributeActorRewards(coreTypes.ActorType_ACTOR_TYPE_VAL, proposer, amountToProposer); err != nil {
 		return err
 	}
 	if err = u.AddPoolAmount(coreTypes.Pools_POOLS_DAO.FriendlyName(), amountToDAO); err != nil {
@@ -0,0 +1,39 @@
+package utility
+
+import (
+	"sort"
+	"time"
+
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The number of committed blocks the time of the chain is the median of; a proposer cannot move the time of the
+// chain unless it proposed the majority of these blocks
+const medianBlockTimeSpan = 11
+
+func (u *UtilityContext) SetProposalBlockTime(blockTime *timestamppb.Timestamp) error {
+	return u.Context.SetBlockTime(blockTime)
+}
+
+// GetMedianBlockTime returns the median time of the last committed blocks. Unlike the time of a single block, it is
+// not chosen by the proposer of the block, so the utility logic should use it as the time of the chain.
+func (u *UtilityContext) GetMedianBlockTime() (time.Time, typesUtil.Error) {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return time.Time{}, err
+	}
+	blockTimes := make([]time.Time, 0, medianBlockTimeSpan)
+	for h := height - 1; h >= 0 && len(blockTimes) < medianBlockTimeSpan; h-- {
+		blockTime, er := store.GetBlockTime(h)
+		if er != nil {
+			return time.Time{}, typesUtil.ErrGetBlockTime(er)
+		}
+		blockTimes = append(blockTimes, blockTime.AsTime())
+	}
+	if len(blockTimes) == 0 {
+		return time.Time{}, nil
+	}
+	sort.Slice(blockTimes, func(i, j int) bool { return blockTimes[i].Before(blockTimes[j]) })
+	return blockTimes[len(blockTimes)/2], nil
+}
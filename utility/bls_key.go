@@ -0,0 +1,43 @@
+package utility
+
+import (
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'bls_key' file contains the logic to register the BLS key of a validator, which is used by consensus
+	to verify the aggregate signatures of quorum certificates.
+
+	Aggregate BLS signatures are only secure against rogue key attacks if every key was registered with a
+	proof of possession of its private key, which is verified statelessly in `ValidateBasic`. Registering a
+	new key replaces the previous one from the next height.
+*/
+
+func (u *UtilityContext) HandleMessageRegisterBLSKey(message *typesUtil.MessageRegisterBLSKey) typesUtil.Error {
+	exists, err := u.GetActorExists(coreTypes.ActorType_ACTOR_TYPE_VAL, message.Address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return typesUtil.ErrNotExists()
+	}
+	store, _, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	if er := store.SetValidatorBLSKey(message.Address, message.PublicKey, message.ProofOfPossession); er != nil {
+		return typesUtil.ErrSetBLSKey(er)
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_REGISTER_BLS_KEY,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address))
+	return nil
+}
+
+func (u *UtilityContext) GetMessageRegisterBLSKeySignerCandidates(msg *typesUtil.MessageRegisterBLSKey) ([][]byte, typesUtil.Error) {
+	output, err := u.GetActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_VAL, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{output, msg.Address}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 
 	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/modules"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
@@ -20,6 +21,18 @@ type UtilityContext struct {
 	proposalProposerAddr []byte
 	proposalStateHash    string
 	proposalBlockTxs     [][]byte
+	proposalEvidence     []*coreTypes.DoubleSignEvidence
+	// The validators of the previous block missing from its quorum certificate; see `SetProposalMissingSigners`
+	proposalMissingSigners [][]byte
+	// Whether the transactions and evidence of the proposal block that cannot be applied are recorded as failures
+	// instead of failing the block; see `SetProposalRecordFailures`
+	proposalRecordFailures bool
+
+	// The total size of the transactions applied in the block; used to adjust the base fee of the dynamic fee market
+	blockSizeInBytes int
+
+	// The events emitted since they were last taken; see `takeEvents`
+	events []*coreTypes.Event
 }
 
 // IMPROVE: Consider renaming to `persistenceContext` or `storeContext`?
@@ -54,6 +67,15 @@ func (p *UtilityContext) SetProposalBlock(blockHash string, proposerAddr []byte,
 	return nil
 }
 
+func (u *UtilityContext) SetProposalRecordFailures(recordFailures bool) error {
+	u.proposalRecordFailures = recordFailures
+	return nil
+}
+
+func (u *UtilityContext) SetProposalValidatorSet(validatorSet *coreTypes.ValidatorSet) error {
+	return u.Context.SetBlockValidatorSet(validatorSet)
+}
+
 func (u *UtilityContext) Store() *Context {
 	return u.Context
 }
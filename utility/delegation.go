@@ -0,0 +1,363 @@
+package utility
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'delegation' file contains the stake delegation logic.
+
+	An account delegates tokens to a validator or service node, which are held in the delegation pool.
+	The rewards earned by the actor are split pro-rata between its own stake and the bonded delegations,
+	and the actor keeps its commission percentage of the delegators' share. The commission cannot be raised by
+	more than `commission_max_change_percentage` over any `commission_change_period_blocks`, so that an actor
+	cannot take most of the rewards of its delegators before they are able to undelegate.
+
+	Undelegating starts an unbonding period of the actor type's unstaking blocks, after which the tokens
+	are returned to the delegator in `EndBlock`. Delegations, including unbonding ones, are slashed by
+	the same percentage as the actor they are delegated to.
+*/
+
+func (u *UtilityContext) HandleMessageDelegate(message *typesUtil.MessageDelegate) typesUtil.Error {
+	amount, err := typesUtil.StringToBigInt(message.Amount)
+	if err != nil {
+		return err
+	}
+	exists, err := u.GetActorExists(message.ActorType, message.ActorAddress)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return typesUtil.ErrNotExists()
+	}
+	status, err := u.GetActorStatus(message.ActorType, message.ActorAddress)
+	if err != nil {
+		return err
+	}
+	if status != int32(typesUtil.StakeStatus_Staked) {
+		return typesUtil.ErrInvalidStatus(status, int32(typesUtil.StakeStatus_Staked))
+	}
+	delegation, err := u.getDelegation(message.Delegator, message.ActorAddress, message.ActorType)
+	if err != nil {
+		return err
+	}
+	switch {
+	case delegation == nil, delegation.Status == coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN:
+		delegation = &coreTypes.Delegation{
+			Delegator:       hex.EncodeToString(message.Delegator),
+			ActorAddress:    hex.EncodeToString(message.ActorAddress),
+			ActorType:       message.ActorType,
+			Amount:          typesUtil.BigIntToString(big.NewInt(0)),
+			Status:          coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED,
+			UnbondingHeight: typesUtil.HeightNotUsed,
+		}
+	case delegation.Status == coreTypes.DelegationStatus_DELEGATION_STATUS_UNBONDING:
+		return typesUtil.ErrDelegationNotBonded(delegation.Delegator, delegation.ActorAddress)
+	}
+	// ensure the delegator has sufficient funding for the delegation
+	delegatorAccountAmount, err := u.GetAccountAmount(message.Delegator)
+	if err != nil {
+		return err
+	}
+	delegatorAccountAmount.Sub(delegatorAccountAmount, amount)
+	if delegatorAccountAmount.Sign() == -1 {
+		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.Delegator))
+	}
+	if err := u.checkUnlockedAmount(message.Delegator, amount); err != nil {
+		return err
+	}
+	delegatedAmount, err := typesUtil.StringToBigInt(delegation.Amount)
+	if err != nil {
+		return err
+	}
+	// move the tokens from the delegator's account into the delegation pool
+	if err := u.SetAccountAmount(message.Delegator, delegatorAccountAmount); err != nil {
+		return err
+	}
+	if err := u.AddPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName(), amount); err != nil {
+		return err
+	}
+	delegation.Amount = typesUtil.BigIntToString(delegatedAmount.Add(delegatedAmount, amount))
+	if err := u.setDelegation(delegation); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_DELEGATE,
+		addressAttribute(coreTypes.EventAttributeKeyDelegator, message.Delegator),
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.ActorAddress),
+		actorTypeAttribute(message.ActorType),
+		amountAttribute(amount))
+	return nil
+}
+
+func (u *UtilityContext) HandleMessageUndelegate(message *typesUtil.MessageUndelegate) typesUtil.Error {
+	delegation, err := u.getDelegation(message.Delegator, message.ActorAddress, message.ActorType)
+	if err != nil {
+		return err
+	}
+	if delegation == nil || delegation.Status == coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN {
+		return typesUtil.ErrDelegationNotFound(hex.EncodeToString(message.Delegator), hex.EncodeToString(message.ActorAddress))
+	}
+	if delegation.Status != coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED {
+		return typesUtil.ErrDelegationNotBonded(delegation.Delegator, delegation.ActorAddress)
+	}
+	// the unbonding period is the same as the unstaking period of the actor type delegated to
+	unbondingHeight, err := u.GetUnstakingHeight(delegation.ActorType)
+	if err != nil {
+		return err
+	}
+	delegation.Status = coreTypes.DelegationStatus_DELEGATION_STATUS_UNBONDING
+	delegation.UnbondingHeight = unbondingHeight
+	if err := u.setDelegation(delegation); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_UNDELEGATE,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyDelegator, delegation.Delegator),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, delegation.ActorAddress),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, delegation.Amount),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyUnstakingHeight, strconv.FormatInt(unbondingHeight, 10)))
+	return nil
+}
+
+func (u *UtilityContext) HandleMessageSetCommission(message *typesUtil.MessageSetCommission) typesUtil.Error {
+	exists, err := u.GetActorExists(message.ActorType, message.Address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return typesUtil.ErrNotExists()
+	}
+	if err := u.checkCommissionChange(message.ActorType, message.Address, message.Percentage); err != nil {
+		return err
+	}
+	if er := u.Store().SetCommission(message.Address, message.ActorType, message.Percentage); er != nil {
+		return typesUtil.ErrSetCommission(er)
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_SET_COMMISSION,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address),
+		actorTypeAttribute(message.ActorType),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyPercentage, strconv.FormatUint(uint64(message.Percentage), 10)))
+	return nil
+}
+
+// checkCommissionChange compares the new commission of an actor to the commission it had at the start of the
+// commission change period. Lowering the commission is not limited.
+func (u *UtilityContext) checkCommissionChange(actorType coreTypes.ActorType, address []byte, percentage uint32) typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	maxChange, err := u.GetCommissionMaxChangePercentage()
+	if err != nil {
+		return err
+	}
+	periodBlocks, err := u.GetCommissionChangePeriodBlocks()
+	if err != nil {
+		return err
+	}
+	// the commission before genesis is zero, so a new actor raises it gradually as well
+	previousPercentage, er := store.GetCommission(address, actorType, height-periodBlocks)
+	if er != nil {
+		return typesUtil.ErrGetCommission(er)
+	}
+	if int64(percentage) > int64(previousPercentage)+int64(maxChange) {
+		return typesUtil.ErrCommissionChangeTooLarge(percentage, previousPercentage, maxChange)
+	}
+	return nil
+}
+
+// WithdrawUnbondedDelegations returns the tokens of the delegations whose unbonding period is over to their delegators
+func (u *UtilityContext) WithdrawUnbondedDelegations() typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	delegations, er := store.GetDelegationsReadyToWithdraw(height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+	for _, delegation := range delegations {
+		delegator, er := hex.DecodeString(delegation.Delegator)
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		if err := u.SubPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName(), delegation.Amount); err != nil {
+			return err
+		}
+		if err := u.AddAccountAmountString(delegator, delegation.Amount); err != nil {
+			return err
+		}
+		delegation.Status = coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN
+		delegation.UnbondingHeight = typesUtil.HeightNotUsed
+		if err := u.setDelegation(delegation); err != nil {
+			return err
+		}
+		u.emitEvent(coreTypes.EventType_EVENT_TYPE_DELEGATION_WITHDRAW,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyDelegator, delegation.Delegator),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAddress, delegation.ActorAddress),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, delegation.Amount))
+	}
+	return nil
+}
+
+// DistributeRelayRewards mints the relay rewards of a service node and shares them with its delegators
+// IMPROVE: Called by the relay claim & proof logic once servicing is implemented
+func (u *UtilityContext) DistributeRelayRewards(serviceNode []byte, amount *big.Int) typesUtil.Error {
+	return u.distributeActorRewards(coreTypes.ActorType_ACTOR_TYPE_SERVICENODE, serviceNode, amount)
+}
+
+// distributeActorRewards splits the rewards of an actor pro-rata between its own stake and the stake
+// of its bonded delegations. The actor keeps its commission on the delegators' share, as well as the
+// remainder of any integer division.
+func (u *UtilityContext) distributeActorRewards(actorType coreTypes.ActorType, address []byte, amount *big.Int) typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	delegations, er := store.GetActorDelegations(address, actorType, height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+	totalDelegated := big.NewInt(0)
+	bondedDelegations := make([]*coreTypes.Delegation, 0, len(delegations))
+	delegatedAmounts := make([]*big.Int, 0, len(delegations))
+	for _, delegation := range delegations {
+		if delegation.Status != coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED {
+			continue
+		}
+		delegatedAmount, err := typesUtil.StringToBigInt(delegation.Amount)
+		if err != nil {
+			return err
+		}
+		totalDelegated.Add(totalDelegated, delegatedAmount)
+		bondedDelegations = append(bondedDelegations, delegation)
+		delegatedAmounts = append(delegatedAmounts, delegatedAmount)
+	}
+	if totalDelegated.Sign() == 0 {
+		return u.rewardAccount(address, amount)
+	}
+	actorStake, err := u.GetActorStakedTokens(actorType, address)
+	if err != nil {
+		return err
+	}
+	commissionPercentage, er := store.GetCommission(address, actorType, height)
+	if er != nil {
+		return typesUtil.ErrGetCommission(er)
+	}
+	totalStake := new(big.Int).Add(actorStake, totalDelegated)
+	// delegatorsShare = amount * totalDelegated / totalStake
+	delegatorsShare := new(big.Int).Mul(amount, totalDelegated)
+	delegatorsShare.Quo(delegatorsShare, totalStake)
+	// the commission is deducted from the delegators' share before it is split between the delegators
+	commission := new(big.Int).Mul(delegatorsShare, big.NewInt(int64(commissionPercentage)))
+	commission.Quo(commission, big.NewInt(100))
+	delegatorsShare.Sub(delegatorsShare, commission)
+
+	amountToActor := new(big.Int).Set(amount)
+	for i, delegation := range bondedDelegations {
+		delegator, er := hex.DecodeString(delegation.Delegator)
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		delegatorReward := new(big.Int).Mul(delegatorsShare, delegatedAmounts[i])
+		delegatorReward.Quo(delegatorReward, totalDelegated)
+		if err := u.rewardAccount(delegator, delegatorReward); err != nil {
+			return err
+		}
+		amountToActor.Sub(amountToActor, delegatorReward)
+	}
+	return u.rewardAccount(address, amountToActor)
+}
+
+func (u *UtilityContext) rewardAccount(address []byte, amount *big.Int) typesUtil.Error {
+	if err := u.AddAccountAmount(address, amount); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_REWARD,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, address),
+		amountAttribute(amount))
+	return nil
+}
+
+// slashDelegations burns `percentage` of every delegation to the actor that has not been withdrawn yet
+func (u *UtilityContext) slashDelegations(actorType coreTypes.ActorType, address []byte, percentage int) typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	delegations, er := store.GetActorDelegations(address, actorType, height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+	totalSlashed := big.NewInt(0)
+	for _, delegation := range delegations {
+		delegatedAmount, err := typesUtil.StringToBigInt(delegation.Amount)
+		if err != nil {
+			return err
+		}
+		slashed := new(big.Int).Mul(delegatedAmount, big.NewInt(int64(percentage)))
+		slashed.Quo(slashed, big.NewInt(100))
+		if slashed.Sign() <= 0 {
+			continue
+		}
+		delegation.Amount = typesUtil.BigIntToString(delegatedAmount.Sub(delegatedAmount, slashed))
+		if err := u.setDelegation(delegation); err != nil {
+			return err
+		}
+		totalSlashed.Add(totalSlashed, slashed)
+	}
+	if totalSlashed.Sign() == 0 {
+		return nil
+	}
+	return u.SubPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName(), typesUtil.BigIntToString(totalSlashed))
+}
+
+// getDelegation returns nil if the delegator never delegated to the actor
+func (u *UtilityContext) getDelegation(delegator, actorAddress []byte, actorType coreTypes.ActorType) (*coreTypes.Delegation, typesUtil.Error) {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return nil, err
+	}
+	exists, er := store.GetDelegationExists(delegator, actorAddress, actorType, height)
+	if er != nil {
+		return nil, typesUtil.ErrGetDelegation(er)
+	}
+	if !exists {
+		return nil, nil
+	}
+	delegation, er := store.GetDelegation(delegator, actorAddress, actorType, height)
+	if er != nil {
+		return nil, typesUtil.ErrGetDelegation(er)
+	}
+	return delegation, nil
+}
+
+func (u *UtilityContext) setDelegation(delegation *coreTypes.Delegation) typesUtil.Error {
+	if er := u.Store().SetDelegation(delegation); er != nil {
+		return typesUtil.ErrSetDelegation(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) GetMessageDelegateSignerCandidates(msg *typesUtil.MessageDelegate) ([][]byte, typesUtil.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
+func (u *UtilityContext) GetMessageUndelegateSignerCandidates(msg *typesUtil.MessageUndelegate) ([][]byte, typesUtil.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
+func (u *UtilityContext) GetMessageSetCommissionSignerCandidates(msg *typesUtil.MessageSetCommission) ([][]byte, typesUtil.Error) {
+	output, err := u.GetActorOutputAddress(msg.ActorType, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Address)
+	return candidates, nil
+}
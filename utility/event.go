@@ -0,0 +1,48 @@
+package utility
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	Events are emitted by the message handlers and the block lifecycle hooks whenever they change the state
+	so that indexers do not need to re-derive stakes, unstakes, pauses, burns or rewards by diffing the state.
+
+	The events emitted while applying a transaction are stored in its `TxResult`, and the events emitted by
+	`BeginBlock` and `EndBlock` are stored in the block; both are indexed by the persistence module.
+*/
+
+func (u *UtilityContext) emitEvent(eventType coreTypes.EventType, attributes ...*coreTypes.EventAttribute) {
+	u.events = append(u.events, coreTypes.NewEvent(eventType, attributes...))
+}
+
+// takeEvents returns the events emitted so far and resets the pending events
+func (u *UtilityContext) takeEvents() []*coreTypes.Event {
+	events := u.events
+	u.events = nil
+	return events
+}
+
+// storeBlockEvents hands the events emitted by the block lifecycle hooks to the persistence context
+func (u *UtilityContext) storeBlockEvents() typesUtil.Error {
+	if err := u.Store().SetBlockEvents(u.takeEvents()); err != nil {
+		return typesUtil.ErrSetBlockEvents(err)
+	}
+	return nil
+}
+
+func addressAttribute(key string, address []byte) *coreTypes.EventAttribute {
+	return coreTypes.NewEventAttribute(key, hex.EncodeToString(address))
+}
+
+func amountAttribute(amount *big.Int) *coreTypes.EventAttribute {
+	return coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, typesUtil.BigIntToString(amount))
+}
+
+func actorTypeAttribute(actorType coreTypes.ActorType) *coreTypes.EventAttribute {
+	return coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyActorType, actorType.GetName())
+}
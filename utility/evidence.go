@@ -0,0 +1,130 @@
+package utility
+
+import (
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'evidence' file contains the handling of the double sign evidence detected by the consensus module.
+
+	When a validator signs two different blocks for the same (height, round, step), consensus packages the
+	two signed votes as a `DoubleSignEvidence` and the next block it proposes carries it. The validators
+	proven to have double signed are the byzantine validators handled in `BeginBlock`, which burns their
+	stake by the `double_sign_burn_percentage` param.
+
+	Evidence older than `validator_max_evidence_age_in_blocks` is rejected, and the handled double signs
+	are remembered (like committed transactions) until they are too old to be handled again.
+*/
+
+func (u *UtilityContext) SetProposalEvidence(evidence []*coreTypes.DoubleSignEvidence) error {
+	u.proposalEvidence = evidence
+	return nil
+}
+
+func (u *UtilityContext) GetProposalEvidence() []*coreTypes.DoubleSignEvidence {
+	return u.proposalEvidence
+}
+
+// GetLastBlockByzantineValidators returns the addresses of the validators proven to have double signed by
+// the evidence of the proposal block; any invalid evidence invalidates the block
+func (u *UtilityContext) GetLastBlockByzantineValidators() ([][]byte, error) {
+	byzantineValidators := make([][]byte, 0)
+	seen := make(map[string]struct{})
+	for _, evidence := range u.proposalEvidence {
+		address, err := u.ValidateDoubleSignEvidence(evidence)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[evidence.Hash()]; ok {
+			return nil, typesUtil.ErrInvalidEvidence(fmt.Errorf("duplicate evidence for %s", hex.EncodeToString(address)))
+		}
+		seen[evidence.Hash()] = struct{}{}
+		byzantineValidators = append(byzantineValidators, address)
+	}
+	return byzantineValidators, nil
+}
+
+// ValidateDoubleSignEvidence returns the address of the double signer if the evidence can be handled at
+// the height of the context
+func (u *UtilityContext) ValidateDoubleSignEvidence(evidence *coreTypes.DoubleSignEvidence) ([]byte, typesUtil.Error) {
+	if err := evidence.ValidateBasic(); err != nil {
+		return nil, typesUtil.ErrInvalidEvidence(err)
+	}
+	store, latestHeight, err := u.GetStoreAndHeight()
+	if err != nil {
+		return nil, err
+	}
+	evidenceHeight := int64(evidence.GetHeight())
+	if evidenceHeight > latestHeight {
+		return nil, typesUtil.ErrInvalidEvidence(fmt.Errorf("evidence height %d is after the current height %d", evidenceHeight, latestHeight))
+	}
+	maxEvidenceAge, err := u.GetMaxEvidenceAgeInBlocks()
+	if err != nil {
+		return nil, err
+	}
+	if latestHeight-evidenceHeight > int64(maxEvidenceAge) {
+		return nil, typesUtil.ErrMaxEvidenceAge()
+	}
+	handled, er := store.GetTransactionHashExists(evidence.Hash(), latestHeight)
+	if er != nil {
+		return nil, typesUtil.ErrGetTransactionHashExists(er)
+	}
+	if handled {
+		return nil, typesUtil.ErrInvalidEvidence(fmt.Errorf("double sign at height %d was already handled", evidenceHeight))
+	}
+	address, er := evidence.Address()
+	if er != nil {
+		return nil, typesUtil.ErrNewPublicKeyFromBytes(er)
+	}
+	exists, err := u.GetActorExists(coreTypes.ActorType_ACTOR_TYPE_VAL, address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, typesUtil.ErrInvalidEvidence(fmt.Errorf("double signer %s is not a validator", address))
+	}
+	return address, nil
+}
+
+// filterProposalEvidence drops the evidence that cannot be handled at the height of the context, so the
+// block proposer never proposes a block its verifiers would reject
+func (u *UtilityContext) filterProposalEvidence() {
+	validEvidence := make([]*coreTypes.DoubleSignEvidence, 0, len(u.proposalEvidence))
+	seen := make(map[string]struct{})
+	for _, evidence := range u.proposalEvidence {
+		if _, err := u.ValidateDoubleSignEvidence(evidence); err != nil {
+			log.Printf("[WARN] Dropping double sign evidence: %v\n", err)
+			continue
+		}
+		if _, ok := seen[evidence.Hash()]; ok {
+			continue
+		}
+		seen[evidence.Hash()] = struct{}{}
+		validEvidence = append(validEvidence, evidence)
+	}
+	u.proposalEvidence = validEvidence
+}
+
+// rememberProposalEvidence records the double signs handled by the proposal block until they are too old
+// to be handled again
+func (u *UtilityContext) rememberProposalEvidence() typesUtil.Error {
+	if len(u.proposalEvidence) == 0 {
+		return nil
+	}
+	maxEvidenceAge, err := u.GetMaxEvidenceAgeInBlocks()
+	if err != nil {
+		return err
+	}
+	for _, evidence := range u.proposalEvidence {
+		maxHeight := int64(evidence.GetHeight()) + int64(maxEvidenceAge)
+		if err := u.Store().InsertTransactionHash(evidence.Hash(), maxHeight); err != nil {
+			return typesUtil.ErrInsertTransactionHash(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package utility
+
+import (
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'fee_market' file contains the dynamic fee market, which replaces the fixed per message fees once
+	the `dynamic_fees` upgrade activates.
+
+	Every transaction pays the base fee of its block plus the tip set by its signer, capped by the max fee
+	of the transaction. The base fee is burnt, while the tip goes to the fee collector pool and is paid to
+	the block proposer in `HandleProposalRewards` like the fixed fees were.
+
+	At the end of every block the base fee is adjusted by comparing the size of the block with the
+	`fee_market_target_block_size_bytes` param, so that it rises while blocks are congested and decays
+	back to `fee_market_min_base_fee` when they are not.
+*/
+
+// GetTransactionFee returns the fee charged to the signer of `tx`, split between the part collected in
+// the fee collector pool and the part that is burnt
+func (u *UtilityContext) GetTransactionFee(tx *typesUtil.Transaction, msg typesUtil.Message) (collected, burnt *big.Int, err typesUtil.Error) {
+	active, err := u.isDynamicFeeMarketActive()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !active {
+		fee, err := u.GetFee(msg, msg.GetActorType())
+		if err != nil {
+			return nil, nil, err
+		}
+		return fee, big.NewInt(0), nil
+	}
+	baseFee, err := u.GetBaseFee()
+	if err != nil {
+		return nil, nil, err
+	}
+	tip, err := tx.GetTipAmount()
+	if err != nil {
+		return nil, nil, err
+	}
+	maxFee, err := tx.GetMaxFeeAmount()
+	if err != nil {
+		return nil, nil, err
+	}
+	if maxFee != nil {
+		if maxFee.Cmp(baseFee) == -1 {
+			return nil, nil, typesUtil.ErrMaxFeeBelowBaseFee(tx.MaxFee, typesUtil.BigIntToString(baseFee))
+		}
+		// the tip is reduced so the total never exceeds the max fee
+		if maxTip := new(big.Int).Sub(maxFee, baseFee); tip.Cmp(maxTip) == 1 {
+			tip = maxTip
+		}
+	}
+	return tip, baseFee, nil
+}
+
+// GetBaseFee returns the base fee charged to the transactions of the context height, which is the one
+// computed at the end of the previous block
+func (u *UtilityContext) GetBaseFee() (*big.Int, typesUtil.Error) {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return nil, err
+	}
+	baseFee, er := store.GetBaseFee(height - 1)
+	if er != nil {
+		return nil, typesUtil.ErrGetBaseFee(er)
+	}
+	// the first block of the fee market starts from the minimum
+	if baseFee == "" {
+		return u.GetFeeMarketMinBaseFee()
+	}
+	return typesUtil.StringToBigInt(baseFee)
+}
+
+// UpdateBaseFee computes the base fee of the next block from the size of the transactions applied in
+// this block
+func (u *UtilityContext) UpdateBaseFee(blockSizeInBytes int) typesUtil.Error {
+	active, err := u.isDynamicFeeMarketActive()
+	if err != nil || !active {
+		return err
+	}
+	baseFee, err := u.GetBaseFee()
+	if err != nil {
+		return err
+	}
+	targetBlockSizeInBytes, err := u.GetFeeMarketTargetBlockSizeBytes()
+	if err != nil {
+		return err
+	}
+	changeDenominator, err := u.GetFeeMarketBaseFeeChangeDenominator()
+	if err != nil {
+		return err
+	}
+	minBaseFee, err := u.GetFeeMarketMinBaseFee()
+	if err != nil {
+		return err
+	}
+	nextBaseFee := typesUtil.CalculateNextBaseFee(baseFee, blockSizeInBytes, targetBlockSizeInBytes, changeDenominator, minBaseFee)
+	if er := u.Store().SetBaseFee(typesUtil.BigIntToString(nextBaseFee)); er != nil {
+		return typesUtil.ErrSetBaseFee(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) isDynamicFeeMarketActive() (bool, typesUtil.Error) {
+	height, err := u.GetLatestBlockHeight()
+	if err != nil {
+		return false, err
+	}
+	return u.isFeatureActiveAtHeight(typesUtil.DynamicFeesUpgrade, height)
+}
+
+func feeEventAttributes(collected, burnt *big.Int) []*coreTypes.EventAttribute {
+	if burnt.Sign() == 0 {
+		return nil
+	}
+	return []*coreTypes.EventAttribute{
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyBaseFee, typesUtil.BigIntToString(burnt)),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyTip, typesUtil.BigIntToString(collected)),
+	}
+}
@@ -34,6 +34,26 @@ func (u *UtilityContext) UpdateParam(paramName string, value any) typesUtil.Erro
 	return typesUtil.ErrUnknownParam(paramName)
 }
 
+// UpdateFlag is the feature flag equivalent of `UpdateParam`
+func (u *UtilityContext) UpdateFlag(flagName string, value any, enabled bool) typesUtil.Error {
+	store := u.Store()
+	var er error
+	switch t := value.(type) {
+	case *wrapperspb.Int32Value:
+		er = store.SetFlag(flagName, int(t.Value), enabled)
+	case *wrapperspb.StringValue:
+		er = store.SetFlag(flagName, t.Value, enabled)
+	case *wrapperspb.BytesValue:
+		er = store.SetFlag(flagName, t.Value, enabled)
+	default:
+		return typesUtil.ErrUnknownParam(flagName)
+	}
+	if er != nil {
+		return typesUtil.ErrUpdateParam(er)
+	}
+	return nil
+}
+
 func (u *UtilityContext) GetParameter(paramName string, height int64) (any, error) {
 	return u.Store().GetParameter(paramName, height)
 }
@@ -246,6 +266,82 @@ func (u *UtilityContext) GetMessageChangeParameterFee() (*big.Int, typesUtil.Err
 	return u.getBigIntParam(typesUtil.MessageChangeParameterFee)
 }
 
+func (u *UtilityContext) GetMessageSubmitProposalFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageSubmitProposalFee)
+}
+
+func (u *UtilityContext) GetMessageVoteFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageVoteFee)
+}
+
+func (u *UtilityContext) GetMessageUpgradePlanFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageUpgradePlanFee)
+}
+
+func (u *UtilityContext) GetMessageDelegateFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageDelegateFee)
+}
+
+func (u *UtilityContext) GetMessageUndelegateFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageUndelegateFee)
+}
+
+func (u *UtilityContext) GetMessageSetCommissionFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageSetCommissionFee)
+}
+
+func (u *UtilityContext) GetMessageRotateKeyFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageRotateKeyFee)
+}
+
+func (u *UtilityContext) GetMessageChangeOutputAddressFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageChangeOutputAddressFee)
+}
+
+func (u *UtilityContext) GetMessageRegisterBLSKeyFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageRegisterBLSKeyFee)
+}
+
+func (u *UtilityContext) GetGovernanceMinDeposit() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.GovernanceMinDepositParamName)
+}
+
+func (u *UtilityContext) GetGovernanceVotingPeriodBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.GovernanceVotingPeriodBlocksParamName)
+}
+
+func (u *UtilityContext) GetGovernanceQuorumPercentage() (int, typesUtil.Error) {
+	return u.getIntParam(typesUtil.GovernanceQuorumPercentageParamName)
+}
+
+func (u *UtilityContext) GetGovernancePassThresholdPercentage() (int, typesUtil.Error) {
+	return u.getIntParam(typesUtil.GovernancePassThresholdPercentageParamName)
+}
+
+func (u *UtilityContext) GetFeeMarketTargetBlockSizeBytes() (int, typesUtil.Error) {
+	return u.getIntParam(typesUtil.FeeMarketTargetBlockSizeBytesParamName)
+}
+
+func (u *UtilityContext) GetFeeMarketBaseFeeChangeDenominator() (int, typesUtil.Error) {
+	return u.getIntParam(typesUtil.FeeMarketBaseFeeChangeDenominatorParamName)
+}
+
+func (u *UtilityContext) GetFeeMarketMinBaseFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.FeeMarketMinBaseFeeParamName)
+}
+
+func (u *UtilityContext) GetTransactionMaxValidityBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.TransactionMaxValidityBlocksParamName)
+}
+
+func (u *UtilityContext) GetCommissionMaxChangePercentage() (int, typesUtil.Error) {
+	return u.getIntParam(typesUtil.CommissionMaxChangePercentageParamName)
+}
+
+func (u *UtilityContext) GetCommissionChangePeriodBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.CommissionChangePeriodBlocksParamName)
+}
+
 func (u *UtilityContext) GetDoubleSignFeeOwner() (owner []byte, err typesUtil.Error) {
 	return u.getByteArrayParam(typesUtil.MessageDoubleSignFeeOwner)
 }
@@ -369,6 +465,44 @@ func (u *UtilityContext) GetParamOwner(paramName string) ([]byte, error) {
 		return store.GetBytesParam(typesUtil.MessageUnpauseServiceNodeFeeOwner, height)
 	case typesUtil.MessageChangeParameterFee:
 		return store.GetBytesParam(typesUtil.MessageChangeParameterFeeOwner, height)
+	case typesUtil.MessageSubmitProposalFee:
+		return store.GetBytesParam(typesUtil.MessageSubmitProposalFeeOwner, height)
+	case typesUtil.MessageVoteFee:
+		return store.GetBytesParam(typesUtil.MessageVoteFeeOwner, height)
+	case typesUtil.MessageUpgradePlanFee:
+		return store.GetBytesParam(typesUtil.MessageUpgradePlanFeeOwner, height)
+	case typesUtil.MessageDelegateFee:
+		return store.GetBytesParam(typesUtil.MessageDelegateFeeOwner, height)
+	case typesUtil.MessageUndelegateFee:
+		return store.GetBytesParam(typesUtil.MessageUndelegateFeeOwner, height)
+	case typesUtil.MessageSetCommissionFee:
+		return store.GetBytesParam(typesUtil.MessageSetCommissionFeeOwner, height)
+	case typesUtil.MessageRotateKeyFee:
+		return store.GetBytesParam(typesUtil.MessageRotateKeyFeeOwner, height)
+	case typesUtil.MessageChangeOutputAddressFee:
+		return store.GetBytesParam(typesUtil.MessageChangeOutputAddressFeeOwner, height)
+	case typesUtil.MessageRegisterBLSKeyFee:
+		return store.GetBytesParam(typesUtil.MessageRegisterBLSKeyFeeOwner, height)
+	case typesUtil.GovernanceMinDepositParamName:
+		return store.GetBytesParam(typesUtil.GovernanceMinDepositOwner, height)
+	case typesUtil.GovernanceVotingPeriodBlocksParamName:
+		return store.GetBytesParam(typesUtil.GovernanceVotingPeriodBlocksOwner, height)
+	case typesUtil.GovernanceQuorumPercentageParamName:
+		return store.GetBytesParam(typesUtil.GovernanceQuorumPercentageOwner, height)
+	case typesUtil.GovernancePassThresholdPercentageParamName:
+		return store.GetBytesParam(typesUtil.GovernancePassThresholdPercentageOwner, height)
+	case typesUtil.FeeMarketTargetBlockSizeBytesParamName:
+		return store.GetBytesParam(typesUtil.FeeMarketTargetBlockSizeBytesOwner, height)
+	case typesUtil.FeeMarketBaseFeeChangeDenominatorParamName:
+		return store.GetBytesParam(typesUtil.FeeMarketBaseFeeChangeDenominatorOwner, height)
+	case typesUtil.FeeMarketMinBaseFeeParamName:
+		return store.GetBytesParam(typesUtil.FeeMarketMinBaseFeeOwner, height)
+	case typesUtil.TransactionMaxValidityBlocksParamName:
+		return store.GetBytesParam(typesUtil.TransactionMaxValidityBlocksOwner, height)
+	case typesUtil.CommissionMaxChangePercentageParamName:
+		return store.GetBytesParam(typesUtil.CommissionMaxChangePercentageOwner, height)
+	case typesUtil.CommissionChangePeriodBlocksParamName:
+		return store.GetBytesParam(typesUtil.CommissionChangePeriodBlocksOwner, height)
 	case typesUtil.BlocksPerSessionOwner:
 		return store.GetBytesParam(typesUtil.AclOwner, height)
 	case typesUtil.AppMaxChainsOwner:
@@ -475,6 +609,44 @@ func (u *UtilityContext) GetParamOwner(paramName string) ([]byte, error) {
 		return store.GetBytesParam(typesUtil.AclOwner, height)
 	case typesUtil.MessageChangeParameterFeeOwner:
 		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageSubmitProposalFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageVoteFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageUpgradePlanFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageDelegateFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageUndelegateFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageSetCommissionFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageRotateKeyFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageChangeOutputAddressFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.MessageRegisterBLSKeyFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.GovernanceMinDepositOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.GovernanceVotingPeriodBlocksOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.GovernanceQuorumPercentageOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.GovernancePassThresholdPercentageOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.FeeMarketTargetBlockSizeBytesOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.FeeMarketBaseFeeChangeDenominatorOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.FeeMarketMinBaseFeeOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.TransactionMaxValidityBlocksOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.CommissionMaxChangePercentageOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
+	case typesUtil.CommissionChangePeriodBlocksOwner:
+		return store.GetBytesParam(typesUtil.AclOwner, height)
 	default:
 		return nil, typesUtil.ErrUnknownParam(paramName)
 	}
@@ -540,6 +712,24 @@ func (u *UtilityContext) GetFee(msg typesUtil.Message, actorType coreTypes.Actor
 		}
 	case *typesUtil.MessageChangeParameter:
 		return u.GetMessageChangeParameterFee()
+	case *typesUtil.MessageSubmitProposal:
+		return u.GetMessageSubmitProposalFee()
+	case *typesUtil.MessageVote:
+		return u.GetMessageVoteFee()
+	case *typesUtil.MessageUpgradePlan:
+		return u.GetMessageUpgradePlanFee()
+	case *typesUtil.MessageDelegate:
+		return u.GetMessageDelegateFee()
+	case *typesUtil.MessageUndelegate:
+		return u.GetMessageUndelegateFee()
+	case *typesUtil.MessageSetCommission:
+		return u.GetMessageSetCommissionFee()
+	case *typesUtil.MessageRotateKey:
+		return u.GetMessageRotateKeyFee()
+	case *typesUtil.MessageChangeOutputAddress:
+		return u.GetMessageChangeOutputAddressFee()
+	case *typesUtil.MessageRegisterBLSKey:
+		return u.GetMessageRegisterBLSKeyFee()
 	default:
 		return nil, typesUtil.ErrUnknownMessage(x)
 	}
@@ -0,0 +1,363 @@
+package utility
+
+import (
+	"encoding/hex"
+	"log"
+	"math/big"
+	"strconv"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+/*
+	This 'governance' file contains the on-chain governance logic.
+
+	A proposal (param change, flag change or pool spend) is submitted alongside a deposit that is
+	escrowed in the governance pool. Validators vote on the proposal until its voting period ends,
+	at which point the votes are weighted by the validators' stake and tallied in `EndBlock`.
+
+	- If the quorum is not met, the proposal is rejected and the deposit is burnt
+	- If the quorum is met, the deposit is returned to the proposer
+	- If the quorum is met and the pass threshold is exceeded, the proposal is applied to the state
+*/
+
+// The pools that can be spent from via governance proposals
+var governanceSpendablePools = map[string]struct{}{
+	coreTypes.Pools_POOLS_DAO.FriendlyName(): {},
+}
+
+func (u *UtilityContext) HandleMessageSubmitProposal(message *typesUtil.MessageSubmitProposal) typesUtil.Error {
+	deposit, err := typesUtil.StringToBigInt(message.Deposit)
+	if err != nil {
+		return err
+	}
+	minDeposit, err := u.GetGovernanceMinDeposit()
+	if err != nil {
+		return err
+	}
+	if typesUtil.BigIntLessThan(deposit, minDeposit) {
+		return typesUtil.ErrInsufficientDeposit(message.Deposit, typesUtil.BigIntToString(minDeposit))
+	}
+	if err := u.validateProposalContent(message); err != nil {
+		return err
+	}
+	// ensure the proposer has sufficient funding for the deposit
+	proposerAccountAmount, err := u.GetAccountAmount(message.Proposer)
+	if err != nil {
+		return err
+	}
+	proposerAccountAmount.Sub(proposerAccountAmount, deposit)
+	if proposerAccountAmount.Sign() == -1 {
+		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.Proposer))
+	}
+	if err := u.checkUnlockedAmount(message.Proposer, deposit); err != nil {
+		return err
+	}
+	votingPeriodBlocks, err := u.GetGovernanceVotingPeriodBlocks()
+	if err != nil {
+		return err
+	}
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	proposalId, er := store.GetNextProposalId(height)
+	if er != nil {
+		return typesUtil.ErrGetNextProposalId(er)
+	}
+	// move the deposit from the proposer's account into escrow
+	if err := u.SetAccountAmount(message.Proposer, proposerAccountAmount); err != nil {
+		return err
+	}
+	if err := u.AddPoolAmount(coreTypes.Pools_POOLS_GOVERNANCE.FriendlyName(), deposit); err != nil {
+		return err
+	}
+	proposal := &coreTypes.Proposal{
+		Id:              proposalId,
+		Proposer:        hex.EncodeToString(message.Proposer),
+		ProposalType:    message.ProposalType,
+		Key:             message.Key,
+		Value:           message.Value,
+		FlagEnabled:     message.FlagEnabled,
+		PoolName:        message.PoolName,
+		Recipient:       hex.EncodeToString(message.Recipient),
+		Amount:          message.Amount,
+		Deposit:         message.Deposit,
+		SubmitHeight:    height,
+		VotingEndHeight: height + votingPeriodBlocks,
+		Status:          coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}
+	if er := store.InsertProposal(proposal); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_PROPOSAL_SUBMIT,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyProposalId, strconv.FormatUint(proposalId, 10)),
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Proposer),
+		amountAttribute(deposit))
+	return nil
+}
+
+// validateProposalContent makes sure that a proposal can be applied if it passes, so the only
+// reason for a passing proposal not to be applied is a change in state during the voting period.
+func (u *UtilityContext) validateProposalContent(message *typesUtil.MessageSubmitProposal) typesUtil.Error {
+	switch message.ProposalType {
+	case coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE:
+		if _, err := u.GetParamOwner(message.Key); err != nil {
+			return typesUtil.ErrUnknownParam(message.Key)
+		}
+		return u.validateProposalValue(message)
+	case coreTypes.ProposalType_PROPOSAL_TYPE_FLAG_CHANGE:
+		return u.validateProposalValue(message)
+	case coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND:
+		if _, ok := governanceSpendablePools[message.PoolName]; !ok {
+			return typesUtil.ErrUnknownPool(message.PoolName)
+		}
+		return nil
+	default:
+		return typesUtil.ErrUnknownProposalType(message.ProposalType)
+	}
+}
+
+// validateProposalValue ensures the value is one of the types handled by `UpdateParam` and `UpdateFlag`
+func (u *UtilityContext) validateProposalValue(message *typesUtil.MessageSubmitProposal) typesUtil.Error {
+	v, err := u.Codec().FromAny(message.Value)
+	if err != nil {
+		return typesUtil.ErrProtoFromAny(err)
+	}
+	switch v.(type) {
+	case *wrapperspb.Int32Value, *wrapperspb.StringValue, *wrapperspb.BytesValue:
+		return nil
+	default:
+		return typesUtil.ErrUnknownParam(message.Key)
+	}
+}
+
+func (u *UtilityContext) HandleMessageVote(message *typesUtil.MessageVote) typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	proposal, er := store.GetProposal(message.ProposalId, height)
+	if er != nil {
+		return typesUtil.ErrGetProposal(message.ProposalId, er)
+	}
+	if proposal.Status != coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING || height > proposal.VotingEndHeight {
+		return typesUtil.ErrProposalNotVoting(message.ProposalId)
+	}
+	// only validators are allowed to vote
+	exists, err := u.GetActorExists(coreTypes.ActorType_ACTOR_TYPE_VAL, message.Voter)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return typesUtil.ErrNotExists()
+	}
+	if er := store.InsertProposalVote(message.ProposalId, message.Voter, message.Option); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_PROPOSAL_VOTE,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyProposalId, strconv.FormatUint(message.ProposalId, 10)),
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Voter),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyVoteOption, message.Option.String()))
+	return nil
+}
+
+// HandleProposalTallies tallies the stake weighted votes of every proposal whose voting period ends at the current height
+func (u *UtilityContext) HandleProposalTallies() typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	proposals, er := store.GetProposalsEndingAtHeight(height, height)
+	if er != nil {
+		return typesUtil.ErrGetProposal(0, er)
+	}
+	if len(proposals) == 0 {
+		return nil
+	}
+	votingPower, totalVotingPower, err := u.getValidatorVotingPower()
+	if err != nil {
+		return err
+	}
+	quorumPercentage, err := u.GetGovernanceQuorumPercentage()
+	if err != nil {
+		return err
+	}
+	passThresholdPercentage, err := u.GetGovernancePassThresholdPercentage()
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		quorumMet, passed, err := u.tallyProposal(proposal, votingPower, totalVotingPower, quorumPercentage, passThresholdPercentage)
+		if err != nil {
+			return err
+		}
+		if err := u.settleProposalDeposit(proposal, quorumMet); err != nil {
+			return err
+		}
+		status := coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		if passed {
+			status = coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED
+			// A proposal that passed but can no longer be applied (e.g. insufficient pool funds)
+			// must not halt the chain, so it is marked as failed instead
+			if err := u.applyProposal(proposal); err != nil {
+				log.Printf("[WARN] Governance proposal %d passed but could not be applied: %s\n", proposal.Id, err.Error())
+				status = coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED
+			}
+		}
+		if er := store.SetProposalStatus(proposal.Id, status); er != nil {
+			return typesUtil.ErrSetProposalStatus(proposal.Id, er)
+		}
+		u.emitEvent(coreTypes.EventType_EVENT_TYPE_PROPOSAL_TALLY,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyProposalId, strconv.FormatUint(proposal.Id, 10)),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyProposalStatus, status.String()))
+	}
+	return nil
+}
+
+// getValidatorVotingPower returns the stake of every validator that has not finished unstaking,
+// keyed by its hex encoded address, along with the sum of all of them.
+func (u *UtilityContext) getValidatorVotingPower() (map[string]*big.Int, *big.Int, typesUtil.Error) {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return nil, nil, err
+	}
+	validators, er := store.GetAllValidators(height)
+	if er != nil {
+		return nil, nil, typesUtil.ErrGetAllValidators(er)
+	}
+	votingPower := make(map[string]*big.Int, len(validators))
+	totalVotingPower := big.NewInt(0)
+	for _, validator := range validators {
+		if validator.UnstakingHeight != typesUtil.HeightNotUsed && validator.UnstakingHeight <= height {
+			continue
+		}
+		stake, err := typesUtil.StringToBigInt(validator.StakedAmount)
+		if err != nil {
+			return nil, nil, err
+		}
+		votingPower[validator.Address] = stake
+		totalVotingPower.Add(totalVotingPower, stake)
+	}
+	return votingPower, totalVotingPower, nil
+}
+
+// tallyProposal weights the latest vote of every validator by its stake and returns whether
+// the quorum was met and whether the proposal passed.
+func (u *UtilityContext) tallyProposal(
+	proposal *coreTypes.Proposal,
+	votingPower map[string]*big.Int,
+	totalVotingPower *big.Int,
+	quorumPercentage, passThresholdPercentage int,
+) (quorumMet, passed bool, err typesUtil.Error) {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return false, false, err
+	}
+	votes, er := store.GetProposalVotes(proposal.Id, height)
+	if er != nil {
+		return false, false, typesUtil.ErrGetProposalVotes(proposal.Id, er)
+	}
+	yes, no, abstain := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	for _, vote := range votes {
+		power, ok := votingPower[vote.Voter]
+		if !ok {
+			continue // the voter is no longer a validator
+		}
+		switch vote.Option {
+		case coreTypes.VoteOption_VOTE_OPTION_YES:
+			yes.Add(yes, power)
+		case coreTypes.VoteOption_VOTE_OPTION_NO:
+			no.Add(no, power)
+		case coreTypes.VoteOption_VOTE_OPTION_ABSTAIN:
+			abstain.Add(abstain, power)
+		}
+	}
+	// quorum: (yes + no + abstain) * 100 >= totalVotingPower * quorumPercentage
+	participated := new(big.Int).Add(yes, no)
+	participated.Add(participated, abstain)
+	participated.Mul(participated, big.NewInt(100))
+	quorum := new(big.Int).Mul(totalVotingPower, big.NewInt(int64(quorumPercentage)))
+	if totalVotingPower.Sign() == 0 || participated.Cmp(quorum) == -1 {
+		return false, false, nil
+	}
+	// pass threshold: yes * 100 > (yes + no) * passThresholdPercentage
+	decisive := new(big.Int).Add(yes, no)
+	if decisive.Sign() == 0 {
+		return true, false, nil
+	}
+	yesWeighted := new(big.Int).Mul(yes, big.NewInt(100))
+	threshold := decisive.Mul(decisive, big.NewInt(int64(passThresholdPercentage)))
+	return true, yesWeighted.Cmp(threshold) == 1, nil
+}
+
+// settleProposalDeposit returns the deposit to the proposer if the quorum was met and burns it otherwise
+func (u *UtilityContext) settleProposalDeposit(proposal *coreTypes.Proposal, quorumMet bool) typesUtil.Error {
+	if err := u.SubPoolAmount(coreTypes.Pools_POOLS_GOVERNANCE.FriendlyName(), proposal.Deposit); err != nil {
+		return err
+	}
+	if !quorumMet {
+		return nil
+	}
+	proposer, er := hex.DecodeString(proposal.Proposer)
+	if er != nil {
+		return typesUtil.ErrHexDecodeFromString(er)
+	}
+	return u.AddAccountAmountString(proposer, proposal.Deposit)
+}
+
+func (u *UtilityContext) applyProposal(proposal *coreTypes.Proposal) typesUtil.Error {
+	switch proposal.ProposalType {
+	case coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE:
+		v, err := u.Codec().FromAny(proposal.Value)
+		if err != nil {
+			return typesUtil.ErrProtoFromAny(err)
+		}
+		return u.UpdateParam(proposal.Key, v)
+	case coreTypes.ProposalType_PROPOSAL_TYPE_FLAG_CHANGE:
+		v, err := u.Codec().FromAny(proposal.Value)
+		if err != nil {
+			return typesUtil.ErrProtoFromAny(err)
+		}
+		return u.UpdateFlag(proposal.Key, v, proposal.FlagEnabled)
+	case coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND:
+		amount, err := typesUtil.StringToBigInt(proposal.Amount)
+		if err != nil {
+			return err
+		}
+		poolAmount, err := u.GetPoolAmount(proposal.PoolName)
+		if err != nil {
+			return err
+		}
+		if typesUtil.BigIntLessThan(poolAmount, amount) {
+			return typesUtil.ErrInsufficientAmount(proposal.PoolName)
+		}
+		recipient, er := hex.DecodeString(proposal.Recipient)
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		if err := u.SubPoolAmount(proposal.PoolName, proposal.Amount); err != nil {
+			return err
+		}
+		return u.AddAccountAmount(recipient, amount)
+	default:
+		return typesUtil.ErrUnknownProposalType(proposal.ProposalType)
+	}
+}
+
+func (u *UtilityContext) GetMessageSubmitProposalSignerCandidates(msg *typesUtil.MessageSubmitProposal) ([][]byte, typesUtil.Error) {
+	return [][]byte{msg.Proposer}, nil
+}
+
+func (u *UtilityContext) GetMessageVoteSignerCandidates(msg *typesUtil.MessageVote) ([][]byte, typesUtil.Error) {
+	output, err := u.GetActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_VAL, msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output)
+	candidates = append(candidates, msg.Voter)
+	return candidates, nil
+}
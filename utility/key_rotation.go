@@ -0,0 +1,135 @@
+package utility
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'key_rotation' file contains the logic to rotate the operator key and change the output address of
+	a staked actor, which are otherwise fixed at stake time.
+
+	Since an actor is identified by the address of its operator key, rotating the key moves the stake record
+	(including its chains, delegations and commission) to the new address, and tombstones the old one. Both
+	messages can only be signed by the output address, so a leaked operator key cannot take over the stake.
+	The consensus validator set and the P2P address book pick up the new key from the next height.
+*/
+
+func (u *UtilityContext) HandleMessageRotateKey(message *typesUtil.MessageRotateKey) typesUtil.Error {
+	exists, err := u.GetActorExists(message.ActorType, message.Address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return typesUtil.ErrNotExists()
+	}
+	newPublicKey, er := crypto.NewPublicKeyFromBytes(message.NewPublicKey)
+	if er != nil {
+		return typesUtil.ErrNewPublicKeyFromBytes(er)
+	}
+	newAddress := newPublicKey.Address()
+	exists, err = u.GetActorExists(message.ActorType, newAddress)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return typesUtil.ErrAlreadyExists()
+	}
+	if err := u.RotateActorKey(message.ActorType, message.Address, newAddress, newPublicKey.Bytes()); err != nil {
+		return err
+	}
+	if err := u.moveDelegations(message.ActorType, message.Address, newAddress); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_ROTATE_KEY,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address),
+		addressAttribute(coreTypes.EventAttributeKeyNewAddress, newAddress),
+		actorTypeAttribute(message.ActorType))
+	return nil
+}
+
+func (u *UtilityContext) HandleMessageChangeOutputAddress(message *typesUtil.MessageChangeOutputAddress) typesUtil.Error {
+	exists, err := u.GetActorExists(message.ActorType, message.Address)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return typesUtil.ErrNotExists()
+	}
+	if err := u.SetActorOutputAddress(message.ActorType, message.Address, message.NewOutputAddress); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_CHANGE_OUTPUT_ADDRESS,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address),
+		actorTypeAttribute(message.ActorType),
+		addressAttribute(coreTypes.EventAttributeKeyOutputAddress, message.NewOutputAddress))
+	return nil
+}
+
+// moveDelegations moves the delegations to, and the commission history of, the actor at `address` to `newAddress`.
+// The delegations keep their amount and status, and the ones to `address` are withdrawn without tokens.
+func (u *UtilityContext) moveDelegations(actorType coreTypes.ActorType, address, newAddress []byte) typesUtil.Error {
+	if typesUtil.ValidateDelegationActorType(actorType) != nil {
+		return nil
+	}
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	delegations, er := store.GetActorDelegations(address, actorType, height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+	for _, delegation := range delegations {
+		movedDelegation := &coreTypes.Delegation{
+			Delegator:       delegation.Delegator,
+			ActorAddress:    hex.EncodeToString(newAddress),
+			ActorType:       delegation.ActorType,
+			Amount:          delegation.Amount,
+			Status:          delegation.Status,
+			UnbondingHeight: delegation.UnbondingHeight,
+		}
+		if err := u.setDelegation(movedDelegation); err != nil {
+			return err
+		}
+		delegation.Amount = typesUtil.BigIntToString(big.NewInt(0))
+		delegation.Status = coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN
+		delegation.UnbondingHeight = typesUtil.HeightNotUsed
+		if err := u.setDelegation(delegation); err != nil {
+			return err
+		}
+	}
+	// the commission change period is checked against the commission history, so it carries over to the new key
+	if er := store.CopyCommissionHistory(address, newAddress, actorType); er != nil {
+		return typesUtil.ErrSetCommission(er)
+	}
+	// the commission is also set at this height to commit it to the state of the new key
+	commission, er := store.GetCommission(address, actorType, height)
+	if er != nil {
+		return typesUtil.ErrGetCommission(er)
+	}
+	if er := store.SetCommission(newAddress, actorType, commission); er != nil {
+		return typesUtil.ErrSetCommission(er)
+	}
+	return nil
+}
+
+func (u *UtilityContext) GetMessageRotateKeySignerCandidates(msg *typesUtil.MessageRotateKey) ([][]byte, typesUtil.Error) {
+	output, err := u.GetActorOutputAddress(msg.ActorType, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{output}, nil
+}
+
+func (u *UtilityContext) GetMessageChangeOutputAddressSignerCandidates(msg *typesUtil.MessageChangeOutputAddress) ([][]byte, typesUtil.Error) {
+	output, err := u.GetActorOutputAddress(msg.ActorType, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{output}, nil
+}
@@ -72,13 +72,19 @@ func (u *utilityModule) HandleMessage(message *anypb.Any) error {
 	case TransactionGossipMessageContentType:
 		msg, err := codec.GetCodec().FromAny(message)
 		if err != nil {
+			u.GetBus().GetP2PModule().ReportInvalidMessage(message)
 			return err
 		}
 		transactionGossipMsg, ok := msg.(*types.TransactionGossipMessage)
 		if !ok {
+			u.GetBus().GetP2PModule().ReportInvalidMessage(message)
 			return fmt.Errorf("failed to cast message to UtilityMessage")
 		}
 		if err := u.CheckTransaction(transactionGossipMsg.Tx); err != nil {
+			// A valid tx may be gossiped again or replayed, so only the txs that are invalid by themselves are reported
+			if _, decodeErr := decodeTransaction(transactionGossipMsg.Tx); decodeErr != nil {
+				u.GetBus().GetP2PModule().ReportInvalidMessage(message)
+			}
 			return err
 		}
 		log.Println("MEMPOOOL: Successfully added a new message to the mempool!")
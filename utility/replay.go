@@ -0,0 +1,65 @@
+package utility
+
+import (
+	"github.com/pokt-network/pocket/shared/modules"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'replay' file contains the replay protection of transactions.
+
+	Every transaction carries a `max_height` after which it can no longer be included in a block, and which
+	cannot be more than `transaction_max_validity_blocks` ahead of the height it is included at. The hash of
+	a committed transaction therefore only needs to be remembered until its `max_height`: the hashes are
+	persisted alongside the state and pruned at the end of every block, which keeps the structure bounded
+	and allows the tx indexer to be pruned without re-opening the door to replays.
+*/
+
+// CheckTransactionReplay returns an error if `tx` cannot be included at the height of the context,
+// either because it is outside of its validity window or because it was already committed
+func (u *UtilityContext) CheckTransactionReplay(txHash string, tx *typesUtil.Transaction) typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	maxValidityBlocks, err := u.GetTransactionMaxValidityBlocks()
+	if err != nil {
+		return err
+	}
+	return checkTransactionReplay(store, txHash, tx, height, maxValidityBlocks)
+}
+
+// PruneExpiredTransactionHashes forgets the transactions that cannot be included in the next block anymore
+func (u *UtilityContext) PruneExpiredTransactionHashes() typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	if er := store.PruneTransactionHashes(height); er != nil {
+		return typesUtil.ErrPruneTransactionHashes(er)
+	}
+	return nil
+}
+
+// rememberTransaction records the hash of a committed transaction until its max height
+func (u *UtilityContext) rememberTransaction(txHash string, tx *typesUtil.Transaction) typesUtil.Error {
+	if err := u.Store().InsertTransactionHash(txHash, tx.MaxHeight); err != nil {
+		return typesUtil.ErrInsertTransactionHash(err)
+	}
+	return nil
+}
+
+// checkTransactionReplay is shared by the mempool admission (on a read context) and block application
+func checkTransactionReplay(store modules.PersistenceReadContext, txHash string, tx *typesUtil.Transaction, height, maxValidityBlocks int64) typesUtil.Error {
+	if err := tx.ValidateMaxHeight(height, maxValidityBlocks); err != nil {
+		return err
+	}
+	exists, err := store.GetTransactionHashExists(txHash, height)
+	if err != nil {
+		return typesUtil.ErrGetTransactionHashExists(err)
+	}
+	if exists {
+		return typesUtil.ErrTransactionAlreadyCommitted()
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pokt-network/pocket/runtime/test_artifacts"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,12 +33,6 @@ func TestUtilityContext_ApplyBlock(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, appHash)
 
-	// // TODO: Uncomment this once `GetValidatorMissedBlocks` is implemented.
-	// beginBlock logic verify
-	// missed, err := ctx.GetValidatorMissedBlocks(byzantine.Address)
-	// require.NoError(t, err)
-	// require.Equal(t, missed, 1)
-
 	feeBig, err := ctx.GetMessageSendFee()
 	require.NoError(t, err)
 
@@ -64,6 +59,33 @@ func TestUtilityContext_ApplyBlock(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_ApplyBlockRecordsFailures(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	tx, _, _, signer := newTestingTransaction(t, ctx)
+
+	// The signer cannot pay the fee of the transaction anymore when the block is applied
+	require.NoError(t, ctx.SetAccountAmount(signer.Address(), big.NewInt(0)))
+
+	txBz, er := tx.Bytes()
+	require.NoError(t, er)
+
+	proposer := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	addrBz, err := hex.DecodeString(proposer.GetAddress())
+	require.NoError(t, err)
+
+	require.NoError(t, ctx.SetProposalBlock("", addrBz, [][]byte{txBz}))
+	require.NoError(t, ctx.SetProposalRecordFailures(true))
+
+	_, err = ctx.ApplyBlock()
+	require.NoError(t, err)
+
+	amountAfter, err := ctx.GetAccountAmount(signer.Address())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), amountAfter, "a failed transaction should not be applied")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_BeginBlock(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	tx, _, _, _ := newTestingTransaction(t, ctx)
@@ -79,14 +101,58 @@ func TestUtilityContext_BeginBlock(t *testing.T) {
 	er = ctx.SetProposalBlock("", addrBz, [][]byte{txBz})
 	require.NoError(t, er)
 
+	missingSigner := getAllTestingValidators(t, ctx)[1]
+	missingSignerAddr, er := hex.DecodeString(missingSigner.GetAddress())
+	require.NoError(t, er)
+	require.NoError(t, ctx.SetProposalMissingSigners([][]byte{missingSignerAddr}))
+
 	_, er = ctx.ApplyBlock()
 	require.NoError(t, er)
 
-	// // TODO: Uncomment this once `GetValidatorMissedBlocks` is implemented.
 	// beginBlock logic verify
-	// missed, err := ctx.GetValidatorMissedBlocks(byzantine.Address)
-	// require.NoError(t, err)
-	// require.Equal(t, missed, 1)
+	missed, err := ctx.GetValidatorMissedBlocks(missingSignerAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, missed)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMissedBlocks(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+
+	validator := getAllTestingValidators(t, ctx)[1]
+	addrBz, er := hex.DecodeString(validator.GetAddress())
+	require.NoError(t, er)
+
+	maxMissedBlocks, err := ctx.GetValidatorMaxMissedBlocks()
+	require.NoError(t, err)
+	stakeBefore, err := ctx.GetActorStakedTokens(coreTypes.ActorType_ACTOR_TYPE_VAL, addrBz)
+	require.NoError(t, err)
+	height, err := ctx.GetLatestBlockHeight()
+	require.NoError(t, err)
+
+	require.NoError(t, ctx.SetProposalMissingSigners([][]byte{addrBz}))
+	for i := 1; i < maxMissedBlocks; i++ {
+		require.NoError(t, ctx.BeginBlock(nil))
+		pauseHeight, err := ctx.GetPauseHeight(coreTypes.ActorType_ACTOR_TYPE_VAL, addrBz)
+		require.NoError(t, err)
+		require.Equal(t, typesUtil.HeightNotUsed, pauseHeight, "validator paused before missing the max missed blocks")
+	}
+
+	// the validator is jailed once it misses `validator_max_missed_blocks` blocks
+	require.NoError(t, ctx.BeginBlock(nil))
+	pauseHeight, err := ctx.GetPauseHeight(coreTypes.ActorType_ACTOR_TYPE_VAL, addrBz)
+	require.NoError(t, err)
+	require.Equal(t, height, pauseHeight)
+	stakeAfter, err := ctx.GetActorStakedTokens(coreTypes.ActorType_ACTOR_TYPE_VAL, addrBz)
+	require.NoError(t, err)
+	require.Equal(t, -1, stakeAfter.Cmp(stakeBefore), "the stake of the jailed validator is not burnt")
+
+	// a paused validator does not miss blocks anymore
+	require.NoError(t, ctx.BeginBlock(nil))
+	stakeAfterPause, err := ctx.GetActorStakedTokens(coreTypes.ActorType_ACTOR_TYPE_VAL, addrBz)
+	require.NoError(t, err)
+	require.Equal(t, stakeAfter, stakeAfterPause)
 
 	test_artifacts.CleanupTest(ctx)
 }
@@ -130,3 +196,15 @@ func TestUtilityContext_EndBlock(t *testing.T) {
 
 	test_artifacts.CleanupTest(ctx)
 }
+
+func TestUtilityContext_GetMedianBlockTime(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+
+	// The genesis block is the only committed block
+	genesisTime := testPersistenceMod.GetBus().GetRuntimeMgr().GetGenesis().GetGenesisTime().AsTime()
+	medianBlockTime, err := ctx.GetMedianBlockTime()
+	require.NoError(t, err)
+	require.True(t, genesisTime.Equal(medianBlockTime))
+
+	test_artifacts.CleanupTest(ctx)
+}
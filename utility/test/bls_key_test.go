@@ -0,0 +1,64 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	"github.com/pokt-network/pocket/shared/crypto"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_HandleMessageRegisterBLSKey(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	validator := getAllTestingValidators(t, ctx)[0]
+	addrBz, err := hex.DecodeString(validator.GetAddress())
+	require.NoError(t, err)
+
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	blsKey, err := crypto.NewBLSPrivateKeyFromPrivateKey(privateKey)
+	require.NoError(t, err)
+	proof, err := blsKey.ProvePossession()
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageRegisterBLSKey{
+		Address:           addrBz,
+		PublicKey:         blsKey.PublicKey(),
+		ProofOfPossession: proof,
+	}
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, ctx.HandleMessageRegisterBLSKey(msg), "handle register bls key message")
+
+	registeredKey, er := ctx.Store().GetValidatorBLSKey(addrBz, 0)
+	require.NoError(t, er)
+	require.Equal(t, blsKey.PublicKey(), registeredKey.GetPublicKey(), "incorrect BLS key")
+	require.Equal(t, proof, registeredKey.GetProofOfPossession(), "incorrect proof of possession")
+
+	candidates, err := ctx.GetMessageRegisterBLSKeySignerCandidates(msg)
+	require.NoError(t, err)
+	require.Contains(t, candidates, addrBz, "the operator can register the BLS key of the validator")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageRegisterBLSKey_NotAValidator(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	blsKey, err := crypto.NewBLSPrivateKeyFromPrivateKey(privateKey)
+	require.NoError(t, err)
+	proof, err := blsKey.ProvePossession()
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageRegisterBLSKey{
+		Address:           privateKey.Address(),
+		PublicKey:         blsKey.PublicKey(),
+		ProofOfPossession: proof,
+	}
+	require.Equal(t, typesUtil.CodeNotExistsError, ctx.HandleMessageRegisterBLSKey(msg).Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
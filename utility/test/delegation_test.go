@@ -0,0 +1,243 @@
+package test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_HandleMessageDelegate(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+
+	delegatorBalanceBefore, err := ctx.GetAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	delegationPoolBefore, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName())
+	require.NoError(t, err)
+
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+	// delegating again to the same actor adds to the existing delegation
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+
+	delegation, er := ctx.Store().GetDelegation(delegatorAddr, validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	totalDelegated := new(big.Int).Mul(test_artifacts.DefaultStakeAmount, big.NewInt(2))
+	require.Equal(t, typesUtil.BigIntToString(totalDelegated), delegation.Amount)
+	require.Equal(t, coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED, delegation.Status)
+	require.Equal(t, typesUtil.HeightNotUsed, delegation.UnbondingHeight)
+
+	delegatorBalanceAfter, err := ctx.GetAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(delegatorBalanceBefore, totalDelegated), delegatorBalanceAfter, "delegation not taken from the delegator")
+	delegationPoolAfter, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(delegationPoolBefore, totalDelegated), delegationPoolAfter, "delegation not held in the delegation pool")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageDelegate_Invalid(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+
+	msg := &typesUtil.MessageDelegate{
+		Delegator:    delegatorAddr,
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		ActorAddress: delegatorAddr,
+		Amount:       test_artifacts.DefaultStakeAmountString,
+	}
+	err := ctx.HandleMessageDelegate(msg)
+	require.Equal(t, typesUtil.CodeNotExistsError, err.Code(), "the actor delegated to does not exist")
+
+	msg.ActorAddress = validatorAddr
+	msg.Amount = typesUtil.BigIntToString(new(big.Int).Add(test_artifacts.DefaultAccountAmount, big.NewInt(1)))
+	err = ctx.HandleMessageDelegate(msg)
+	require.Equal(t, typesUtil.CodeInsufficientAmountError, err.Code(), "delegation above the delegator's balance")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageUndelegate(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+
+	msg := &typesUtil.MessageUndelegate{Delegator: delegatorAddr, ActorAddress: validatorAddr, ActorType: coreTypes.ActorType_ACTOR_TYPE_VAL}
+	err := ctx.HandleMessageUndelegate(msg)
+	require.Equal(t, typesUtil.CodeDelegationNotFoundError, err.Code(), "nothing delegated yet")
+
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+	require.NoError(t, ctx.HandleMessageUndelegate(msg), "handle message undelegate")
+
+	expectedUnbondingHeight, err := ctx.GetUnstakingHeight(coreTypes.ActorType_ACTOR_TYPE_VAL)
+	require.NoError(t, err)
+	delegation, er := ctx.Store().GetDelegation(delegatorAddr, validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, coreTypes.DelegationStatus_DELEGATION_STATUS_UNBONDING, delegation.Status)
+	require.Equal(t, expectedUnbondingHeight, delegation.UnbondingHeight)
+
+	err = ctx.HandleMessageUndelegate(msg)
+	require.Equal(t, typesUtil.CodeDelegationNotBondedError, err.Code(), "already unbonding")
+
+	err = ctx.HandleMessageDelegate(&typesUtil.MessageDelegate{
+		Delegator:    delegatorAddr,
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		ActorAddress: validatorAddr,
+		Amount:       test_artifacts.DefaultStakeAmountString,
+	})
+	require.Equal(t, typesUtil.CodeDelegationNotBondedError, err.Code(), "cannot add to an unbonding delegation")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_WithdrawUnbondedDelegations(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+	require.NoError(t, ctx.Context.SetParam(typesUtil.ValidatorUnstakingBlocksParamName, 0))
+
+	delegatorBalanceBefore, err := ctx.GetAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+	require.NoError(t, ctx.HandleMessageUndelegate(&typesUtil.MessageUndelegate{Delegator: delegatorAddr, ActorAddress: validatorAddr, ActorType: coreTypes.ActorType_ACTOR_TYPE_VAL}))
+	require.NoError(t, ctx.WithdrawUnbondedDelegations(), "withdraw unbonded delegations")
+
+	delegation, er := ctx.Store().GetDelegation(delegatorAddr, validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, coreTypes.DelegationStatus_DELEGATION_STATUS_WITHDRAWN, delegation.Status)
+
+	delegatorBalanceAfter, err := ctx.GetAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, delegatorBalanceBefore, delegatorBalanceAfter, "delegation not returned to the delegator")
+
+	delegations, er := ctx.Store().GetActorDelegations(validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Empty(t, delegations, "withdrawn delegations are not returned")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageSetCommission(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	_, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+
+	commission, er := ctx.Store().GetCommission(validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, uint32(0), commission, "the commission defaults to zero")
+
+	msg := &typesUtil.MessageSetCommission{ActorType: coreTypes.ActorType_ACTOR_TYPE_VAL, Address: validatorAddr, Percentage: 10}
+	require.NoError(t, ctx.HandleMessageSetCommission(msg), "handle message set commission")
+
+	commission, er = ctx.Store().GetCommission(validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, uint32(10), commission)
+
+	// the commission cannot be raised by more than the max change until the change period is over
+	msg.Percentage = 10 + uint32(DefaultTestingParams(t).GetCommissionMaxChangePercentage())
+	err := ctx.HandleMessageSetCommission(msg)
+	require.Equal(t, typesUtil.CodeCommissionChangeTooLargeError, err.Code())
+
+	// lowering the commission is not limited
+	msg.Percentage = 0
+	require.NoError(t, ctx.HandleMessageSetCommission(msg), "lower the commission")
+
+	candidates, err := ctx.GetMessageSetCommissionSignerCandidates(msg)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+	require.Equal(t, validatorAddr, candidates[1])
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleProposalRewards_Delegations(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+
+	commissionPercentage := uint32(10)
+	require.NoError(t, ctx.HandleMessageSetCommission(&typesUtil.MessageSetCommission{
+		ActorType:  coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:    validatorAddr,
+		Percentage: commissionPercentage,
+	}))
+	// the delegation equals the validator's own stake, so the delegator is owed half of the rewards minus the commission
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+
+	fees := big.NewInt(1000000)
+	require.NoError(t, ctx.SetPoolAmount(coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName(), fees))
+
+	delegatorBalanceBefore, err := ctx.GetAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	validatorBalanceBefore, err := ctx.GetAccountAmount(validatorAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, ctx.HandleProposalRewards(validatorAddr), "handle proposal rewards")
+
+	proposerCutPercentage, err := ctx.GetProposerPercentageOfFees()
+	require.NoError(t, err)
+	amountToProposer := new(big.Int).Mul(fees, big.NewInt(int64(proposerCutPercentage)))
+	amountToProposer.Quo(amountToProposer, big.NewInt(100))
+	delegatorsShare := new(big.Int).Quo(amountToProposer, big.NewInt(2))
+	commission := new(big.Int).Mul(delegatorsShare, big.NewInt(int64(commissionPercentage)))
+	commission.Quo(commission, big.NewInt(100))
+	expectedDelegatorReward := new(big.Int).Sub(delegatorsShare, commission)
+
+	delegatorBalanceAfter, err := ctx.GetAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, expectedDelegatorReward, new(big.Int).Sub(delegatorBalanceAfter, delegatorBalanceBefore))
+	validatorBalanceAfter, err := ctx.GetAccountAmount(validatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(amountToProposer, expectedDelegatorReward), new(big.Int).Sub(validatorBalanceAfter, validatorBalanceBefore))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_BurnActor_Delegations(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+
+	delegationPoolBefore, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName())
+	require.NoError(t, err)
+
+	burnPercentage := 10
+	require.NoError(t, ctx.BurnActor(coreTypes.ActorType_ACTOR_TYPE_VAL, burnPercentage, validatorAddr), "burn actor")
+
+	slashed := new(big.Int).Mul(test_artifacts.DefaultStakeAmount, big.NewInt(int64(burnPercentage)))
+	slashed.Quo(slashed, big.NewInt(100))
+	delegation, er := ctx.Store().GetDelegation(delegatorAddr, validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, typesUtil.BigIntToString(new(big.Int).Sub(test_artifacts.DefaultStakeAmount, slashed)), delegation.Amount)
+
+	delegationPoolAfter, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_DELEGATION.FriendlyName())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(delegationPoolBefore, slashed), delegationPoolAfter, "slashed delegations not burnt from the delegation pool")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+// getTestingDelegatorAndValidator returns an application's address as the delegator so that its
+// balance is not affected by validator rewards
+func getTestingDelegatorAndValidator(t *testing.T, ctx utility.UtilityContext) (delegatorAddr, validatorAddr []byte) {
+	delegator := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_APP)
+	validator := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	delegatorAddr, err := hex.DecodeString(delegator.GetAddress())
+	require.NoError(t, err)
+	validatorAddr, err = hex.DecodeString(validator.GetAddress())
+	require.NoError(t, err)
+	return
+}
+
+func delegateToTestingValidator(t *testing.T, ctx utility.UtilityContext, delegatorAddr, validatorAddr []byte, amount string) {
+	msg := &typesUtil.MessageDelegate{
+		Delegator:    delegatorAddr,
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		ActorAddress: validatorAddr,
+		Amount:       amount,
+	}
+	require.NoError(t, ctx.HandleMessageDelegate(msg), "handle message delegate")
+}
@@ -0,0 +1,93 @@
+package test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_HandleByzantineValidators(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	evidence, doubleSigner := newTestingDoubleSignEvidence(t, ctx, 1)
+
+	stakeBefore, err := ctx.GetActorStakedTokens(coreTypes.ActorType_ACTOR_TYPE_VAL, doubleSigner)
+	require.NoError(t, err)
+
+	require.NoError(t, ctx.SetProposalEvidence([]*coreTypes.DoubleSignEvidence{evidence}))
+	byzantineValidators, er := ctx.GetLastBlockByzantineValidators()
+	require.NoError(t, er)
+	require.Equal(t, [][]byte{doubleSigner}, byzantineValidators)
+	require.NoError(t, ctx.BeginBlock(byzantineValidators))
+
+	// the stake of the double signer is burnt
+	burnPercentage, err := ctx.GetDoubleSignBurnPercentage()
+	require.NoError(t, err)
+	burnt := new(big.Int).Quo(new(big.Int).Mul(stakeBefore, big.NewInt(int64(burnPercentage))), big.NewInt(100))
+	stakeAfter, err := ctx.GetActorStakedTokens(coreTypes.ActorType_ACTOR_TYPE_VAL, doubleSigner)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(stakeBefore, burnt), stakeAfter)
+
+	// the same double sign cannot be handled twice
+	_, er = ctx.GetLastBlockByzantineValidators()
+	require.Equal(t, typesUtil.CodeInvalidEvidenceError, er.(typesUtil.Error).Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetLastBlockByzantineValidators_MaxEvidenceAge(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 10)
+	maxEvidenceAge, err := ctx.GetMaxEvidenceAgeInBlocks()
+	require.NoError(t, err)
+
+	oldEvidence, _ := newTestingDoubleSignEvidence(t, ctx, uint64(10-maxEvidenceAge-1))
+	recentEvidence, _ := newTestingDoubleSignEvidence(t, ctx, uint64(10-maxEvidenceAge))
+
+	// verifiers reject blocks with evidence that is too old
+	require.NoError(t, ctx.SetProposalEvidence([]*coreTypes.DoubleSignEvidence{oldEvidence, recentEvidence}))
+	_, er := ctx.GetLastBlockByzantineValidators()
+	require.Equal(t, typesUtil.CodeMaxEvidenceAgeError, er.(typesUtil.Error).Code())
+
+	// proposers drop it
+	proposer := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	proposerAddr, er := hex.DecodeString(proposer.GetAddress())
+	require.NoError(t, er)
+	_, _, er = ctx.CreateAndApplyProposalBlock(proposerAddr, 10000)
+	require.NoError(t, er)
+	require.Equal(t, []*coreTypes.DoubleSignEvidence{recentEvidence}, ctx.GetProposalEvidence())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+// newTestingDoubleSignEvidence stakes a new validator and returns the evidence of a double sign at `height`
+func newTestingDoubleSignEvidence(t *testing.T, ctx utility.UtilityContext, height uint64) (*coreTypes.DoubleSignEvidence, []byte) {
+	privKey, er := crypto.GeneratePrivateKey()
+	require.NoError(t, er)
+	address := privKey.Address().Bytes()
+	stake := test_artifacts.DefaultStakeAmountString
+	require.NoError(t, ctx.Store().InsertValidator(address, privKey.PublicKey().Bytes(), address, false,
+		int32(typesUtil.StakeStatus_Staked), test_artifacts.DefaultServiceURL, stake, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed))
+	require.NoError(t, ctx.AddPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.FriendlyName(), test_artifacts.DefaultStakeAmount))
+
+	voteA, voteB := []byte("vote for block A"), []byte("vote for block B")
+	signatureA, er := privKey.Sign(voteA)
+	require.NoError(t, er)
+	signatureB, er := privKey.Sign(voteB)
+	require.NoError(t, er)
+	return &coreTypes.DoubleSignEvidence{
+		PublicKey:  privKey.PublicKey().Bytes(),
+		Height:     height,
+		Round:      0,
+		Step:       2,
+		VoteA:      voteA,
+		SignatureA: signatureA,
+		VoteB:      voteB,
+		SignatureB: signatureB,
+	}, address
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_AnteHandleMessage_DynamicFees(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	activateDynamicFees(t, ctx)
+
+	baseFee, err := ctx.GetFeeMarketMinBaseFee()
+	require.NoError(t, err)
+	tip := big.NewInt(500)
+
+	tx, startingBalance, _, signer := newTestingTransaction(t, ctx)
+	tx.Tip = typesUtil.BigIntToString(tip)
+	require.NoError(t, tx.Sign(signer))
+
+	_, _, err = ctx.AnteHandleMessage(tx)
+	require.NoError(t, err)
+
+	// the signer pays the base fee plus the tip
+	expectedAfterBalance := new(big.Int).Sub(startingBalance, new(big.Int).Add(baseFee, tip))
+	amount, err := ctx.GetAccountAmount(signer.Address())
+	require.NoError(t, err)
+	require.Equal(t, expectedAfterBalance, amount, "unexpected after balance")
+
+	// the base fee is burnt and only the tip is collected for the proposer
+	feeCollector, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName())
+	require.NoError(t, err)
+	require.Equal(t, tip, feeCollector)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_AnteHandleMessage_DynamicFeesMaxFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	activateDynamicFees(t, ctx)
+
+	baseFee, err := ctx.GetFeeMarketMinBaseFee()
+	require.NoError(t, err)
+
+	// the tip is reduced so that the total does not exceed the max fee
+	maxFee := new(big.Int).Add(baseFee, big.NewInt(200))
+	tx, startingBalance, _, signer := newTestingTransaction(t, ctx)
+	tx.MaxFee = typesUtil.BigIntToString(maxFee)
+	tx.Tip = "500"
+	require.NoError(t, tx.Sign(signer))
+
+	msg, err := tx.Message()
+	require.NoError(t, err)
+	collected, burnt, err := ctx.GetTransactionFee(tx, msg)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), collected)
+	require.Equal(t, baseFee, burnt)
+
+	_, _, err = ctx.AnteHandleMessage(tx)
+	require.NoError(t, err)
+	amount, err := ctx.GetAccountAmount(signer.Address())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(startingBalance, maxFee), amount, "unexpected after balance")
+
+	// transactions that cannot afford the base fee are rejected
+	tx, _, _, signer = newTestingTransaction(t, ctx)
+	tx.MaxFee = typesUtil.BigIntToString(new(big.Int).Sub(baseFee, big.NewInt(1)))
+	require.NoError(t, tx.Sign(signer))
+	_, _, err = ctx.AnteHandleMessage(tx)
+	require.Equal(t, typesUtil.CodeMaxFeeBelowBaseFeeError, err.Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_UpdateBaseFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+
+	// the base fee is not tracked before the upgrade activates
+	require.NoError(t, ctx.UpdateBaseFee(0))
+	baseFee, err := ctx.Store().GetBaseFee(0)
+	require.NoError(t, err)
+	require.Empty(t, baseFee)
+
+	activateDynamicFees(t, ctx)
+	minBaseFee, err := ctx.GetFeeMarketMinBaseFee()
+	require.NoError(t, err)
+	targetBlockSizeInBytes, err := ctx.GetFeeMarketTargetBlockSizeBytes()
+	require.NoError(t, err)
+	changeDenominator, err := ctx.GetFeeMarketBaseFeeChangeDenominator()
+	require.NoError(t, err)
+
+	// a block twice the target size increases the base fee of the next block
+	require.NoError(t, ctx.UpdateBaseFee(2*targetBlockSizeInBytes))
+	baseFee, err = ctx.Store().GetBaseFee(0)
+	require.NoError(t, err)
+	expected := new(big.Int).Add(minBaseFee, new(big.Int).Quo(minBaseFee, big.NewInt(int64(changeDenominator))))
+	require.Equal(t, typesUtil.BigIntToString(expected), baseFee)
+
+	// an empty block cannot bring it below the minimum
+	require.NoError(t, ctx.UpdateBaseFee(0))
+	baseFee, err = ctx.Store().GetBaseFee(0)
+	require.NoError(t, err)
+	require.Equal(t, typesUtil.BigIntToString(minBaseFee), baseFee)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func activateDynamicFees(t *testing.T, ctx utility.UtilityContext) {
+	require.NoError(t, ctx.Store().SetFlag(typesUtil.UpgradeFlagName(typesUtil.DynamicFeesUpgrade), 0, true))
+}
@@ -120,6 +120,39 @@ func TestUtilityContext_GetDoubleSignFeeOwner(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetFeeMarketBaseFeeChangeDenominator(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int(defaultParams.GetFeeMarketBaseFeeChangeDenominator())
+	gotParam, err := ctx.GetFeeMarketBaseFeeChangeDenominator()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetFeeMarketMinBaseFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetFeeMarketMinBaseFee()
+	gotParam, err := ctx.GetFeeMarketMinBaseFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetFeeMarketTargetBlockSizeBytes(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int(defaultParams.GetFeeMarketTargetBlockSizeBytes())
+	gotParam, err := ctx.GetFeeMarketTargetBlockSizeBytes()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetFishermanMaxChains(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -175,6 +208,50 @@ func TestUtilityContext_GetFishermanUnstakingBlocks(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetGovernanceMinDeposit(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetGovernanceMinDeposit()
+	gotParam, err := ctx.GetGovernanceMinDeposit()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetGovernancePassThresholdPercentage(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int(defaultParams.GetGovernancePassThresholdPercentage())
+	gotParam, err := ctx.GetGovernancePassThresholdPercentage()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetGovernanceQuorumPercentage(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int(defaultParams.GetGovernanceQuorumPercentage())
+	gotParam, err := ctx.GetGovernanceQuorumPercentage()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetGovernanceVotingPeriodBlocks(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int64(defaultParams.GetGovernanceVotingPeriodBlocks())
+	gotParam, err := ctx.GetGovernanceVotingPeriodBlocks()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMaxEvidenceAgeInBlocks(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -186,6 +263,17 @@ func TestUtilityContext_GetMaxEvidenceAgeInBlocks(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageChangeOutputAddressFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageChangeOutputAddressFee()
+	gotParam, err := ctx.GetMessageChangeOutputAddressFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMessageChangeParameterFee(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -197,6 +285,17 @@ func TestUtilityContext_GetMessageChangeParameterFee(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageDelegateFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageDelegateFee()
+	gotParam, err := ctx.GetMessageDelegateFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMessageDoubleSignFee(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -318,6 +417,28 @@ func TestUtilityContext_GetMessageProveTestScoreFee(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageRegisterBLSKeyFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageRegisterBlsKeyFee()
+	gotParam, err := ctx.GetMessageRegisterBLSKeyFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetMessageRotateKeyFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageRotateKeyFee()
+	gotParam, err := ctx.GetMessageRotateKeyFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMessageSendFee(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -329,6 +450,17 @@ func TestUtilityContext_GetMessageSendFee(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageSetCommissionFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageSetCommissionFee()
+	gotParam, err := ctx.GetMessageSetCommissionFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMessageStakeAppFee(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -373,6 +505,17 @@ func TestUtilityContext_GetMessageStakeValidatorFee(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageSubmitProposalFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageSubmitProposalFee()
+	gotParam, err := ctx.GetMessageSubmitProposalFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMessageTestScoreFee(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -384,6 +527,17 @@ func TestUtilityContext_GetMessageTestScoreFee(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageUndelegateFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageUndelegateFee()
+	gotParam, err := ctx.GetMessageUndelegateFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMessageUnpauseAppFee(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -472,6 +626,28 @@ func TestUtilityContext_GetMessageUnstakeValidatorFee(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetMessageUpgradePlanFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageUpgradePlanFee()
+	gotParam, err := ctx.GetMessageUpgradePlanFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetMessageVoteFee(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageVoteFee()
+	gotParam, err := ctx.GetMessageVoteFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, typesUtil.BigIntToString(gotParam))
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetMissedBlocksBurnPercentage(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -560,6 +736,39 @@ func TestUtilityContext_GetStakingAdjustment(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_GetTransactionMaxValidityBlocks(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int64(defaultParams.GetTransactionMaxValidityBlocks())
+	gotParam, err := ctx.GetTransactionMaxValidityBlocks()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetCommissionMaxChangePercentage(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int(defaultParams.GetCommissionMaxChangePercentage())
+	gotParam, err := ctx.GetCommissionMaxChangePercentage()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_GetCommissionChangePeriodBlocks(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := int64(defaultParams.GetCommissionChangePeriodBlocks())
+	gotParam, err := ctx.GetCommissionChangePeriodBlocks()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, gotParam)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_GetValidatorMaxMissedBlocks(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 	defaultParams := DefaultTestingParams(t)
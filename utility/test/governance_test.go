@@ -0,0 +1,245 @@
+package test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityContext_HandleMessageSubmitProposal(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	proposer := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	proposerAddr, er := hex.DecodeString(proposer.GetAddress())
+	require.NoError(t, er)
+
+	deposit := test_artifacts.DefaultParams().GetGovernanceMinDeposit()
+	proposerBalanceBefore, err := ctx.GetAccountAmount(proposerAddr)
+	require.NoError(t, err)
+	govPoolBefore, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_GOVERNANCE.FriendlyName())
+	require.NoError(t, err)
+
+	msg := newTestingParamChangeProposal(t, proposerAddr, typesUtil.MissedBlocksBurnPercentageParamName, 2, deposit)
+	require.NoError(t, ctx.HandleMessageSubmitProposal(msg), "handle message submit proposal")
+
+	proposal, er := ctx.Store().GetProposal(1, 0)
+	require.NoError(t, er)
+	require.Equal(t, proposer.GetAddress(), proposal.Proposer)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, proposal.Status)
+	require.Equal(t, int64(test_artifacts.DefaultParams().GetGovernanceVotingPeriodBlocks()), proposal.VotingEndHeight)
+
+	depositAmount, err := typesUtil.StringToBigInt(deposit)
+	require.NoError(t, err)
+	proposerBalanceAfter, err := ctx.GetAccountAmount(proposerAddr)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(proposerBalanceBefore, depositAmount), proposerBalanceAfter, "deposit not taken from the proposer")
+	govPoolAfter, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_GOVERNANCE.FriendlyName())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(govPoolBefore, depositAmount), govPoolAfter, "deposit not escrowed in the governance pool")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageSubmitProposal_Invalid(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	proposer := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	proposerAddr, er := hex.DecodeString(proposer.GetAddress())
+	require.NoError(t, er)
+
+	msg := newTestingParamChangeProposal(t, proposerAddr, typesUtil.MissedBlocksBurnPercentageParamName, 2, "1")
+	err := ctx.HandleMessageSubmitProposal(msg)
+	require.Equal(t, typesUtil.CodeInsufficientDepositError, err.Code(), "deposit below the minimum")
+
+	deposit := test_artifacts.DefaultParams().GetGovernanceMinDeposit()
+	msg = newTestingParamChangeProposal(t, proposerAddr, "not_a_param", 2, deposit)
+	err = ctx.HandleMessageSubmitProposal(msg)
+	require.Equal(t, typesUtil.CodeUnknownParamError, err.Code(), "unknown param")
+
+	msg = &typesUtil.MessageSubmitProposal{
+		Proposer:     proposerAddr,
+		ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND,
+		PoolName:     coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName(),
+		Recipient:    proposerAddr,
+		Amount:       "1",
+		Deposit:      deposit,
+	}
+	err = ctx.HandleMessageSubmitProposal(msg)
+	require.Equal(t, typesUtil.CodeUnknownPoolError, err.Code(), "pool not spendable via governance")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageVote(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	validators := getAllTestingValidators(t, ctx)
+	proposalId := submitTestingProposal(t, ctx, typesUtil.MissedBlocksBurnPercentageParamName, 2)
+
+	voterAddr, er := hex.DecodeString(validators[0].GetAddress())
+	require.NoError(t, er)
+	msg := &typesUtil.MessageVote{Voter: voterAddr, ProposalId: proposalId, Option: coreTypes.VoteOption_VOTE_OPTION_NO}
+	require.NoError(t, ctx.HandleMessageVote(msg), "handle message vote")
+
+	// votes can be changed during the voting period, only the latest one counts
+	msg.Option = coreTypes.VoteOption_VOTE_OPTION_YES
+	require.NoError(t, ctx.HandleMessageVote(msg), "handle message vote")
+
+	votes, er := ctx.Store().GetProposalVotes(proposalId, 0)
+	require.NoError(t, er)
+	require.Len(t, votes, 1)
+	require.Equal(t, validators[0].GetAddress(), votes[0].Voter)
+	require.Equal(t, coreTypes.VoteOption_VOTE_OPTION_YES, votes[0].Option)
+
+	// only validators are allowed to vote
+	app := getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_APP)
+	appAddr, er := hex.DecodeString(app.GetAddress())
+	require.NoError(t, er)
+	msg = &typesUtil.MessageVote{Voter: appAddr, ProposalId: proposalId, Option: coreTypes.VoteOption_VOTE_OPTION_YES}
+	require.Error(t, ctx.HandleMessageVote(msg))
+
+	// proposals that do not exist cannot be voted on
+	msg = &typesUtil.MessageVote{Voter: voterAddr, ProposalId: proposalId + 1, Option: coreTypes.VoteOption_VOTE_OPTION_YES}
+	err := ctx.HandleMessageVote(msg)
+	require.Equal(t, typesUtil.CodeGetProposalError, err.Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleProposalTallies(t *testing.T) {
+	tests := []struct {
+		name           string
+		option         coreTypes.VoteOption
+		numVoters      int
+		expectedStatus coreTypes.ProposalStatus
+		expectApplied  bool
+		expectRefund   bool
+	}{
+		{"passes with a quorum of yes votes", coreTypes.VoteOption_VOTE_OPTION_YES, -1, coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED, true, true},
+		{"rejected with a quorum of no votes", coreTypes.VoteOption_VOTE_OPTION_NO, -1, coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED, false, true},
+		{"rejected with a quorum of abstain votes", coreTypes.VoteOption_VOTE_OPTION_ABSTAIN, -1, coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED, false, true},
+		{"rejected and deposit burnt without quorum", coreTypes.VoteOption_VOTE_OPTION_YES, 0, coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := NewTestingUtilityContext(t, 0)
+			// tally the proposal at the height it is submitted
+			require.NoError(t, ctx.UpdateParam(typesUtil.GovernanceVotingPeriodBlocksParamName, &wrapperspb.Int32Value{Value: 0}))
+
+			validators := getAllTestingValidators(t, ctx)
+			proposer, er := hex.DecodeString(validators[0].GetAddress())
+			require.NoError(t, er)
+			proposerBalanceBefore, err := ctx.GetAccountAmount(proposer)
+			require.NoError(t, err)
+			paramBefore, err := ctx.GetMissedBlocksBurnPercentage()
+			require.NoError(t, err)
+
+			proposalId := submitTestingProposal(t, ctx, typesUtil.MissedBlocksBurnPercentageParamName, int32(paramBefore+1))
+
+			numVoters := test.numVoters
+			if numVoters == -1 {
+				numVoters = len(validators)
+			}
+			for _, validator := range validators[:numVoters] {
+				voter, er := hex.DecodeString(validator.GetAddress())
+				require.NoError(t, er)
+				require.NoError(t, ctx.HandleMessageVote(&typesUtil.MessageVote{Voter: voter, ProposalId: proposalId, Option: test.option}))
+			}
+			require.NoError(t, ctx.HandleProposalTallies(), "handle proposal tallies")
+
+			proposal, er := ctx.Store().GetProposal(proposalId, 0)
+			require.NoError(t, er)
+			require.Equal(t, test.expectedStatus, proposal.Status)
+
+			paramAfter, err := ctx.GetMissedBlocksBurnPercentage()
+			require.NoError(t, err)
+			if test.expectApplied {
+				require.Equal(t, paramBefore+1, paramAfter, "passed proposal not applied")
+			} else {
+				require.Equal(t, paramBefore, paramAfter, "rejected proposal applied")
+			}
+
+			proposerBalanceAfter, err := ctx.GetAccountAmount(proposer)
+			require.NoError(t, err)
+			if test.expectRefund {
+				require.Equal(t, proposerBalanceBefore, proposerBalanceAfter, "deposit not refunded")
+			} else {
+				deposit, err := typesUtil.StringToBigInt(proposal.Deposit)
+				require.NoError(t, err)
+				require.Equal(t, new(big.Int).Sub(proposerBalanceBefore, deposit), proposerBalanceAfter, "deposit not burnt")
+			}
+
+			test_artifacts.CleanupTest(ctx)
+		})
+	}
+}
+
+func TestUtilityContext_HandleProposalTallies_PoolSpend(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	require.NoError(t, ctx.UpdateParam(typesUtil.GovernanceVotingPeriodBlocksParamName, &wrapperspb.Int32Value{Value: 0}))
+
+	validators := getAllTestingValidators(t, ctx)
+	proposer, er := hex.DecodeString(validators[0].GetAddress())
+	require.NoError(t, er)
+	recipient, er := hex.DecodeString(GetAllTestingAccounts(t, ctx)[0].GetAddress())
+	require.NoError(t, er)
+
+	spendAmount := big.NewInt(1000)
+	daoBefore, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_DAO.FriendlyName())
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageSubmitProposal{
+		Proposer:     proposer,
+		ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND,
+		PoolName:     coreTypes.Pools_POOLS_DAO.FriendlyName(),
+		Recipient:    recipient,
+		Amount:       typesUtil.BigIntToString(spendAmount),
+		Deposit:      test_artifacts.DefaultParams().GetGovernanceMinDeposit(),
+	}
+	require.NoError(t, ctx.HandleMessageSubmitProposal(msg))
+	for _, validator := range validators {
+		voter, er := hex.DecodeString(validator.GetAddress())
+		require.NoError(t, er)
+		require.NoError(t, ctx.HandleMessageVote(&typesUtil.MessageVote{Voter: voter, ProposalId: 1, Option: coreTypes.VoteOption_VOTE_OPTION_YES}))
+	}
+	recipientBefore, err := ctx.GetAccountAmount(recipient)
+	require.NoError(t, err)
+	require.NoError(t, ctx.HandleProposalTallies(), "handle proposal tallies")
+
+	daoAfter, err := ctx.GetPoolAmount(coreTypes.Pools_POOLS_DAO.FriendlyName())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(daoBefore, spendAmount), daoAfter)
+	recipientAfter, err := ctx.GetAccountAmount(recipient)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(recipientBefore, spendAmount), recipientAfter)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func newTestingParamChangeProposal(t *testing.T, proposer []byte, key string, value int32, deposit string) *typesUtil.MessageSubmitProposal {
+	pbValue, err := anypb.New(wrapperspb.Int32(value))
+	require.NoError(t, err)
+	return &typesUtil.MessageSubmitProposal{
+		Proposer:     proposer,
+		ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE,
+		Key:          key,
+		Value:        pbValue,
+		Deposit:      deposit,
+	}
+}
+
+func submitTestingProposal(t *testing.T, ctx utility.UtilityContext, key string, value int32) uint64 {
+	proposer, er := hex.DecodeString(getFirstActor(t, ctx, coreTypes.ActorType_ACTOR_TYPE_VAL).GetAddress())
+	require.NoError(t, er)
+	deposit := test_artifacts.DefaultParams().GetGovernanceMinDeposit()
+	require.NoError(t, ctx.HandleMessageSubmitProposal(newTestingParamChangeProposal(t, proposer, key, value, deposit)))
+
+	proposals, er := ctx.Store().GetAllProposals(0)
+	require.NoError(t, er)
+	return proposals[len(proposals)-1].Id
+}
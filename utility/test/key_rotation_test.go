@@ -0,0 +1,135 @@
+package test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_HandleMessageRotateKey(t *testing.T) {
+	for _, actorType := range actorTypes {
+		t.Run(fmt.Sprintf("%s.HandleMessageRotateKey", actorType.String()), func(t *testing.T) {
+			ctx := NewTestingUtilityContext(t, 0)
+			actor := getFirstActor(t, ctx, actorType)
+			addrBz, err := hex.DecodeString(actor.GetAddress())
+			require.NoError(t, err)
+
+			newPublicKey, err := crypto.GeneratePublicKey()
+			require.NoError(t, err)
+
+			msg := &typesUtil.MessageRotateKey{
+				ActorType:    actorType,
+				Address:      addrBz,
+				NewPublicKey: newPublicKey.Bytes(),
+			}
+			require.NoError(t, ctx.HandleMessageRotateKey(msg), "handle rotate key message")
+
+			exists, err := ctx.GetActorExists(actorType, addrBz)
+			require.NoError(t, err)
+			require.False(t, exists, "the old operator key should not be an actor anymore")
+
+			rotatedActor := getActorByAddr(t, ctx, actorType, newPublicKey.Address().String())
+			require.Equal(t, hex.EncodeToString(newPublicKey.Bytes()), rotatedActor.GetPublicKey(), "incorrect public key")
+			require.Equal(t, actor.GetStakedAmount(), rotatedActor.GetStakedAmount(), "stake not moved")
+			require.Equal(t, actor.GetOutput(), rotatedActor.GetOutput(), "output address not moved")
+			require.Equal(t, actor.GetChains(), rotatedActor.GetChains(), "chains not moved")
+
+			// the actor at the old operator key can no longer be rotated
+			require.Equal(t, typesUtil.CodeNotExistsError, ctx.HandleMessageRotateKey(msg).Code())
+
+			test_artifacts.CleanupTest(ctx)
+		})
+	}
+}
+
+func TestUtilityContext_HandleMessageRotateKey_AlreadyExists(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	validators := getAllTestingValidators(t, ctx)
+	require.Greater(t, len(validators), 1)
+
+	addrBz, err := hex.DecodeString(validators[0].GetAddress())
+	require.NoError(t, err)
+	otherPublicKey, err := hex.DecodeString(validators[1].GetPublicKey())
+	require.NoError(t, err)
+
+	err = ctx.HandleMessageRotateKey(&typesUtil.MessageRotateKey{
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:      addrBz,
+		NewPublicKey: otherPublicKey,
+	})
+	require.Equal(t, typesUtil.CodeAlreadyExistsError, err.(typesUtil.Error).Code(), "the key of another validator cannot be used")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageRotateKey_Delegations(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	delegatorAddr, validatorAddr := getTestingDelegatorAndValidator(t, ctx)
+	delegateToTestingValidator(t, ctx, delegatorAddr, validatorAddr, test_artifacts.DefaultStakeAmountString)
+	require.NoError(t, ctx.HandleMessageSetCommission(&typesUtil.MessageSetCommission{
+		ActorType:  coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:    validatorAddr,
+		Percentage: 10,
+	}))
+
+	newPublicKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+	require.NoError(t, ctx.HandleMessageRotateKey(&typesUtil.MessageRotateKey{
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:      validatorAddr,
+		NewPublicKey: newPublicKey.Bytes(),
+	}))
+
+	delegation, er := ctx.Store().GetDelegation(delegatorAddr, newPublicKey.Address(), coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, test_artifacts.DefaultStakeAmountString, delegation.Amount, "delegation not moved")
+	require.Equal(t, coreTypes.DelegationStatus_DELEGATION_STATUS_BONDED, delegation.Status)
+
+	delegations, er := ctx.Store().GetActorDelegations(validatorAddr, coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Empty(t, delegations, "delegations to the old operator key should be withdrawn")
+
+	commission, er := ctx.Store().GetCommission(newPublicKey.Address(), coreTypes.ActorType_ACTOR_TYPE_VAL, 0)
+	require.NoError(t, er)
+	require.Equal(t, uint32(10), commission, "commission not moved")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageChangeOutputAddress(t *testing.T) {
+	for _, actorType := range actorTypes {
+		t.Run(fmt.Sprintf("%s.HandleMessageChangeOutputAddress", actorType.String()), func(t *testing.T) {
+			ctx := NewTestingUtilityContext(t, 0)
+			actor := getFirstActor(t, ctx, actorType)
+			addrBz, err := hex.DecodeString(actor.GetAddress())
+			require.NoError(t, err)
+
+			newOutputAddress, err := crypto.GenerateAddress()
+			require.NoError(t, err)
+
+			msg := &typesUtil.MessageChangeOutputAddress{
+				ActorType:        actorType,
+				Address:          addrBz,
+				NewOutputAddress: newOutputAddress,
+			}
+			require.NoError(t, ctx.HandleMessageChangeOutputAddress(msg), "handle change output address message")
+
+			output, err := ctx.GetActorOutputAddress(actorType, addrBz)
+			require.NoError(t, err)
+			require.Equal(t, []byte(newOutputAddress), output, "output address not changed")
+
+			// only the new output address can sign for the actor's stake record
+			candidates, err := ctx.GetMessageRotateKeySignerCandidates(&typesUtil.MessageRotateKey{ActorType: actorType, Address: addrBz})
+			require.NoError(t, err)
+			require.Equal(t, [][]byte{newOutputAddress}, candidates)
+
+			test_artifacts.CleanupTest(ctx)
+		})
+	}
+}
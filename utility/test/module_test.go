@@ -147,6 +147,11 @@ func mockBusInTestModules(t *testing.T) {
 	busMock.EXPECT().GetPersistenceModule().Return(testPersistenceMod).AnyTimes()
 	busMock.EXPECT().GetUtilityModule().Return(testUtilityMod).AnyTimes()
 
+	// the mempool admission checks the validity window of the transactions against the current height
+	consensusMock := mockModules.NewMockConsensusModule(ctrl)
+	consensusMock.EXPECT().CurrentHeight().Return(uint64(0)).AnyTimes()
+	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
+
 	testPersistenceMod.SetBus(busMock)
 	testUtilityMod.SetBus(busMock)
 
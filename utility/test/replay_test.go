@@ -0,0 +1,61 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_CheckTransactionReplay(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+
+	tx, _, _, signer := newTestingTransaction(t, ctx)
+	txHash, err := tx.Hash()
+	require.NoError(t, err)
+	require.NoError(t, ctx.CheckTransactionReplay(txHash, tx))
+
+	// a committed transaction is rejected until its max height
+	require.NoError(t, ctx.Store().InsertTransactionHash(txHash, tx.MaxHeight))
+	err = ctx.CheckTransactionReplay(txHash, tx)
+	require.Equal(t, typesUtil.CodeTransactionAlreadyCommittedError, err.Code())
+
+	// after which it is pruned since it cannot be included anymore
+	require.NoError(t, ctx.Store().PruneTransactionHashes(tx.MaxHeight))
+	exists, er := ctx.Store().GetTransactionHashExists(txHash, 1)
+	require.NoError(t, er)
+	require.False(t, exists)
+
+	tx.MaxHeight = 0
+	require.NoError(t, tx.Sign(signer))
+	err = ctx.CheckTransactionReplay(txHash, tx)
+	require.Equal(t, typesUtil.CodeTransactionExpiredError, err.Code())
+
+	maxValidityBlocks, err := ctx.GetTransactionMaxValidityBlocks()
+	require.NoError(t, err)
+	tx.MaxHeight = 1 + maxValidityBlocks + 1
+	require.NoError(t, tx.Sign(signer))
+	err = ctx.CheckTransactionReplay(txHash, tx)
+	require.Equal(t, typesUtil.CodeMaxHeightBeyondValidityWindowError, err.Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_PruneExpiredTransactionHashes(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+
+	tx, _, _, _ := newTestingTransaction(t, ctx)
+	txHash, err := tx.Hash()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), tx.MaxHeight)
+	require.NoError(t, ctx.Store().InsertTransactionHash(txHash, tx.MaxHeight))
+
+	// the transaction can still be included in the next block
+	require.NoError(t, ctx.PruneExpiredTransactionHashes())
+	exists, er := ctx.Store().GetTransactionHashExists(txHash, 1)
+	require.NoError(t, er)
+	require.True(t, exists)
+
+	test_artifacts.CleanupTest(ctx)
+}
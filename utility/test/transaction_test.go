@@ -37,6 +37,36 @@ func TestUtilityContext_AnteHandleMessage(t *testing.T) {
 	test_artifacts.CleanupTest(ctx)
 }
 
+func TestUtilityContext_AnteHandleMessageWithLockedAmount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+
+	tx, startingBalance, _, signer := newTestingTransaction(t, ctx)
+	msg, err := tx.Message()
+	require.NoError(t, err)
+	collectedFee, burntFee, err := ctx.GetTransactionFee(tx, msg)
+	require.NoError(t, err)
+	fee := big.NewInt(0).Add(collectedFee, burntFee)
+
+	// the balance covers the fee, but only if the locked tokens are spent
+	locked := big.NewInt(0).Sub(startingBalance, fee)
+	locked.Add(locked, big.NewInt(1))
+	require.NoError(t, ctx.Context.SetAccountVesting(signer.Address(), &coreTypes.VestingSchedule{
+		VestingType:    coreTypes.VestingType_VESTING_TYPE_DELAYED,
+		OriginalAmount: utilTypes.BigIntToString(locked),
+		StartHeight:    0,
+		EndHeight:      100,
+	}))
+
+	_, _, err = ctx.AnteHandleMessage(tx)
+	require.Equal(t, utilTypes.CodeInsufficientUnlockedAmountError, err.Code())
+
+	amount, err := ctx.GetAccountAmount(signer.Address())
+	require.NoError(t, err)
+	require.Equal(t, startingBalance, amount, "no fee is deducted")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
 func TestUtilityContext_ApplyTransaction(t *testing.T) {
 	ctx := NewTestingUtilityContext(t, 0)
 
@@ -54,6 +84,17 @@ func TestUtilityContext_ApplyTransaction(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedAfterBalance, amount, "unexpected after balance")
 
+	events := txResult.GetEvents()
+	require.Len(t, events, 2)
+	require.Equal(t, coreTypes.EventType_EVENT_TYPE_FEE, events[0].EventType)
+	feeAmount, ok := events[0].GetAttribute(coreTypes.EventAttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, typesUtil.BigIntToString(feeBig), feeAmount)
+	require.Equal(t, coreTypes.EventType_EVENT_TYPE_TRANSFER, events[1].EventType)
+	sender, ok := events[1].GetAttribute(coreTypes.EventAttributeKeySender)
+	require.True(t, ok)
+	require.Equal(t, signer.Address().String(), sender)
+
 	test_artifacts.CleanupTest(ctx)
 }
 
@@ -72,6 +113,16 @@ func TestUtilityContext_CheckTransaction(t *testing.T) {
 	require.True(t, ctx.Mempool.Contains(hash)) // IMPROVE: Access the mempool from the `testUtilityMod` directly
 	require.Equal(t, testUtilityMod.CheckTransaction(txBz).Error(), typesUtil.ErrDuplicateTransaction().Error())
 
+	// transactions valid for longer than the replay protection window are not admitted
+	maxValidityBlocks, err := ctx.GetTransactionMaxValidityBlocks()
+	require.NoError(t, err)
+	tx, _, _, signer := newTestingTransaction(t, ctx)
+	tx.MaxHeight = maxValidityBlocks + 1
+	require.NoError(t, tx.Sign(signer))
+	txBz, err = tx.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, typesUtil.ErrMaxHeightBeyondValidityWindow(tx.MaxHeight, maxValidityBlocks).Error(), testUtilityMod.CheckTransaction(txBz).Error())
+
 	test_artifacts.CleanupTest(ctx)
 }
 
@@ -163,8 +214,9 @@ func newTestingTransaction(t *testing.T, ctx utility.UtilityContext) (transactio
 	require.NoError(t, err)
 
 	transaction = &typesUtil.Transaction{
-		Msg:   any,
-		Nonce: testNonce,
+		Msg:       any,
+		Nonce:     testNonce,
+		MaxHeight: ctx.Height + 1,
 	}
 	require.NoError(t, transaction.Sign(signer))
 
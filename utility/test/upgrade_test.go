@@ -0,0 +1,100 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_HandleMessageUpgradePlan(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	upgradeName := "test_upgrade"
+
+	msg := &typesUtil.MessageUpgradePlan{Name: upgradeName, Height: 10}
+	require.NoError(t, ctx.HandleMessageUpgradePlan(msg), "handle message upgrade plan")
+
+	activationHeight, enabled, err := ctx.Store().GetIntFlag(typesUtil.UpgradeFlagName(upgradeName), 0)
+	require.NoError(t, err)
+	require.True(t, enabled)
+	require.Equal(t, 10, activationHeight)
+
+	// the upgrade is scheduled but not active yet
+	active, err := ctx.IsFeatureActive(upgradeName)
+	require.NoError(t, err)
+	require.False(t, active)
+	require.NoError(t, ctx.CheckScheduledUpgrades(), "the upgrade does not activate at the current height")
+
+	// upgrades cannot be scheduled at or before the current height
+	msg = &typesUtil.MessageUpgradePlan{Name: upgradeName, Height: 0}
+	er := ctx.HandleMessageUpgradePlan(msg)
+	require.Equal(t, typesUtil.CodeInvalidUpgradeHeightError, er.Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageUpgradePlan_Cancel(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	upgradeName := "test_upgrade"
+
+	cancelMsg := &typesUtil.MessageUpgradePlan{Name: upgradeName, Cancel: true}
+	er := ctx.HandleMessageUpgradePlan(cancelMsg)
+	require.Equal(t, typesUtil.CodeUpgradeNotScheduledError, er.Code(), "cannot cancel an upgrade that was never scheduled")
+
+	require.NoError(t, ctx.HandleMessageUpgradePlan(&typesUtil.MessageUpgradePlan{Name: upgradeName, Height: 10}))
+	require.NoError(t, ctx.HandleMessageUpgradePlan(cancelMsg), "cancel upgrade")
+
+	activationHeight, enabled, err := ctx.Store().GetIntFlag(typesUtil.UpgradeFlagName(upgradeName), 0)
+	require.NoError(t, err)
+	require.False(t, enabled)
+	require.Equal(t, 10, activationHeight, "the activation height is kept when cancelling")
+
+	er = ctx.HandleMessageUpgradePlan(cancelMsg)
+	require.Equal(t, typesUtil.CodeUpgradeNotScheduledError, er.Code(), "cannot cancel an upgrade twice")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_CheckScheduledUpgrades(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	upgradeName := "test_upgrade"
+
+	// activate the upgrade at the current height
+	require.NoError(t, ctx.Store().SetFlag(typesUtil.UpgradeFlagName(upgradeName), int64(0), true))
+
+	active, err := ctx.IsFeatureActive(upgradeName)
+	require.NoError(t, err)
+	require.True(t, active)
+
+	// an active upgrade cannot be rescheduled
+	er := ctx.HandleMessageUpgradePlan(&typesUtil.MessageUpgradePlan{Name: upgradeName, Height: 10})
+	require.Equal(t, typesUtil.CodeUpgradeAlreadyActiveError, er.Code())
+
+	// the binary does not implement the upgrade
+	err = ctx.CheckScheduledUpgrades()
+	require.Error(t, err)
+	require.Equal(t, typesUtil.CodeUpgradeRequiredError, err.(typesUtil.Error).Code())
+	require.Equal(t, typesUtil.CodeUpgradeRequiredError, ctx.BeginBlock(nil).Code(), "blocks activating unknown upgrades must not be applied")
+
+	// the binary implements the upgrade
+	typesUtil.KnownUpgrades[upgradeName] = struct{}{}
+	defer delete(typesUtil.KnownUpgrades, upgradeName)
+	require.NoError(t, ctx.CheckScheduledUpgrades())
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_CheckScheduledUpgrades_PastActivationHeight(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 1)
+	upgradeName := "test_upgrade"
+
+	// the upgrade activated before the height of the context, e.g. while the node was down
+	require.NoError(t, ctx.Store().SetFlag(typesUtil.UpgradeFlagName(upgradeName), int64(0), true))
+
+	err := ctx.CheckScheduledUpgrades()
+	require.Error(t, err)
+	require.Equal(t, typesUtil.CodeUpgradeRequiredError, err.(typesUtil.Error).Code())
+
+	test_artifacts.CleanupTest(ctx)
+}
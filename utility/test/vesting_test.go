@@ -0,0 +1,103 @@
+package test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/utility"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityContext_GetAccountUnlockedAmount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 5)
+	address := newTestVestingAccount(t, ctx, &coreTypes.VestingSchedule{
+		VestingType:    coreTypes.VestingType_VESTING_TYPE_CONTINUOUS,
+		OriginalAmount: "1000",
+		StartHeight:    0,
+		EndHeight:      10,
+	}, big.NewInt(1500))
+
+	locked, err := ctx.GetAccountLockedAmount(address)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), locked, "half of the schedule has vested")
+
+	unlocked, err := ctx.GetAccountUnlockedAmount(address)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), unlocked)
+
+	// the unlocked amount never goes below zero, even if the locked tokens were burnt
+	require.NoError(t, ctx.SetAccountAmount(address, big.NewInt(100)))
+	unlocked, err = ctx.GetAccountUnlockedAmount(address)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), unlocked)
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageSendWithLockedAmount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	address := newTestVestingAccount(t, ctx, &coreTypes.VestingSchedule{
+		VestingType:    coreTypes.VestingType_VESTING_TYPE_DELAYED,
+		OriginalAmount: "1000",
+		StartHeight:    0,
+		EndHeight:      100,
+	}, big.NewInt(2000))
+	recipient, er := crypto.GenerateAddress()
+	require.NoError(t, er)
+
+	msg := NewTestingSendMessage(t, address, recipient, "1500")
+	err := ctx.HandleMessageSend(&msg)
+	require.Equal(t, typesUtil.CodeInsufficientUnlockedAmountError, err.Code(), "only 1000 tokens are unlocked")
+
+	msg = NewTestingSendMessage(t, address, recipient, "1000")
+	require.NoError(t, ctx.HandleMessageSend(&msg))
+
+	amount, err := ctx.GetAccountAmount(address)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), amount, "the locked tokens remain in the account")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func TestUtilityContext_HandleMessageStakeWithLockedAmount(t *testing.T) {
+	ctx := NewTestingUtilityContext(t, 0)
+	lockedAmount := new(big.Int).Sub(test_artifacts.DefaultAccountAmount, big.NewInt(1))
+	address := newTestVestingAccount(t, ctx, &coreTypes.VestingSchedule{
+		VestingType:    coreTypes.VestingType_VESTING_TYPE_PERIODIC,
+		OriginalAmount: typesUtil.BigIntToString(lockedAmount),
+		StartHeight:    0,
+		EndHeight:      100,
+		Periods: []*coreTypes.VestingPeriod{
+			{Length: 100, Amount: typesUtil.BigIntToString(lockedAmount)},
+		},
+	}, test_artifacts.DefaultAccountAmount)
+	pubKey, er := crypto.GeneratePublicKey()
+	require.NoError(t, er)
+
+	msg := &typesUtil.MessageStake{
+		PublicKey:     pubKey.Bytes(),
+		Chains:        test_artifacts.DefaultChains,
+		Amount:        test_artifacts.DefaultStakeAmountString,
+		ServiceUrl:    "https://localhost.com",
+		OutputAddress: address,
+		Signer:        address,
+		ActorType:     coreTypes.ActorType_ACTOR_TYPE_VAL,
+	}
+	err := ctx.HandleStakeMessage(msg)
+	require.Equal(t, typesUtil.CodeInsufficientUnlockedAmountError, err.Code(), "the stake cannot use locked tokens")
+
+	test_artifacts.CleanupTest(ctx)
+}
+
+func newTestVestingAccount(t *testing.T, ctx utility.UtilityContext, schedule *coreTypes.VestingSchedule, amount *big.Int) crypto.Address {
+	address, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	require.NoError(t, schedule.ValidateBasic())
+	require.NoError(t, ctx.SetAccountAmount(address, amount))
+	require.NoError(t, ctx.Context.SetAccountVesting(address, schedule))
+	return address
+}
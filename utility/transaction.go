@@ -3,6 +3,8 @@ package utility
 import (
 	"bytes"
 	"encoding/hex"
+	"math/big"
+	"strconv"
 
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
@@ -18,36 +20,133 @@ func (u *utilityModule) CheckTransaction(txProtoBytes []byte) error {
 		return typesUtil.ErrDuplicateTransaction()
 	}
 
-	// Is the tx already indexed (on disk)?
-	persistenceModule := u.GetBus().GetPersistenceModule()
-	if txExists, err := persistenceModule.TransactionExists(txHash); err != nil {
+	transaction, err := decodeTransaction(txProtoBytes)
+	if err != nil {
+		return err
+	}
+
+	// Can the tx be included in the next block and was it not committed already?
+	if err := u.checkTransactionReplay(txHash, transaction); err != nil {
 		return err
-	} else if txExists {
-		// TODO: non-ordered nonce requires non-pruned tx indexer
-		return typesUtil.ErrTransactionAlreadyCommitted()
 	}
 
+	// Store the tx in the mempool
+	return u.Mempool.AddTransaction(txProtoBytes)
+}
+
+// decodeTransaction decodes the tx and checks that it is valid by itself, regardless of the state of the chain
+func decodeTransaction(txProtoBytes []byte) (*typesUtil.Transaction, typesUtil.Error) {
 	// Can the tx bytes be decoded as a protobuf?
 	transaction := &typesUtil.Transaction{}
 	if err := codec.GetCodec().Unmarshal(txProtoBytes, transaction); err != nil {
-		return typesUtil.ErrProtoUnmarshal(err)
+		return nil, typesUtil.ErrProtoUnmarshal(err)
 	}
 
 	// Does the tx pass basic validation?
 	if err := transaction.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+func (u *utilityModule) checkTransactionReplay(txHash string, transaction *typesUtil.Transaction) error {
+	height := int64(u.GetBus().GetConsensusModule().CurrentHeight())
+	readCtx, err := u.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
 		return err
 	}
+	defer readCtx.Close()
 
-	// Store the tx in the mempool
-	return u.Mempool.AddTransaction(txProtoBytes)
+	maxValidityBlocks, err := readCtx.GetIntParam(typesUtil.TransactionMaxValidityBlocksParamName, height)
+	if err != nil {
+		return typesUtil.ErrGetParam(typesUtil.TransactionMaxValidityBlocksParamName, err)
+	}
+	if err := checkTransactionReplay(readCtx, txHash, transaction, height, int64(maxValidityBlocks)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsMempoolEmpty lets the block proposer wait for transactions before proposing an empty block
+func (u *utilityModule) IsMempoolEmpty() bool {
+	return u.Mempool.IsEmpty()
+}
+
+// ReapMempool pops the transactions of a block proposal without applying them; the transactions that expired or
+// were committed since they entered the mempool are dropped, like in `CreateAndApplyProposalBlock`
+func (u *utilityModule) ReapMempool(maxTransactionBytes int, excludedTxs map[string]struct{}) ([][]byte, error) {
+	transactions := make([][]byte, 0)
+	totalTxsSizeInBytes := 0
+	for !u.Mempool.IsEmpty() {
+		txBytes, err := u.Mempool.PopTransaction()
+		if err != nil {
+			return nil, err
+		}
+		// the transaction is already proposed in a block that is not committed yet
+		txHash := typesUtil.TransactionHash(txBytes)
+		if _, ok := excludedTxs[txHash]; ok {
+			continue
+		}
+		transaction, err := typesUtil.TransactionFromBytes(txBytes)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.checkTransactionReplay(txHash, transaction); err != nil {
+			continue
+		}
+		if totalTxsSizeInBytes+len(txBytes) >= maxTransactionBytes {
+			// Add back popped transaction to be proposed in a future block
+			if err := u.Mempool.AddTransaction(txBytes); err != nil {
+				return nil, err
+			}
+			break // we've reached our max
+		}
+		totalTxsSizeInBytes += len(txBytes)
+		transactions = append(transactions, txBytes)
+	}
+	return transactions, nil
+}
+
+// ValidateProposalTransactions checks the transactions a block proposal can be voted on with, before they are applied
+func (u *utilityModule) ValidateProposalTransactions(transactions [][]byte, excludedTxs map[string]struct{}) error {
+	txHashes := make(map[string]struct{}, len(transactions))
+	for _, txBytes := range transactions {
+		txHash := typesUtil.TransactionHash(txBytes)
+		if _, ok := excludedTxs[txHash]; ok {
+			return typesUtil.ErrDuplicateTransaction()
+		}
+		if _, ok := txHashes[txHash]; ok {
+			return typesUtil.ErrDuplicateTransaction()
+		}
+		txHashes[txHash] = struct{}{}
+		transaction, err := decodeTransaction(txBytes)
+		if err != nil {
+			return err
+		}
+		if err := u.checkTransactionReplay(txHash, transaction); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (u *UtilityContext) ApplyTransaction(index int, tx *typesUtil.Transaction) (modules.TxResult, typesUtil.Error) {
+	// set aside the events emitted by the block lifecycle hooks so they are not attributed to the transaction
+	blockEvents := u.takeEvents()
+	defer func() { u.events = blockEvents }()
+
 	msg, signer, err := u.AnteHandleMessage(tx)
 	if err != nil {
 		return nil, err
 	}
-	return tx.ToTxResult(u.Height, index, signer, msg.GetMessageRecipient(), msg.GetMessageName(), u.HandleMessage(msg))
+	anteEvents := u.takeEvents()
+	txErr := u.HandleMessage(msg)
+	txEvents := u.takeEvents()
+	// the fee is charged regardless, but the events of a failed message are discarded
+	if txErr != nil {
+		txEvents = nil
+	}
+	return tx.ToTxResult(u.Height, index, signer, msg.GetMessageRecipient(), msg.GetMessageName(), append(anteEvents, txEvents...), txErr)
 }
 
 // CLEANUP: Exposed for testing purposes only
@@ -56,10 +155,11 @@ func (u *UtilityContext) AnteHandleMessage(tx *typesUtil.Transaction) (msg types
 	if err != nil {
 		return nil, "", err
 	}
-	fee, err := u.GetFee(msg, msg.GetActorType())
+	collectedFee, burntFee, err := u.GetTransactionFee(tx, msg)
 	if err != nil {
 		return nil, "", err
 	}
+	fee := new(big.Int).Add(collectedFee, burntFee)
 	pubKey, er := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
 	if er != nil {
 		return nil, "", typesUtil.ErrNewPublicKeyFromBytes(er)
@@ -73,6 +173,10 @@ func (u *UtilityContext) AnteHandleMessage(tx *typesUtil.Transaction) (msg types
 	if accountAmount.Sign() == -1 {
 		return nil, "", typesUtil.ErrInsufficientAmount(address.String())
 	}
+	// the fee cannot be paid with the tokens locked by the vesting schedule of the account
+	if err := u.checkUnlockedAmount(address, fee); err != nil {
+		return nil, "", err
+	}
 	signerCandidates, err := u.GetSignerCandidates(msg)
 	if err != nil {
 		return nil, "", err
@@ -91,9 +195,15 @@ func (u *UtilityContext) AnteHandleMessage(tx *typesUtil.Transaction) (msg types
 	if err := u.SetAccountAmount(address, accountAmount); err != nil {
 		return nil, signer, err
 	}
-	if err := u.AddPoolAmount(coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName(), fee); err != nil {
+	// the burnt part of the fee (i.e. the base fee of the dynamic fee market) is not added to any pool
+	if err := u.AddPoolAmount(coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName(), collectedFee); err != nil {
 		return nil, "", err
 	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_FEE, append([]*coreTypes.EventAttribute{
+		addressAttribute(coreTypes.EventAttributeKeyAddress, address),
+		amountAttribute(fee),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyPool, coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName()),
+	}, feeEventAttributes(collectedFee, burntFee)...)...)
 	msg.SetSigner(address)
 	return msg, signer, nil
 }
@@ -114,6 +224,24 @@ func (u *UtilityContext) HandleMessage(msg typesUtil.Message) (err typesUtil.Err
 		return u.HandleUnpauseMessage(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.HandleMessageChangeParameter(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.HandleMessageSubmitProposal(x)
+	case *typesUtil.MessageVote:
+		return u.HandleMessageVote(x)
+	case *typesUtil.MessageUpgradePlan:
+		return u.HandleMessageUpgradePlan(x)
+	case *typesUtil.MessageDelegate:
+		return u.HandleMessageDelegate(x)
+	case *typesUtil.MessageUndelegate:
+		return u.HandleMessageUndelegate(x)
+	case *typesUtil.MessageSetCommission:
+		return u.HandleMessageSetCommission(x)
+	case *typesUtil.MessageRotateKey:
+		return u.HandleMessageRotateKey(x)
+	case *typesUtil.MessageChangeOutputAddress:
+		return u.HandleMessageChangeOutputAddress(x)
+	case *typesUtil.MessageRegisterBLSKey:
+		return u.HandleMessageRegisterBLSKey(x)
 	default:
 		return typesUtil.ErrUnknownMessage(x)
 	}
@@ -137,6 +265,10 @@ func (u *UtilityContext) HandleMessageSend(message *typesUtil.MessageSend) types
 	if fromAccountAmount.Sign() == -1 {
 		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.FromAddress))
 	}
+	// locked vesting tokens cannot be sent
+	if err = u.checkUnlockedAmount(message.FromAddress, amount); err != nil {
+		return err
+	}
 	// add the amount to the recipient's account
 	if err = u.AddAccountAmount(message.ToAddress, amount); err != nil {
 		return err
@@ -145,6 +277,10 @@ func (u *UtilityContext) HandleMessageSend(message *typesUtil.MessageSend) types
 	if err = u.SetAccountAmount(message.FromAddress, fromAccountAmount); err != nil {
 		return err
 	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_TRANSFER,
+		addressAttribute(coreTypes.EventAttributeKeySender, message.FromAddress),
+		addressAttribute(coreTypes.EventAttributeKeyRecipient, message.ToAddress),
+		amountAttribute(amount))
 	return nil
 }
 
@@ -168,6 +304,10 @@ func (u *UtilityContext) HandleStakeMessage(message *typesUtil.MessageStake) typ
 	if signerAccountAmount.Sign() == -1 {
 		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.Signer))
 	}
+	// locked vesting tokens cannot be staked
+	if err = u.checkUnlockedAmount(message.Signer, amount); err != nil {
+		return err
+	}
 	// validators don't have chains field
 	if err = u.CheckBelowMaxChains(message.ActorType, message.Chains); err != nil {
 		return err
@@ -207,6 +347,11 @@ func (u *UtilityContext) HandleStakeMessage(message *typesUtil.MessageStake) typ
 	if er != nil {
 		return typesUtil.ErrInsert(er)
 	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_STAKE,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, publicKey.Address()),
+		actorTypeAttribute(message.ActorType),
+		amountAttribute(amount),
+		addressAttribute(coreTypes.EventAttributeKeyOutputAddress, message.OutputAddress))
 	return nil
 }
 
@@ -240,6 +385,10 @@ func (u *UtilityContext) HandleEditStakeMessage(message *typesUtil.MessageEditSt
 	if signerAccountAmount.Sign() == -1 {
 		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.Signer))
 	}
+	// locked vesting tokens cannot be staked
+	if err = u.checkUnlockedAmount(message.Signer, amount); err != nil {
+		return err
+	}
 	if err = u.CheckBelowMaxChains(message.ActorType, message.Chains); err != nil {
 		return err
 	}
@@ -270,6 +419,10 @@ func (u *UtilityContext) HandleEditStakeMessage(message *typesUtil.MessageEditSt
 	if er != nil {
 		return typesUtil.ErrInsert(er)
 	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_EDIT_STAKE,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address),
+		actorTypeAttribute(message.ActorType),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyAmount, message.Amount))
 	return nil
 }
 
@@ -287,6 +440,10 @@ func (u *UtilityContext) HandleUnstakeMessage(message *typesUtil.MessageUnstake)
 	if err = u.SetActorUnstaking(message.ActorType, unstakingHeight, message.Address); err != nil {
 		return err
 	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_UNSTAKE_BEGIN,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address),
+		actorTypeAttribute(message.ActorType),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyUnstakingHeight, strconv.FormatInt(unstakingHeight, 10)))
 	return nil
 }
 
@@ -312,6 +469,9 @@ func (u *UtilityContext) HandleUnpauseMessage(message *typesUtil.MessageUnpause)
 	if err = u.SetActorPauseHeight(message.ActorType, message.Address, typesUtil.HeightNotUsed); err != nil {
 		return err
 	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_UNPAUSE,
+		addressAttribute(coreTypes.EventAttributeKeyAddress, message.Address),
+		actorTypeAttribute(message.ActorType))
 	return nil
 }
 
@@ -350,7 +510,12 @@ func (u *UtilityContext) HandleMessageChangeParameter(message *typesUtil.Message
 	if err != nil {
 		return typesUtil.ErrProtoFromAny(err)
 	}
-	return u.UpdateParam(message.ParameterKey, v)
+	if err := u.UpdateParam(message.ParameterKey, v); err != nil {
+		return err
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_PARAM_CHANGE,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyParam, message.ParameterKey))
+	return nil
 }
 
 func (u *UtilityContext) GetSignerCandidates(msg typesUtil.Message) ([][]byte, typesUtil.Error) {
@@ -367,6 +532,24 @@ func (u *UtilityContext) GetSignerCandidates(msg typesUtil.Message) ([][]byte, t
 		return u.GetMessageUnpauseSignerCandidates(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.GetMessageChangeParameterSignerCandidates(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.GetMessageSubmitProposalSignerCandidates(x)
+	case *typesUtil.MessageVote:
+		return u.GetMessageVoteSignerCandidates(x)
+	case *typesUtil.MessageUpgradePlan:
+		return u.GetMessageUpgradePlanSignerCandidates(x)
+	case *typesUtil.MessageDelegate:
+		return u.GetMessageDelegateSignerCandidates(x)
+	case *typesUtil.MessageUndelegate:
+		return u.GetMessageUndelegateSignerCandidates(x)
+	case *typesUtil.MessageSetCommission:
+		return u.GetMessageSetCommissionSignerCandidates(x)
+	case *typesUtil.MessageRotateKey:
+		return u.GetMessageRotateKeySignerCandidates(x)
+	case *typesUtil.MessageChangeOutputAddress:
+		return u.GetMessageChangeOutputAddressSignerCandidates(x)
+	case *typesUtil.MessageRegisterBLSKey:
+		return u.GetMessageRegisterBLSKeySignerCandidates(x)
 	default:
 		return nil, typesUtil.ErrUnknownMessage(x)
 	}
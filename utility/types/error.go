@@ -94,201 +94,291 @@ const (
 	CodeGetExistsError                   Code = 61
 	CodeGetLatestHeightError             Code = 62
 
-	CodeGetPauseHeightError               Code = 64
-	CodeAlreadyPausedError                Code = 65
-	CodeSetPauseHeightError               Code = 66
-	CodeNotPausedError                    Code = 67
-	CodeNotReadyToUnpauseError            Code = 68
-	CodeSetStatusPausedBeforeError        Code = 69
-	CodeInvalidServiceUrlError            Code = 70
-	CodeNotExistsError                    Code = 71
-	CodeGetMissedBlocksError              Code = 72
-	CodeEmptyHashError                    Code = 73
-	CodeInvalidBlockHeightError           Code = 74
-	CodeUnequalPublicKeysError            Code = 75
-	CodeUnequalVoteTypesError             Code = 76
-	CodeEqualVotesError                   Code = 77
-	CodeUnequalRoundsError                Code = 78
-	CodeMaxEvidenceAgeError               Code = 79
-	CodeGetStakedTokensError              Code = 80
-	CodeSetValidatorStakedTokensError     Code = 81
-	CodeSetPoolAmountError                Code = 82
-	CodeGetPoolAmountError                Code = 83
-	CodeInvalidProposerCutPercentageError Code = 84
-	CodeUnknownParamError                 Code = 85
-	CodeUnauthorizedParamChangeError      Code = 86
-	CodeInvalidParamValueError            Code = 87
-	CodeUpdateParamError                  Code = 88
-	CodeGetServiceNodesPerSessionAtError  Code = 89
-	CodeGetBlockHashError                 Code = 90
-	CodeGetServiceNodeCountError          Code = 91
-	CodeEmptyParamKeyError                Code = 92
-	CodeEmptyParamValueError              Code = 93
-	CodeGetOutputAddressError             Code = 94
-	CodeTransactionAlreadyCommittedError  Code = 95
-	CodeInitGenesisParamsError            Code = 96
-	CodeGetAllFishermenError              Code = 97
-	CodeGetAllServiceNodesError           Code = 98
-	CodeGetAllAppsError                   Code = 99
-	CodeNewPersistenceContextError        Code = 100
-	CodeGetAppHashError                   Code = 101
-	CodeNewSavePointError                 Code = 102
-	CodeRollbackSavePointError            Code = 103
-	CodeResetContextError                 Code = 104
-	CodeCommitContextError                Code = 105
-	CodeReleaseContextError               Code = 106
-	CodeGetAllPoolsError                  Code = 107
-	CodeGetAllAccountsError               Code = 108
-	CodeGetAllParamsError                 Code = 109
-	CodeSetPoolError                      Code = 110
-	CodeDuplicateSavePointError           Code = 111
-	CodeSavePointNotFoundError            Code = 112
-	CodeEmptySavePointsError              Code = 113
-	CodeInvalidEvidenceTypeError          Code = 114
-	CodeExportStateError                  Code = 115
-	CodeUnequalHeightsError               Code = 116
-	CodeSetMissedBlocksError              Code = 117
-	CodeNegativeAmountError               Code = 118
-	CodeNilQuorumCertificateError         Code = 119
-	CodeMissingRequiredArgError           Code = 120
-	CodeSocketRequestTimedOutError        Code = 121
-	CodeUndefinedSocketTypeError          Code = 122
-	CodePeerHangUpError                   Code = 123
-	CodeUnexpectedSocketError             Code = 124
-	CodePayloadTooBigError                Code = 125
-	CodeSocketIOStartFailedError          Code = 126
-	CodeGetStakeAmountError               Code = 127
-	CodeStakeLessError                    Code = 128
-	CodeGetHeightError                    Code = 129
-	CodeUnknownActorType                  Code = 130
-	CodeUnknownMessageType                Code = 131
-
-	GetStakedTokensError              = "an error occurred getting the validator staked tokens"
-	SetValidatorStakedTokensError     = "an error occurred setting the validator staked tokens"
-	EqualVotesError                   = "the votes are identical and not equivocating"
-	UnequalRoundsError                = "the round numbers are not equal"
-	UnequalVoteTypesError             = "the vote types are not equal"
-	UnequalPublicKeysError            = "the two public keys are not equal"
-	GetMissedBlocksError              = "an error occurred getting the missed blocks field"
-	DecodeMessageError                = "unable to decode the message"
-	NotExistsError                    = "the actor does not exist in the state"
-	InvalidServiceUrlError            = "the service url is not valid"
-	NotReadyToUnpauseError            = "the actor isn't ready to unpause as the minimum number of blocks hasn't passed since pausing"
-	NotPausedError                    = "the actor is not paused"
-	SetPauseHeightError               = "an error occurred setting the pause height"
-	AlreadyPausedError                = "the actor is already paused"
-	GetPauseHeightError               = "an error occurred getting the pause height"
-	UnmarshalTransactionError         = "an error occurred decoding the transaction"
-	AlreadyExistsError                = "the actor already exists in the state"
-	GetExistsError                    = "an error occurred when checking if already exists"
-	GetStakeAmountError               = "an error occurred getting the stake amount"
-	StakeLessError                    = "the stake amount cannot be less than current amount"
-	GetReadyToUnstakeError            = "an error occurred getting the 'ready to unstake' group"
-	GetLatestHeightError              = "an error occurred getting the latest height"
-	SetUnstakingHeightAndStatus       = "an error occurred setting the unstaking height and status"
-	GetStatusError                    = "an error occurred getting the staking status"
-	InvalidStatusError                = "the staking status is not valid"
-	InsertError                       = "an error occurred inserting into persistence"
-	MaxChainsError                    = "the amount chains exceeds the maximum value"
-	InvalidPublicKeyLenError          = "the public key length is not valid"
-	EmptyAmountError                  = "the amount field is empty"
-	NilOutputAddressError             = "the output address is nil"
-	InvalidRelayChainLengthError      = "the relay chain id length is invalid"
-	EmptyRelayChainError              = "the relay chain id is empty"
-	EmptyRelayChainsError             = "the relay chains are nil or empty"
-	MinimumStakeError                 = "an error occurred because the amount specified is less than the minimum stake"
-	GetParamError                     = "an error occurred getting the parameter"
-	SetAccountError                   = "an error occurred setting the account"
-	AddAccountAmountError             = "an error occurred adding the amount to the account balance"
-	AddPoolAmountError                = "an error occurred adding to the pool"
-	SubPoolAmountError                = "an error occurred subtracting from the pool"
-	SetPoolAmountError                = "an error occurred setting the pool amount"
-	GetPoolAmountError                = "an error occurred getting the pool amount"
-	InvalidSignerError                = "the signer of the message is not a proper candidate"
-	GetAccountAmountError             = "an error occurred getting the account amount"
-	UnknownMessageError               = "the message type is unrecognized"
-	AppHashError                      = "an error occurred generating the apphash"
-	InvalidNonceError                 = "the nonce field is invalid; cannot be converted to big.Int"
-	NewPublicKeyFromBytesError        = "unable to convert the raw bytes to a valid public key"
-	SignatureVerificationFailedError  = "the public key / signature combination is not valid for the msg"
-	ProtoFromAnyError                 = "an error occurred getting the structure from the protobuf any"
-	NewFeeFromStringError             = "the fee string is unable to be converted to a valid base 10 number"
-	EmptyNonceError                   = "the nonce in the transaction is empty"
-	EmptyPublicKeyError               = "the public key field is empty"
-	EmptySignatureError               = "the signature field is empty"
-	TransactionSignError              = "an error occurred signing the transaction"
-	InterfaceConversionError          = "an error occurred converting the interface to an expected type: "
-	SetStatusPausedBeforeError        = "an error occurred setting the actor status that were paused before"
-	EmptyHashError                    = "the hash is empty"
-	InvalidBlockHeightError           = "the block height field is not valid"
-	MaxEvidenceAgeError               = "the evidence is too old to be processed"
-	InvalidProposerCutPercentageError = "the proposer cut percentage is larger than 100"
-	UnknownParamError                 = "the param name is not found in the acl"
-	UnauthorizedParamChangeError      = "unauthorized param change, the signer must be address: "
-	InvalidParamValueError            = "the param value is not the expected type"
-	GetBlockHashError                 = "an error occurred getting the block hash"
-	GetServiceNodesPerSessionAtError  = "an error occurred getting the service nodes per session for height"
-	GetServiceNodeCountError          = "an error occurred getting the service node count"
-	EmptyParamKeyError                = "the parameter key is empty"
-	EmptyParamValueError              = "the parameter value is empty"
-	GetOutputAddressError             = "an error occurred getting the output address using operator"
-	GetHeightError                    = "an error occurred when getting the height from the store"
-	TransactionAlreadyCommittedError  = "the transaction is already committed"
-	NewSavePointError                 = "an error occurred creating the save point"
-	RollbackSavePointError            = "an error occurred rolling back to save point"
-	NewPersistenceContextError        = "an error occurred creating the persistence context"
-	GetAppHashError                   = "an error occurred getting the appHash"
-	ResetContextError                 = "an error occurred resetting the context"
-	CommitContextError                = "an error occurred committing the context"
-	ReleaseContextError               = "an error occurred releasing the context"
-	SetPoolError                      = "an error occurred setting the pool"
-	DuplicateSavePointError           = "the save point is duplicated"
-	SavePointNotFoundError            = "the save point is not found"
-	EmptySavePointsError              = "the save points list in context is empty"
-	InvalidEvidenceTypeError          = "the evidence type is not valid"
-	ExportStateError                  = "an error occurred exporting the state"
-	UnequalHeightsError               = "the heights are not equal"
-	SetMissedBlocksError              = "an error occurred setting missed blocks"
-	MissingRequiredArgError           = "socket error: missing required argument."
-	SocketRequestTimedOutError        = "socket error: request timed out while waiting on ACK."
-	UndefinedSocketTypeError          = "socket error: undefined given socket type."
-	PeerHangUpError                   = "socket error: Peer hang up."
-	UnexpectedSocketError             = "socket error: Unexpected peer error."
-	PayloadTooBigError                = "socket error: payload size is too big. "
-	SocketIOStartFailedError          = "socket error: failed to start socket reading/writing (io)"
-	EmptyTransactionError             = "the transaction is empty"
-	StringToBigIntError               = "an error occurred converting the string primitive to big.Int, the conversion was unsuccessful with base 10"
-	GetAllValidatorsError             = "an error occurred getting all validators from the state"
-	InvalidAmountError                = "the amount field is invalid; cannot be converted to big.Int"
-	InvalidAddressLenError            = "the length of the address is not valid"
-	EmptyAddressError                 = "the address field is empty"
-	EmptyNameError                    = "the name field is empty"
-	NilPoolError                      = "the pool is nil"
-	EmptyAccountError                 = "the account is nil"
-	NewAddressFromBytesError          = "unable to convert the raw bytes to a valid address"
-	InvalidTransactionCountError      = "the total transactions are less than the block transactions"
-	EmptyTimestampError               = "the timestamp field is empty"
-	EmptyProposerError                = "the proposer field is empty"
-	EmptyNetworkIDError               = "the network id field is empty"
-	InvalidHashLengthError            = "the length of the hash is not the correct size"
-	NilQuorumCertificateError         = "the quorum certificate is nil"
-	HexDecodeFromStringError          = "an error occurred decoding the string into hex bytes"
-	ProtoMarshalError                 = "an error occurred marshalling the structure in protobuf"
-	ProtoUnmarshalError               = "an error occurred unmarshalling the structure in protobuf"
-	ProtoNewAnyError                  = "an error occurred creating the protobuf any"
-	UpdateParamError                  = "an error occurred updating the parameter"
-	InitGenesisParamError             = "an error occurred initializing the params in genesis"
-	GetAllFishermenError              = "an error occurred getting all of the fishermen¬"
-	GetAllAppsError                   = "an error occurred getting all of the apps"
-	GetAllServiceNodesError           = "an error occurred getting all of the service nodes"
-	GetAllPoolsError                  = "an error occurred getting all of the pools"
-	GetAllAccountsError               = "an error occurred getting all of the accounts"
-	GetAllParamsError                 = "an error occurred getting all of the params"
-	DuplicateTransactionError         = "the transaction is already found in the mempool"
-	InsufficientAmountError           = "the account has insufficient funds to complete the operation"
-	NegativeAmountError               = "the amount is negative"
-	UnknownActorTypeError             = "the actor type is not recognized"
-	UnknownMessageTypeError           = "the message being by the utility message is not recognized"
+	CodeGetPauseHeightError                Code = 64
+	CodeAlreadyPausedError                 Code = 65
+	CodeSetPauseHeightError                Code = 66
+	CodeNotPausedError                     Code = 67
+	CodeNotReadyToUnpauseError             Code = 68
+	CodeSetStatusPausedBeforeError         Code = 69
+	CodeInvalidServiceUrlError             Code = 70
+	CodeNotExistsError                     Code = 71
+	CodeGetMissedBlocksError               Code = 72
+	CodeEmptyHashError                     Code = 73
+	CodeInvalidBlockHeightError            Code = 74
+	CodeUnequalPublicKeysError             Code = 75
+	CodeUnequalVoteTypesError              Code = 76
+	CodeEqualVotesError                    Code = 77
+	CodeUnequalRoundsError                 Code = 78
+	CodeMaxEvidenceAgeError                Code = 79
+	CodeGetStakedTokensError               Code = 80
+	CodeSetValidatorStakedTokensError      Code = 81
+	CodeSetPoolAmountError                 Code = 82
+	CodeGetPoolAmountError                 Code = 83
+	CodeInvalidProposerCutPercentageError  Code = 84
+	CodeUnknownParamError                  Code = 85
+	CodeUnauthorizedParamChangeError       Code = 86
+	CodeInvalidParamValueError             Code = 87
+	CodeUpdateParamError                   Code = 88
+	CodeGetServiceNodesPerSessionAtError   Code = 89
+	CodeGetBlockHashError                  Code = 90
+	CodeGetServiceNodeCountError           Code = 91
+	CodeEmptyParamKeyError                 Code = 92
+	CodeEmptyParamValueError               Code = 93
+	CodeGetOutputAddressError              Code = 94
+	CodeTransactionAlreadyCommittedError   Code = 95
+	CodeInitGenesisParamsError             Code = 96
+	CodeGetAllFishermenError               Code = 97
+	CodeGetAllServiceNodesError            Code = 98
+	CodeGetAllAppsError                    Code = 99
+	CodeNewPersistenceContextError         Code = 100
+	CodeGetAppHashError                    Code = 101
+	CodeNewSavePointError                  Code = 102
+	CodeRollbackSavePointError             Code = 103
+	CodeResetContextError                  Code = 104
+	CodeCommitContextError                 Code = 105
+	CodeReleaseContextError                Code = 106
+	CodeGetAllPoolsError                   Code = 107
+	CodeGetAllAccountsError                Code = 108
+	CodeGetAllParamsError                  Code = 109
+	CodeSetPoolError                       Code = 110
+	CodeDuplicateSavePointError            Code = 111
+	CodeSavePointNotFoundError             Code = 112
+	CodeEmptySavePointsError               Code = 113
+	CodeInvalidEvidenceTypeError           Code = 114
+	CodeExportStateError                   Code = 115
+	CodeUnequalHeightsError                Code = 116
+	CodeSetMissedBlocksError               Code = 117
+	CodeNegativeAmountError                Code = 118
+	CodeNilQuorumCertificateError          Code = 119
+	CodeMissingRequiredArgError            Code = 120
+	CodeSocketRequestTimedOutError         Code = 121
+	CodeUndefinedSocketTypeError           Code = 122
+	CodePeerHangUpError                    Code = 123
+	CodeUnexpectedSocketError              Code = 124
+	CodePayloadTooBigError                 Code = 125
+	CodeSocketIOStartFailedError           Code = 126
+	CodeGetStakeAmountError                Code = 127
+	CodeStakeLessError                     Code = 128
+	CodeGetHeightError                     Code = 129
+	CodeUnknownActorType                   Code = 130
+	CodeUnknownMessageType                 Code = 131
+	CodeUnknownProposalTypeError           Code = 132
+	CodeInsufficientDepositError           Code = 133
+	CodeGetProposalError                   Code = 134
+	CodeProposalNotVotingError             Code = 135
+	CodeInvalidVoteOptionError             Code = 136
+	CodeGetProposalVotesError              Code = 137
+	CodeSetProposalStatusError             Code = 138
+	CodeUnknownPoolError                   Code = 139
+	CodeGetNextProposalIdError             Code = 140
+	CodeInvalidUpgradeNameError            Code = 141
+	CodeInvalidUpgradeHeightError          Code = 142
+	CodeUpgradeNotScheduledError           Code = 143
+	CodeUpgradeAlreadyActiveError          Code = 144
+	CodeGetFlagError                       Code = 145
+	CodeUpgradeRequiredError               Code = 146
+	CodeInvalidDelegationActorTypeError    Code = 147
+	CodeInvalidCommissionPercentageError   Code = 148
+	CodeGetDelegationError                 Code = 149
+	CodeDelegationNotFoundError            Code = 150
+	CodeDelegationNotBondedError           Code = 151
+	CodeSetDelegationError                 Code = 152
+	CodeGetCommissionError                 Code = 153
+	CodeSetCommissionError                 Code = 154
+	CodeGetAccountVestingError             Code = 155
+	CodeInvalidVestingScheduleError        Code = 156
+	CodeInsufficientUnlockedAmountError    Code = 157
+	CodeSetBlockEventsError                Code = 158
+	CodeTipExceedsMaxFeeError              Code = 159
+	CodeMaxFeeBelowBaseFeeError            Code = 160
+	CodeGetBaseFeeError                    Code = 161
+	CodeSetBaseFeeError                    Code = 162
+	CodeTransactionExpiredError            Code = 163
+	CodeMaxHeightBeyondValidityWindowError Code = 164
+	CodeGetTransactionHashExistsError      Code = 165
+	CodeInsertTransactionHashError         Code = 166
+	CodePruneTransactionHashesError        Code = 167
+	CodeInvalidEvidenceError               Code = 168
+	CodeSameOperatorKeyError               Code = 169
+	CodeRotateKeyError                     Code = 170
+	CodeSetOutputAddressError              Code = 171
+	CodeInvalidBLSKeyError                 Code = 172
+	CodeInvalidBLSProofOfPossessionError   Code = 173
+	CodeSetBLSKeyError                     Code = 174
+	CodeGetBlockTimeError                  Code = 175
+	CodeCommissionChangeTooLargeError      Code = 176
+
+	GetStakedTokensError               = "an error occurred getting the validator staked tokens"
+	SetValidatorStakedTokensError      = "an error occurred setting the validator staked tokens"
+	EqualVotesError                    = "the votes are identical and not equivocating"
+	UnequalRoundsError                 = "the round numbers are not equal"
+	UnequalVoteTypesError              = "the vote types are not equal"
+	UnequalPublicKeysError             = "the two public keys are not equal"
+	GetMissedBlocksError               = "an error occurred getting the missed blocks field"
+	DecodeMessageError                 = "unable to decode the message"
+	NotExistsError                     = "the actor does not exist in the state"
+	InvalidServiceUrlError             = "the service url is not valid"
+	NotReadyToUnpauseError             = "the actor isn't ready to unpause as the minimum number of blocks hasn't passed since pausing"
+	NotPausedError                     = "the actor is not paused"
+	SetPauseHeightError                = "an error occurred setting the pause height"
+	AlreadyPausedError                 = "the actor is already paused"
+	GetPauseHeightError                = "an error occurred getting the pause height"
+	UnmarshalTransactionError          = "an error occurred decoding the transaction"
+	AlreadyExistsError                 = "the actor already exists in the state"
+	GetExistsError                     = "an error occurred when checking if already exists"
+	GetStakeAmountError                = "an error occurred getting the stake amount"
+	StakeLessError                     = "the stake amount cannot be less than current amount"
+	GetReadyToUnstakeError             = "an error occurred getting the 'ready to unstake' group"
+	GetLatestHeightError               = "an error occurred getting the latest height"
+	SetUnstakingHeightAndStatus        = "an error occurred setting the unstaking height and status"
+	GetStatusError                     = "an error occurred getting the staking status"
+	InvalidStatusError                 = "the staking status is not valid"
+	InsertError                        = "an error occurred inserting into persistence"
+	MaxChainsError                     = "the amount chains exceeds the maximum value"
+	InvalidPublicKeyLenError           = "the public key length is not valid"
+	EmptyAmountError                   = "the amount field is empty"
+	NilOutputAddressError              = "the output address is nil"
+	InvalidRelayChainLengthError       = "the relay chain id length is invalid"
+	EmptyRelayChainError               = "the relay chain id is empty"
+	EmptyRelayChainsError              = "the relay chains are nil or empty"
+	MinimumStakeError                  = "an error occurred because the amount specified is less than the minimum stake"
+	GetParamError                      = "an error occurred getting the parameter"
+	SetAccountError                    = "an error occurred setting the account"
+	AddAccountAmountError              = "an error occurred adding the amount to the account balance"
+	AddPoolAmountError                 = "an error occurred adding to the pool"
+	SubPoolAmountError                 = "an error occurred subtracting from the pool"
+	SetPoolAmountError                 = "an error occurred setting the pool amount"
+	GetPoolAmountError                 = "an error occurred getting the pool amount"
+	InvalidSignerError                 = "the signer of the message is not a proper candidate"
+	GetAccountAmountError              = "an error occurred getting the account amount"
+	UnknownMessageError                = "the message type is unrecognized"
+	AppHashError                       = "an error occurred generating the apphash"
+	InvalidNonceError                  = "the nonce field is invalid; cannot be converted to big.Int"
+	NewPublicKeyFromBytesError         = "unable to convert the raw bytes to a valid public key"
+	SignatureVerificationFailedError   = "the public key / signature combination is not valid for the msg"
+	ProtoFromAnyError                  = "an error occurred getting the structure from the protobuf any"
+	NewFeeFromStringError              = "the fee string is unable to be converted to a valid base 10 number"
+	EmptyNonceError                    = "the nonce in the transaction is empty"
+	EmptyPublicKeyError                = "the public key field is empty"
+	EmptySignatureError                = "the signature field is empty"
+	TransactionSignError               = "an error occurred signing the transaction"
+	InterfaceConversionError           = "an error occurred converting the interface to an expected type: "
+	SetStatusPausedBeforeError         = "an error occurred setting the actor status that were paused before"
+	EmptyHashError                     = "the hash is empty"
+	InvalidBlockHeightError            = "the block height field is not valid"
+	MaxEvidenceAgeError                = "the evidence is too old to be processed"
+	InvalidProposerCutPercentageError  = "the proposer cut percentage is larger than 100"
+	UnknownParamError                  = "the param name is not found in the acl"
+	UnauthorizedParamChangeError       = "unauthorized param change, the signer must be address: "
+	InvalidParamValueError             = "the param value is not the expected type"
+	GetBlockHashError                  = "an error occurred getting the block hash"
+	GetServiceNodesPerSessionAtError   = "an error occurred getting the service nodes per session for height"
+	GetServiceNodeCountError           = "an error occurred getting the service node count"
+	EmptyParamKeyError                 = "the parameter key is empty"
+	EmptyParamValueError               = "the parameter value is empty"
+	GetOutputAddressError              = "an error occurred getting the output address using operator"
+	GetHeightError                     = "an error occurred when getting the height from the store"
+	TransactionAlreadyCommittedError   = "the transaction is already committed"
+	NewSavePointError                  = "an error occurred creating the save point"
+	RollbackSavePointError             = "an error occurred rolling back to save point"
+	NewPersistenceContextError         = "an error occurred creating the persistence context"
+	GetAppHashError                    = "an error occurred getting the appHash"
+	ResetContextError                  = "an error occurred resetting the context"
+	CommitContextError                 = "an error occurred committing the context"
+	ReleaseContextError                = "an error occurred releasing the context"
+	SetPoolError                       = "an error occurred setting the pool"
+	DuplicateSavePointError            = "the save point is duplicated"
+	SavePointNotFoundError             = "the save point is not found"
+	EmptySavePointsError               = "the save points list in context is empty"
+	InvalidEvidenceTypeError           = "the evidence type is not valid"
+	ExportStateError                   = "an error occurred exporting the state"
+	UnequalHeightsError                = "the heights are not equal"
+	SetMissedBlocksError               = "an error occurred setting missed blocks"
+	MissingRequiredArgError            = "socket error: missing required argument."
+	SocketRequestTimedOutError         = "socket error: request timed out while waiting on ACK."
+	UndefinedSocketTypeError           = "socket error: undefined given socket type."
+	PeerHangUpError                    = "socket error: Peer hang up."
+	UnexpectedSocketError              = "socket error: Unexpected peer error."
+	PayloadTooBigError                 = "socket error: payload size is too big. "
+	SocketIOStartFailedError           = "socket error: failed to start socket reading/writing (io)"
+	EmptyTransactionError              = "the transaction is empty"
+	StringToBigIntError                = "an error occurred converting the string primitive to big.Int, the conversion was unsuccessful with base 10"
+	GetAllValidatorsError              = "an error occurred getting all validators from the state"
+	InvalidAmountError                 = "the amount field is invalid; cannot be converted to big.Int"
+	InvalidAddressLenError             = "the length of the address is not valid"
+	EmptyAddressError                  = "the address field is empty"
+	EmptyNameError                     = "the name field is empty"
+	NilPoolError                       = "the pool is nil"
+	EmptyAccountError                  = "the account is nil"
+	NewAddressFromBytesError           = "unable to convert the raw bytes to a valid address"
+	InvalidTransactionCountError       = "the total transactions are less than the block transactions"
+	EmptyTimestampError                = "the timestamp field is empty"
+	EmptyProposerError                 = "the proposer field is empty"
+	EmptyNetworkIDError                = "the network id field is empty"
+	InvalidHashLengthError             = "the length of the hash is not the correct size"
+	NilQuorumCertificateError          = "the quorum certificate is nil"
+	HexDecodeFromStringError           = "an error occurred decoding the string into hex bytes"
+	ProtoMarshalError                  = "an error occurred marshalling the structure in protobuf"
+	ProtoUnmarshalError                = "an error occurred unmarshalling the structure in protobuf"
+	ProtoNewAnyError                   = "an error occurred creating the protobuf any"
+	UpdateParamError                   = "an error occurred updating the parameter"
+	InitGenesisParamError              = "an error occurred initializing the params in genesis"
+	GetAllFishermenError               = "an error occurred getting all of the fishermen¬"
+	GetAllAppsError                    = "an error occurred getting all of the apps"
+	GetAllServiceNodesError            = "an error occurred getting all of the service nodes"
+	GetAllPoolsError                   = "an error occurred getting all of the pools"
+	GetAllAccountsError                = "an error occurred getting all of the accounts"
+	GetAllParamsError                  = "an error occurred getting all of the params"
+	DuplicateTransactionError          = "the transaction is already found in the mempool"
+	InsufficientAmountError            = "the account has insufficient funds to complete the operation"
+	NegativeAmountError                = "the amount is negative"
+	UnknownActorTypeError              = "the actor type is not recognized"
+	UnknownMessageTypeError            = "the message being by the utility message is not recognized"
+	UnknownProposalTypeError           = "the proposal type is not recognized"
+	InsufficientDepositError           = "the deposit is below the minimum governance deposit"
+	GetProposalError                   = "an error occurred getting the proposal"
+	ProposalNotVotingError             = "the proposal is not in its voting period"
+	InvalidVoteOptionError             = "the vote option is not recognized"
+	GetProposalVotesError              = "an error occurred getting the votes of the proposal"
+	SetProposalStatusError             = "an error occurred setting the status of the proposal"
+	UnknownPoolError                   = "the pool is not recognized"
+	GetNextProposalIdError             = "an error occurred getting the id of the next proposal"
+	InvalidUpgradeNameError            = "the upgrade name is invalid"
+	InvalidUpgradeHeightError          = "the upgrade height must be greater than the current height"
+	UpgradeNotScheduledError           = "the upgrade is not scheduled"
+	UpgradeAlreadyActiveError          = "the upgrade has already been activated"
+	GetFlagError                       = "an error occurred getting the flag"
+	UpgradeRequiredError               = "the node does not support an upgrade active at this height and must be upgraded"
+	InvalidDelegationActorTypeError    = "the actor type cannot be delegated to"
+	InvalidCommissionPercentageError   = "the commission percentage must be between 0 and 100"
+	GetDelegationError                 = "an error occurred getting the delegation"
+	DelegationNotFoundError            = "the delegation does not exist"
+	DelegationNotBondedError           = "the delegation is not bonded"
+	SetDelegationError                 = "an error occurred setting the delegation"
+	GetCommissionError                 = "an error occurred getting the commission"
+	SetCommissionError                 = "an error occurred setting the commission"
+	GetAccountVestingError             = "an error occurred getting the vesting schedule of the account"
+	InvalidVestingScheduleError        = "the vesting schedule is invalid"
+	InsufficientUnlockedAmountError    = "the account has insufficient unlocked funds to complete the operation"
+	SetBlockEventsError                = "an error occurred storing the block events"
+	TipExceedsMaxFeeError              = "the tip exceeds the max fee of the transaction"
+	MaxFeeBelowBaseFeeError            = "the max fee of the transaction is below the base fee"
+	GetBaseFeeError                    = "an error occurred getting the base fee"
+	SetBaseFeeError                    = "an error occurred setting the base fee"
+	TransactionExpiredError            = "the max height of the transaction has passed"
+	MaxHeightBeyondValidityWindowError = "the max height of the transaction is beyond the validity window"
+	GetTransactionHashExistsError      = "an error occurred checking whether the transaction was committed"
+	InsertTransactionHashError         = "an error occurred inserting the transaction hash"
+	PruneTransactionHashesError        = "an error occurred pruning the expired transaction hashes"
+	InvalidEvidenceError               = "the double sign evidence is not valid"
+	SameOperatorKeyError               = "the new operator key is the current operator key"
+	RotateKeyError                     = "an error occurred rotating the operator key"
+	SetOutputAddressError              = "an error occurred setting the output address"
+	InvalidBLSKeyError                 = "the BLS public key is not valid"
+	InvalidBLSProofOfPossessionError   = "the proof of possession of the BLS key is not valid"
+	SetBLSKeyError                     = "an error occurred setting the BLS key"
+	GetBlockTimeError                  = "an error occurred getting the time of a committed block"
+	CommissionChangeTooLargeError      = "the commission increases by more than the max change of the commission change period"
 )
 
 func ErrUnknownParam(paramName string) Error {
@@ -795,3 +885,183 @@ func ErrUnknownActorType(actorType string) Error {
 func ErrUnknownMessageType(messageType any) Error {
 	return NewError(CodeUnknownMessageType, fmt.Sprintf("%s: %v", UnknownMessageTypeError, messageType))
 }
+
+func ErrUnknownProposalType(proposalType any) Error {
+	return NewError(CodeUnknownProposalTypeError, fmt.Sprintf("%s: %v", UnknownProposalTypeError, proposalType))
+}
+
+func ErrInsufficientDeposit(deposit, minDeposit string) Error {
+	return NewError(CodeInsufficientDepositError, fmt.Sprintf("%s: %s < %s", InsufficientDepositError, deposit, minDeposit))
+}
+
+func ErrGetProposal(proposalId uint64, err error) Error {
+	return NewError(CodeGetProposalError, fmt.Sprintf("%s: %d: %s", GetProposalError, proposalId, err.Error()))
+}
+
+func ErrProposalNotVoting(proposalId uint64) Error {
+	return NewError(CodeProposalNotVotingError, fmt.Sprintf("%s: %d", ProposalNotVotingError, proposalId))
+}
+
+func ErrInvalidVoteOption(option any) Error {
+	return NewError(CodeInvalidVoteOptionError, fmt.Sprintf("%s: %v", InvalidVoteOptionError, option))
+}
+
+func ErrGetProposalVotes(proposalId uint64, err error) Error {
+	return NewError(CodeGetProposalVotesError, fmt.Sprintf("%s: %d: %s", GetProposalVotesError, proposalId, err.Error()))
+}
+
+func ErrSetProposalStatus(proposalId uint64, err error) Error {
+	return NewError(CodeSetProposalStatusError, fmt.Sprintf("%s: %d: %s", SetProposalStatusError, proposalId, err.Error()))
+}
+
+func ErrUnknownPool(poolName string) Error {
+	return NewError(CodeUnknownPoolError, fmt.Sprintf("%s: %s", UnknownPoolError, poolName))
+}
+
+func ErrGetNextProposalId(err error) Error {
+	return NewError(CodeGetNextProposalIdError, fmt.Sprintf("%s: %s", GetNextProposalIdError, err.Error()))
+}
+
+func ErrInvalidUpgradeName(name string) Error {
+	return NewError(CodeInvalidUpgradeNameError, fmt.Sprintf("%s: %s", InvalidUpgradeNameError, name))
+}
+
+func ErrInvalidUpgradeHeight(upgradeHeight, height int64) Error {
+	return NewError(CodeInvalidUpgradeHeightError, fmt.Sprintf("%s: %d <= %d", InvalidUpgradeHeightError, upgradeHeight, height))
+}
+
+func ErrUpgradeNotScheduled(name string) Error {
+	return NewError(CodeUpgradeNotScheduledError, fmt.Sprintf("%s: %s", UpgradeNotScheduledError, name))
+}
+
+func ErrUpgradeAlreadyActive(name string) Error {
+	return NewError(CodeUpgradeAlreadyActiveError, fmt.Sprintf("%s: %s", UpgradeAlreadyActiveError, name))
+}
+
+func ErrGetFlag(flagName string, err error) Error {
+	return NewError(CodeGetFlagError, fmt.Sprintf("%s: %s: %s", GetFlagError, flagName, err.Error()))
+}
+
+func ErrUpgradeRequired(name string, height int64) Error {
+	return NewError(CodeUpgradeRequiredError, fmt.Sprintf("%s: upgrade %s at height %d", UpgradeRequiredError, name, height))
+}
+
+func ErrInvalidDelegationActorType(actorType any) Error {
+	return NewError(CodeInvalidDelegationActorTypeError, fmt.Sprintf("%s: %v", InvalidDelegationActorTypeError, actorType))
+}
+
+func ErrInvalidCommissionPercentage(percentage uint32) Error {
+	return NewError(CodeInvalidCommissionPercentageError, fmt.Sprintf("%s: %d", InvalidCommissionPercentageError, percentage))
+}
+
+func ErrGetDelegation(err error) Error {
+	return NewError(CodeGetDelegationError, fmt.Sprintf("%s: %s", GetDelegationError, err.Error()))
+}
+
+func ErrDelegationNotFound(delegator, actorAddress string) Error {
+	return NewError(CodeDelegationNotFoundError, fmt.Sprintf("%s: %s -> %s", DelegationNotFoundError, delegator, actorAddress))
+}
+
+func ErrDelegationNotBonded(delegator, actorAddress string) Error {
+	return NewError(CodeDelegationNotBondedError, fmt.Sprintf("%s: %s -> %s", DelegationNotBondedError, delegator, actorAddress))
+}
+
+func ErrSetDelegation(err error) Error {
+	return NewError(CodeSetDelegationError, fmt.Sprintf("%s: %s", SetDelegationError, err.Error()))
+}
+
+func ErrGetCommission(err error) Error {
+	return NewError(CodeGetCommissionError, fmt.Sprintf("%s: %s", GetCommissionError, err.Error()))
+}
+
+func ErrSetCommission(err error) Error {
+	return NewError(CodeSetCommissionError, fmt.Sprintf("%s: %s", SetCommissionError, err.Error()))
+}
+
+func ErrGetAccountVesting(err error) Error {
+	return NewError(CodeGetAccountVestingError, fmt.Sprintf("%s: %s", GetAccountVestingError, err.Error()))
+}
+
+func ErrInvalidVestingSchedule(err error) Error {
+	return NewError(CodeInvalidVestingScheduleError, fmt.Sprintf("%s: %s", InvalidVestingScheduleError, err.Error()))
+}
+
+func ErrInsufficientUnlockedAmount(address, unlocked, amount string) Error {
+	return NewError(CodeInsufficientUnlockedAmountError, fmt.Sprintf("%s: with address %s, %s unlocked < %s", InsufficientUnlockedAmountError, address, unlocked, amount))
+}
+
+func ErrSetBlockEvents(err error) Error {
+	return NewError(CodeSetBlockEventsError, fmt.Sprintf("%s: %s", SetBlockEventsError, err.Error()))
+}
+
+func ErrTipExceedsMaxFee(tip, maxFee string) Error {
+	return NewError(CodeTipExceedsMaxFeeError, fmt.Sprintf("%s: %s > %s", TipExceedsMaxFeeError, tip, maxFee))
+}
+
+func ErrMaxFeeBelowBaseFee(maxFee, baseFee string) Error {
+	return NewError(CodeMaxFeeBelowBaseFeeError, fmt.Sprintf("%s: %s < %s", MaxFeeBelowBaseFeeError, maxFee, baseFee))
+}
+
+func ErrGetBaseFee(err error) Error {
+	return NewError(CodeGetBaseFeeError, fmt.Sprintf("%s: %s", GetBaseFeeError, err.Error()))
+}
+
+func ErrSetBaseFee(err error) Error {
+	return NewError(CodeSetBaseFeeError, fmt.Sprintf("%s: %s", SetBaseFeeError, err.Error()))
+}
+
+func ErrTransactionExpired(maxHeight, height int64) Error {
+	return NewError(CodeTransactionExpiredError, fmt.Sprintf("%s: %d < %d", TransactionExpiredError, maxHeight, height))
+}
+
+func ErrMaxHeightBeyondValidityWindow(maxHeight, maxAllowedHeight int64) Error {
+	return NewError(CodeMaxHeightBeyondValidityWindowError, fmt.Sprintf("%s: %d > %d", MaxHeightBeyondValidityWindowError, maxHeight, maxAllowedHeight))
+}
+
+func ErrGetTransactionHashExists(err error) Error {
+	return NewError(CodeGetTransactionHashExistsError, fmt.Sprintf("%s: %s", GetTransactionHashExistsError, err.Error()))
+}
+
+func ErrInsertTransactionHash(err error) Error {
+	return NewError(CodeInsertTransactionHashError, fmt.Sprintf("%s: %s", InsertTransactionHashError, err.Error()))
+}
+
+func ErrPruneTransactionHashes(err error) Error {
+	return NewError(CodePruneTransactionHashesError, fmt.Sprintf("%s: %s", PruneTransactionHashesError, err.Error()))
+}
+
+func ErrInvalidEvidence(err error) Error {
+	return NewError(CodeInvalidEvidenceError, fmt.Sprintf("%s: %s", InvalidEvidenceError, err.Error()))
+}
+
+func ErrSameOperatorKey() Error {
+	return NewError(CodeSameOperatorKeyError, fmt.Sprintf("%s", SameOperatorKeyError))
+}
+
+func ErrRotateKey(err error) Error {
+	return NewError(CodeRotateKeyError, fmt.Sprintf("%s: %s", RotateKeyError, err.Error()))
+}
+
+func ErrSetOutputAddress(err error) Error {
+	return NewError(CodeSetOutputAddressError, fmt.Sprintf("%s: %s", SetOutputAddressError, err.Error()))
+}
+
+func ErrInvalidBLSKey(err error) Error {
+	return NewError(CodeInvalidBLSKeyError, fmt.Sprintf("%s: %s", InvalidBLSKeyError, err.Error()))
+}
+
+func ErrInvalidBLSProofOfPossession() Error {
+	return NewError(CodeInvalidBLSProofOfPossessionError, fmt.Sprintf("%s", InvalidBLSProofOfPossessionError))
+}
+
+func ErrSetBLSKey(err error) Error {
+	return NewError(CodeSetBLSKeyError, fmt.Sprintf("%s: %s", SetBLSKeyError, err.Error()))
+}
+
+func ErrGetBlockTime(err error) Error {
+	return NewError(CodeGetBlockTimeError, fmt.Sprintf("%s: %s", GetBlockTimeError, err.Error()))
+}
+
+func ErrCommissionChangeTooLarge(percentage, previousPercentage uint32, maxChange int) Error {
+	return NewError(CodeCommissionChangeTooLargeError, fmt.Sprintf("%s: %d -> %d, max change %d", CommissionChangeTooLargeError, previousPercentage, percentage, maxChange))
+}
@@ -0,0 +1,25 @@
+package types
+
+import "math/big"
+
+// CalculateNextBaseFee moves the base fee towards the price at which blocks are `targetBlockSizeInBytes`
+// large: it increases when the block was fuller than the target, decreases when it was emptier, and
+// changes by at most 1/`changeDenominator` per block. The result never drops below `minBaseFee`.
+func CalculateNextBaseFee(baseFee *big.Int, blockSizeInBytes, targetBlockSizeInBytes, changeDenominator int, minBaseFee *big.Int) *big.Int {
+	nextBaseFee := new(big.Int).Set(baseFee)
+	if targetBlockSizeInBytes > 0 && changeDenominator > 0 {
+		// delta = baseFee * (blockSize - target) / target / changeDenominator
+		delta := new(big.Int).Mul(baseFee, big.NewInt(int64(blockSizeInBytes-targetBlockSizeInBytes)))
+		delta.Quo(delta, big.NewInt(int64(targetBlockSizeInBytes)))
+		delta.Quo(delta, big.NewInt(int64(changeDenominator)))
+		// guarantee that a small base fee still increases when blocks are fuller than the target
+		if blockSizeInBytes > targetBlockSizeInBytes && delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+		nextBaseFee.Add(nextBaseFee, delta)
+	}
+	if nextBaseFee.Cmp(minBaseFee) == -1 {
+		return new(big.Int).Set(minBaseFee)
+	}
+	return nextBaseFee
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateNextBaseFee(t *testing.T) {
+	minBaseFee := big.NewInt(1000)
+	targetBlockSizeInBytes, changeDenominator := 100, 8
+
+	got := CalculateNextBaseFee(big.NewInt(8000), 100, targetBlockSizeInBytes, changeDenominator, minBaseFee)
+	require.Equal(t, big.NewInt(8000), got, "a block at the target size keeps the base fee")
+
+	got = CalculateNextBaseFee(big.NewInt(8000), 200, targetBlockSizeInBytes, changeDenominator, minBaseFee)
+	require.Equal(t, big.NewInt(9000), got, "a block twice the target size increases the base fee by 1/denominator")
+
+	got = CalculateNextBaseFee(big.NewInt(8000), 0, targetBlockSizeInBytes, changeDenominator, minBaseFee)
+	require.Equal(t, big.NewInt(7000), got, "an empty block decreases the base fee by 1/denominator")
+
+	got = CalculateNextBaseFee(big.NewInt(1000), 0, targetBlockSizeInBytes, changeDenominator, minBaseFee)
+	require.Equal(t, minBaseFee, got, "the base fee never drops below the minimum")
+
+	got = CalculateNextBaseFee(big.NewInt(1), 101, targetBlockSizeInBytes, changeDenominator, big.NewInt(0))
+	require.Equal(t, big.NewInt(2), got, "the base fee increases by at least one when a block is above the target")
+}
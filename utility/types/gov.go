@@ -63,6 +63,29 @@ const (
 	MessagePauseServiceNodeFee          = "message_pause_service_node_fee"
 	MessageUnpauseServiceNodeFee        = "message_unpause_service_node_fee"
 	MessageChangeParameterFee           = "message_change_parameter_fee"
+	MessageSubmitProposalFee            = "message_submit_proposal_fee"
+	MessageVoteFee                      = "message_vote_fee"
+	MessageUpgradePlanFee               = "message_upgrade_plan_fee"
+	MessageDelegateFee                  = "message_delegate_fee"
+	MessageUndelegateFee                = "message_undelegate_fee"
+	MessageSetCommissionFee             = "message_set_commission_fee"
+	MessageRotateKeyFee                 = "message_rotate_key_fee"
+	MessageChangeOutputAddressFee       = "message_change_output_address_fee"
+	MessageRegisterBLSKeyFee            = "message_register_bls_key_fee"
+
+	GovernanceMinDepositParamName              = "governance_min_deposit"
+	GovernanceVotingPeriodBlocksParamName      = "governance_voting_period_blocks"
+	GovernanceQuorumPercentageParamName        = "governance_quorum_percentage"
+	GovernancePassThresholdPercentageParamName = "governance_pass_threshold_percentage"
+
+	FeeMarketTargetBlockSizeBytesParamName     = "fee_market_target_block_size_bytes"
+	FeeMarketBaseFeeChangeDenominatorParamName = "fee_market_base_fee_change_denominator"
+	FeeMarketMinBaseFeeParamName               = "fee_market_min_base_fee"
+
+	TransactionMaxValidityBlocksParamName = "transaction_max_validity_blocks"
+
+	CommissionMaxChangePercentageParamName = "commission_max_change_percentage"
+	CommissionChangePeriodBlocksParamName  = "commission_change_period_blocks"
 
 	AclOwner                                 = "acl_owner"
 	BlocksPerSessionOwner                    = "blocks_per_session_owner"
@@ -119,4 +142,27 @@ const (
 	MessagePauseServiceNodeFeeOwner          = "message_pause_service_node_fee_owner"
 	MessageUnpauseServiceNodeFeeOwner        = "message_unpause_service_node_fee_owner"
 	MessageChangeParameterFeeOwner           = "message_change_parameter_fee_owner"
+	MessageSubmitProposalFeeOwner            = "message_submit_proposal_fee_owner"
+	MessageVoteFeeOwner                      = "message_vote_fee_owner"
+	MessageUpgradePlanFeeOwner               = "message_upgrade_plan_fee_owner"
+	MessageDelegateFeeOwner                  = "message_delegate_fee_owner"
+	MessageUndelegateFeeOwner                = "message_undelegate_fee_owner"
+	MessageSetCommissionFeeOwner             = "message_set_commission_fee_owner"
+	MessageRotateKeyFeeOwner                 = "message_rotate_key_fee_owner"
+	MessageChangeOutputAddressFeeOwner       = "message_change_output_address_fee_owner"
+	MessageRegisterBLSKeyFeeOwner            = "message_register_bls_key_fee_owner"
+
+	GovernanceMinDepositOwner              = "governance_min_deposit_owner"
+	GovernanceVotingPeriodBlocksOwner      = "governance_voting_period_blocks_owner"
+	GovernanceQuorumPercentageOwner        = "governance_quorum_percentage_owner"
+	GovernancePassThresholdPercentageOwner = "governance_pass_threshold_percentage_owner"
+
+	FeeMarketTargetBlockSizeBytesOwner     = "fee_market_target_block_size_bytes_owner"
+	FeeMarketBaseFeeChangeDenominatorOwner = "fee_market_base_fee_change_denominator_owner"
+	FeeMarketMinBaseFeeOwner               = "fee_market_min_base_fee_owner"
+
+	TransactionMaxValidityBlocksOwner = "transaction_max_validity_blocks_owner"
+
+	CommissionMaxChangePercentageOwner = "commission_max_change_percentage_owner"
+	CommissionChangePeriodBlocksOwner  = "commission_change_period_blocks_owner"
 )
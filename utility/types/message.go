@@ -57,11 +57,24 @@ var _ Message = &MessageUnstake{}
 var _ Message = &MessageUnpause{}
 var _ Message = &MessageChangeParameter{}
 var _ Message = &MessageDoubleSign{}
+var _ Message = &MessageSubmitProposal{}
+var _ Message = &MessageVote{}
+var _ Message = &MessageUpgradePlan{}
+var _ Message = &MessageDelegate{}
+var _ Message = &MessageUndelegate{}
+var _ Message = &MessageSetCommission{}
+var _ Message = &MessageRotateKey{}
+var _ Message = &MessageChangeOutputAddress{}
+var _ Message = &MessageRegisterBLSKey{}
 
 func (msg *MessageSend) GetActorType() coreTypes.ActorType {
 	return coreTypes.ActorType_ACTOR_TYPE_UNSPECIFIED // there's no actor type for message send, so return zero to allow fee retrieval
 }
 
+func (msg *MessageRegisterBLSKey) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL // only validators sign consensus votes
+}
+
 func (msg *MessageStake) ValidateBasic() Error {
 	if err := ValidatePublicKey(msg.GetPublicKey()); err != nil {
 		return err
@@ -130,13 +143,157 @@ func (msg *MessageChangeParameter) ValidateBasic() Error {
 	return nil
 }
 
-func (msg *MessageSend) GetMessageName() string            { return getMessageType(msg) }
-func (msg *MessageUnstake) GetMessageName() string         { return getMessageType(msg) }
-func (msg *MessageUnpause) GetMessageName() string         { return getMessageType(msg) }
-func (msg *MessageEditStake) GetMessageName() string       { return getMessageType(msg) }
-func (msg *MessageStake) GetMessageName() string           { return getMessageType(msg) }
-func (msg *MessageChangeParameter) GetMessageName() string { return getMessageType(msg) }
-func (msg *MessageDoubleSign) GetMessageName() string      { return getMessageType(msg) }
+func (msg *MessageSubmitProposal) ValidateBasic() Error {
+	if err := ValidateAddress(msg.Proposer); err != nil {
+		return err
+	}
+	if err := ValidateAmount(msg.Deposit); err != nil {
+		return err
+	}
+	switch msg.ProposalType {
+	case coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE, coreTypes.ProposalType_PROPOSAL_TYPE_FLAG_CHANGE:
+		if msg.Key == "" {
+			return ErrEmptyParamKey()
+		}
+		if msg.Value == nil {
+			return ErrEmptyParamValue()
+		}
+	case coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND:
+		if msg.PoolName == "" {
+			return ErrUnknownPool(msg.PoolName)
+		}
+		if err := ValidateAddress(msg.Recipient); err != nil {
+			return err
+		}
+		if err := ValidateAmount(msg.Amount); err != nil {
+			return err
+		}
+	default:
+		return ErrUnknownProposalType(msg.ProposalType)
+	}
+	return nil
+}
+
+func (msg *MessageVote) ValidateBasic() Error {
+	if err := ValidateAddress(msg.Voter); err != nil {
+		return err
+	}
+	switch msg.Option {
+	case coreTypes.VoteOption_VOTE_OPTION_YES, coreTypes.VoteOption_VOTE_OPTION_NO, coreTypes.VoteOption_VOTE_OPTION_ABSTAIN:
+		return nil
+	default:
+		return ErrInvalidVoteOption(msg.Option)
+	}
+}
+
+func (msg *MessageUpgradePlan) ValidateBasic() Error {
+	if err := ValidateAddress(msg.Signer); err != nil {
+		return err
+	}
+	if msg.Name == "" || len(UpgradeFlagName(msg.Name)) > MaxFlagNameLength {
+		return ErrInvalidUpgradeName(msg.Name)
+	}
+	if !msg.Cancel && msg.Height <= 0 {
+		return ErrInvalidUpgradeHeight(msg.Height, 0)
+	}
+	return nil
+}
+
+func (msg *MessageDelegate) ValidateBasic() Error {
+	if err := ValidateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := ValidateDelegationActorType(msg.ActorType); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.ActorAddress); err != nil {
+		return err
+	}
+	return ValidateAmount(msg.Amount)
+}
+
+func (msg *MessageUndelegate) ValidateBasic() Error {
+	if err := ValidateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := ValidateDelegationActorType(msg.ActorType); err != nil {
+		return err
+	}
+	return ValidateAddress(msg.ActorAddress)
+}
+
+func (msg *MessageSetCommission) ValidateBasic() Error {
+	if err := ValidateDelegationActorType(msg.ActorType); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	if msg.Percentage > 100 {
+		return ErrInvalidCommissionPercentage(msg.Percentage)
+	}
+	return nil
+}
+
+func (msg *MessageRotateKey) ValidateBasic() Error {
+	if err := ValidateActorType(msg.ActorType); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	if err := ValidatePublicKey(msg.NewPublicKey); err != nil {
+		return err
+	}
+	pk, er := cryptoPocket.NewPublicKeyFromBytes(msg.NewPublicKey)
+	if er != nil {
+		return ErrNewPublicKeyFromBytes(er)
+	}
+	if bytes.Equal(pk.Address(), msg.Address) {
+		return ErrSameOperatorKey()
+	}
+	return nil
+}
+
+func (msg *MessageChangeOutputAddress) ValidateBasic() Error {
+	if err := ValidateActorType(msg.ActorType); err != nil {
+		return err
+	}
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	return ValidateOutputAddress(msg.NewOutputAddress)
+}
+
+func (msg *MessageRegisterBLSKey) ValidateBasic() Error {
+	if err := ValidateAddress(msg.Address); err != nil {
+		return err
+	}
+	if er := cryptoPocket.ValidateBLSPublicKey(msg.PublicKey); er != nil {
+		return ErrInvalidBLSKey(er)
+	}
+	if !cryptoPocket.VerifyBLSPossession(msg.PublicKey, msg.ProofOfPossession) {
+		return ErrInvalidBLSProofOfPossession()
+	}
+	return nil
+}
+
+func (msg *MessageSend) GetMessageName() string                { return getMessageType(msg) }
+func (msg *MessageUnstake) GetMessageName() string             { return getMessageType(msg) }
+func (msg *MessageUnpause) GetMessageName() string             { return getMessageType(msg) }
+func (msg *MessageEditStake) GetMessageName() string           { return getMessageType(msg) }
+func (msg *MessageStake) GetMessageName() string               { return getMessageType(msg) }
+func (msg *MessageChangeParameter) GetMessageName() string     { return getMessageType(msg) }
+func (msg *MessageDoubleSign) GetMessageName() string          { return getMessageType(msg) }
+func (msg *MessageSubmitProposal) GetMessageName() string      { return getMessageType(msg) }
+func (msg *MessageVote) GetMessageName() string                { return getMessageType(msg) }
+func (msg *MessageUpgradePlan) GetMessageName() string         { return getMessageType(msg) }
+func (msg *MessageDelegate) GetMessageName() string            { return getMessageType(msg) }
+func (msg *MessageUndelegate) GetMessageName() string          { return getMessageType(msg) }
+func (msg *MessageSetCommission) GetMessageName() string       { return getMessageType(msg) }
+func (msg *MessageRotateKey) GetMessageName() string           { return getMessageType(msg) }
+func (msg *MessageChangeOutputAddress) GetMessageName() string { return getMessageType(msg) }
+func (msg *MessageRegisterBLSKey) GetMessageName() string      { return getMessageType(msg) }
 
 func (msg *MessageSend) GetMessageRecipient() string            { return hex.EncodeToString(msg.ToAddress) }
 func (msg *MessageUnstake) GetMessageRecipient() string         { return "" }
@@ -145,6 +302,17 @@ func (msg *MessageEditStake) GetMessageRecipient() string       { return "" }
 func (msg *MessageStake) GetMessageRecipient() string           { return "" }
 func (msg *MessageChangeParameter) GetMessageRecipient() string { return "" }
 func (msg *MessageDoubleSign) GetMessageRecipient() string      { return "" }
+func (msg *MessageSubmitProposal) GetMessageRecipient() string  { return "" }
+func (msg *MessageVote) GetMessageRecipient() string            { return "" }
+func (msg *MessageUpgradePlan) GetMessageRecipient() string     { return "" }
+func (msg *MessageDelegate) GetMessageRecipient() string        { return hex.EncodeToString(msg.ActorAddress) }
+func (msg *MessageUndelegate) GetMessageRecipient() string {
+	return hex.EncodeToString(msg.ActorAddress)
+}
+func (msg *MessageSetCommission) GetMessageRecipient() string       { return "" }
+func (msg *MessageRotateKey) GetMessageRecipient() string           { return "" }
+func (msg *MessageChangeOutputAddress) GetMessageRecipient() string { return "" }
+func (msg *MessageRegisterBLSKey) GetMessageRecipient() string      { return "" }
 
 func (msg *MessageUnstake) ValidateBasic() Error { return ValidateAddress(msg.Address) }
 func (msg *MessageUnpause) ValidateBasic() Error { return ValidateAddress(msg.Address) }
@@ -158,14 +326,35 @@ func (msg *MessageSend) SetSigner(signer []byte)                    { /*no op*/
 func (msg *MessageChangeParameter) SetSigner(signer []byte)         { msg.Signer = signer }
 func (x *MessageChangeParameter) GetActorType() coreTypes.ActorType { return -1 }
 func (x *MessageDoubleSign) GetActorType() coreTypes.ActorType      { return -1 }
-
-func (msg *MessageStake) GetCanonicalBytes() []byte           { return getCanonicalBytes(msg) }
-func (msg *MessageEditStake) GetCanonicalBytes() []byte       { return getCanonicalBytes(msg) }
-func (msg *MessageDoubleSign) GetCanonicalBytes() []byte      { return getCanonicalBytes(msg) }
-func (msg *MessageSend) GetCanonicalBytes() []byte            { return getCanonicalBytes(msg) }
-func (msg *MessageChangeParameter) GetCanonicalBytes() []byte { return getCanonicalBytes(msg) }
-func (msg *MessageUnstake) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
-func (msg *MessageUnpause) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
+func (msg *MessageSubmitProposal) SetSigner(signer []byte)          { /*no op*/ }
+func (msg *MessageVote) SetSigner(signer []byte)                    { msg.Signer = signer }
+func (x *MessageSubmitProposal) GetActorType() coreTypes.ActorType  { return -1 }
+func (x *MessageVote) GetActorType() coreTypes.ActorType            { return coreTypes.ActorType_ACTOR_TYPE_VAL }
+func (msg *MessageUpgradePlan) SetSigner(signer []byte)             { msg.Signer = signer }
+func (x *MessageUpgradePlan) GetActorType() coreTypes.ActorType     { return -1 }
+func (msg *MessageDelegate) SetSigner(signer []byte)                { /*no op*/ }
+func (msg *MessageUndelegate) SetSigner(signer []byte)              { /*no op*/ }
+func (msg *MessageRotateKey) SetSigner(signer []byte)               { msg.Signer = signer }
+func (msg *MessageChangeOutputAddress) SetSigner(signer []byte)     { msg.Signer = signer }
+func (msg *MessageSetCommission) SetSigner(signer []byte)           { msg.Signer = signer }
+func (msg *MessageRegisterBLSKey) SetSigner(signer []byte)          { msg.Signer = signer }
+
+func (msg *MessageStake) GetCanonicalBytes() []byte               { return getCanonicalBytes(msg) }
+func (msg *MessageEditStake) GetCanonicalBytes() []byte           { return getCanonicalBytes(msg) }
+func (msg *MessageDoubleSign) GetCanonicalBytes() []byte          { return getCanonicalBytes(msg) }
+func (msg *MessageSend) GetCanonicalBytes() []byte                { return getCanonicalBytes(msg) }
+func (msg *MessageChangeParameter) GetCanonicalBytes() []byte     { return getCanonicalBytes(msg) }
+func (msg *MessageUnstake) GetCanonicalBytes() []byte             { return getCanonicalBytes(msg) }
+func (msg *MessageUnpause) GetCanonicalBytes() []byte             { return getCanonicalBytes(msg) }
+func (msg *MessageSubmitProposal) GetCanonicalBytes() []byte      { return getCanonicalBytes(msg) }
+func (msg *MessageVote) GetCanonicalBytes() []byte                { return getCanonicalBytes(msg) }
+func (msg *MessageUpgradePlan) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
+func (msg *MessageDelegate) GetCanonicalBytes() []byte            { return getCanonicalBytes(msg) }
+func (msg *MessageUndelegate) GetCanonicalBytes() []byte          { return getCanonicalBytes(msg) }
+func (msg *MessageSetCommission) GetCanonicalBytes() []byte       { return getCanonicalBytes(msg) }
+func (msg *MessageRotateKey) GetCanonicalBytes() []byte           { return getCanonicalBytes(msg) }
+func (msg *MessageChangeOutputAddress) GetCanonicalBytes() []byte { return getCanonicalBytes(msg) }
+func (msg *MessageRegisterBLSKey) GetCanonicalBytes() []byte      { return getCanonicalBytes(msg) }
 
 // helpers
 
@@ -241,6 +430,16 @@ func ValidateActorType(_ coreTypes.ActorType) Error {
 	return nil
 }
 
+// ValidateDelegationActorType ensures the actor type is one that shares its rewards with delegators
+func ValidateDelegationActorType(actorType coreTypes.ActorType) Error {
+	switch actorType {
+	case coreTypes.ActorType_ACTOR_TYPE_VAL, coreTypes.ActorType_ACTOR_TYPE_SERVICENODE:
+		return nil
+	default:
+		return ErrInvalidDelegationActorType(actorType)
+	}
+}
+
 func ValidateServiceUrl(actorType coreTypes.ActorType, uri string) Error {
 	if actorType == coreTypes.ActorType_ACTOR_TYPE_APP {
 		return nil
@@ -2,6 +2,7 @@ package types
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/pokt-network/pocket/shared/codec"
@@ -261,6 +262,255 @@ func TestMessageUnpause_ValidateBasic(t *testing.T) {
 	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
 }
 
+func TestMessageSubmitProposal_ValidateBasic(t *testing.T) {
+	proposer, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	paramValueAny, err := codec.GetCodec().ToAny(wrapperspb.Int32(1))
+	require.NoError(t, err)
+
+	msg := MessageSubmitProposal{
+		Proposer:     proposer,
+		ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_PARAM_CHANGE,
+		Key:          "key",
+		Value:        paramValueAny,
+		Deposit:      defaultAmount,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgMissingProposer := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingProposer.Proposer = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingProposer.ValidateBasic().Code())
+
+	msgMissingDeposit := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingDeposit.Deposit = ""
+	require.Equal(t, ErrEmptyAmount().Code(), msgMissingDeposit.ValidateBasic().Code())
+
+	msgMissingKey := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingKey.Key = ""
+	require.Equal(t, ErrEmptyParamKey().Code(), msgMissingKey.ValidateBasic().Code())
+
+	msgMissingValue := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingValue.Value = nil
+	require.Equal(t, ErrEmptyParamValue().Code(), msgMissingValue.ValidateBasic().Code())
+
+	msgUnknownType := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgUnknownType.ProposalType = coreTypes.ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+	require.Equal(t, CodeUnknownProposalTypeError, msgUnknownType.ValidateBasic().Code())
+
+	msgPoolSpend := MessageSubmitProposal{
+		Proposer:     proposer,
+		ProposalType: coreTypes.ProposalType_PROPOSAL_TYPE_POOL_SPEND,
+		PoolName:     coreTypes.Pools_POOLS_DAO.FriendlyName(),
+		Recipient:    proposer,
+		Amount:       defaultAmount,
+		Deposit:      defaultAmount,
+	}
+	require.NoError(t, msgPoolSpend.ValidateBasic())
+
+	msgMissingPool := proto.Clone(&msgPoolSpend).(*MessageSubmitProposal)
+	msgMissingPool.PoolName = ""
+	require.Equal(t, CodeUnknownPoolError, msgMissingPool.ValidateBasic().Code())
+
+	msgMissingRecipient := proto.Clone(&msgPoolSpend).(*MessageSubmitProposal)
+	msgMissingRecipient.Recipient = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingRecipient.ValidateBasic().Code())
+}
+
+func TestMessageVote_ValidateBasic(t *testing.T) {
+	voter, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageVote{
+		Voter:      voter,
+		ProposalId: 1,
+		Option:     coreTypes.VoteOption_VOTE_OPTION_YES,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgMissingVoter := proto.Clone(&msg).(*MessageVote)
+	msgMissingVoter.Voter = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingVoter.ValidateBasic().Code())
+
+	msgMissingOption := proto.Clone(&msg).(*MessageVote)
+	msgMissingOption.Option = coreTypes.VoteOption_VOTE_OPTION_UNSPECIFIED
+	require.Equal(t, CodeInvalidVoteOptionError, msgMissingOption.ValidateBasic().Code())
+}
+
+func TestMessageUpgradePlan_ValidateBasic(t *testing.T) {
+	signer, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageUpgradePlan{
+		Signer: signer,
+		Name:   "upgrade",
+		Height: 10,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgMissingSigner := proto.Clone(&msg).(*MessageUpgradePlan)
+	msgMissingSigner.Signer = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingSigner.ValidateBasic().Code())
+
+	msgMissingName := proto.Clone(&msg).(*MessageUpgradePlan)
+	msgMissingName.Name = ""
+	require.Equal(t, CodeInvalidUpgradeNameError, msgMissingName.ValidateBasic().Code())
+
+	msgNameTooLong := proto.Clone(&msg).(*MessageUpgradePlan)
+	msgNameTooLong.Name = strings.Repeat("a", MaxFlagNameLength)
+	require.Equal(t, CodeInvalidUpgradeNameError, msgNameTooLong.ValidateBasic().Code())
+
+	msgMissingHeight := proto.Clone(&msg).(*MessageUpgradePlan)
+	msgMissingHeight.Height = 0
+	require.Equal(t, CodeInvalidUpgradeHeightError, msgMissingHeight.ValidateBasic().Code())
+
+	msgCancel := proto.Clone(msgMissingHeight).(*MessageUpgradePlan)
+	msgCancel.Cancel = true
+	require.NoError(t, msgCancel.ValidateBasic(), "cancelling does not require a height")
+}
+
+func TestMessageDelegate_ValidateBasic(t *testing.T) {
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	actorAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageDelegate{
+		Delegator:    delegator,
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_SERVICENODE,
+		ActorAddress: actorAddress,
+		Amount:       defaultAmount,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgMissingDelegator := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingDelegator.Delegator = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingDelegator.ValidateBasic().Code())
+
+	msgInvalidActorType := proto.Clone(&msg).(*MessageDelegate)
+	msgInvalidActorType.ActorType = coreTypes.ActorType_ACTOR_TYPE_APP
+	require.Equal(t, CodeInvalidDelegationActorTypeError, msgInvalidActorType.ValidateBasic().Code())
+
+	msgMissingActorAddress := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingActorAddress.ActorAddress = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingActorAddress.ValidateBasic().Code())
+
+	msgMissingAmount := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingAmount.Amount = ""
+	require.Equal(t, ErrEmptyAmount().Code(), msgMissingAmount.ValidateBasic().Code())
+}
+
+func TestMessageUndelegate_ValidateBasic(t *testing.T) {
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	actorAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageUndelegate{
+		Delegator:    delegator,
+		ActorAddress: actorAddress,
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_SERVICENODE,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgInvalidActorType := proto.Clone(&msg).(*MessageUndelegate)
+	msgInvalidActorType.ActorType = coreTypes.ActorType_ACTOR_TYPE_APP
+	require.Equal(t, CodeInvalidDelegationActorTypeError, msgInvalidActorType.ValidateBasic().Code())
+
+	msgMissingActorAddress := proto.Clone(&msg).(*MessageUndelegate)
+	msgMissingActorAddress.ActorAddress = nil
+	require.Equal(t, ErrEmptyAddress().Code(), msgMissingActorAddress.ValidateBasic().Code())
+}
+
+func TestMessageSetCommission_ValidateBasic(t *testing.T) {
+	address, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageSetCommission{
+		ActorType:  coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:    address,
+		Percentage: 100,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgInvalidPercentage := proto.Clone(&msg).(*MessageSetCommission)
+	msgInvalidPercentage.Percentage = 101
+	require.Equal(t, CodeInvalidCommissionPercentageError, msgInvalidPercentage.ValidateBasic().Code())
+
+	msgInvalidActorType := proto.Clone(&msg).(*MessageSetCommission)
+	msgInvalidActorType.ActorType = coreTypes.ActorType_ACTOR_TYPE_FISH
+	require.Equal(t, CodeInvalidDelegationActorTypeError, msgInvalidActorType.ValidateBasic().Code())
+}
+
+func TestMessageRotateKey_ValidateBasic(t *testing.T) {
+	publicKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+	newPublicKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	msg := MessageRotateKey{
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:      publicKey.Address(),
+		NewPublicKey: newPublicKey.Bytes(),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgMissingKey := proto.Clone(&msg).(*MessageRotateKey)
+	msgMissingKey.NewPublicKey = nil
+	require.Equal(t, CodeEmptyPublicKeyError, msgMissingKey.ValidateBasic().Code())
+
+	msgSameKey := proto.Clone(&msg).(*MessageRotateKey)
+	msgSameKey.NewPublicKey = publicKey.Bytes()
+	require.Equal(t, CodeSameOperatorKeyError, msgSameKey.ValidateBasic().Code())
+}
+
+func TestMessageChangeOutputAddress_ValidateBasic(t *testing.T) {
+	address, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	newOutputAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageChangeOutputAddress{
+		ActorType:        coreTypes.ActorType_ACTOR_TYPE_APP,
+		Address:          address,
+		NewOutputAddress: newOutputAddress,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgMissingOutput := proto.Clone(&msg).(*MessageChangeOutputAddress)
+	msgMissingOutput.NewOutputAddress = nil
+	require.Equal(t, CodeNilOutputAddress, msgMissingOutput.ValidateBasic().Code())
+}
+
+func TestMessageRegisterBLSKey_ValidateBasic(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	blsKey, err := crypto.NewBLSPrivateKeyFromPrivateKey(privateKey)
+	require.NoError(t, err)
+	proof, err := blsKey.ProvePossession()
+	require.NoError(t, err)
+
+	msg := MessageRegisterBLSKey{
+		Address:           privateKey.Address(),
+		PublicKey:         blsKey.PublicKey(),
+		ProofOfPossession: proof,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msgInvalidKey := proto.Clone(&msg).(*MessageRegisterBLSKey)
+	msgInvalidKey.PublicKey = msg.PublicKey[1:]
+	require.Equal(t, CodeInvalidBLSKeyError, msgInvalidKey.ValidateBasic().Code())
+
+	// The proof of possession of another key cannot be replayed
+	otherPrivateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherBLSKey, err := crypto.NewBLSPrivateKeyFromPrivateKey(otherPrivateKey)
+	require.NoError(t, err)
+	msgInvalidProof := proto.Clone(&msg).(*MessageRegisterBLSKey)
+	msgInvalidProof.PublicKey = otherBLSKey.PublicKey()
+	require.Equal(t, CodeInvalidBLSProofOfPossessionError, msgInvalidProof.ValidateBasic().Code())
+}
+
 func TestRelayChain_Validate(t *testing.T) {
 	relayChainValid := RelayChain("0001")
 	err := relayChainValid.Validate()
@@ -2,8 +2,10 @@ package types
 
 import (
 	"bytes"
+	"math/big"
 
 	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 	"google.golang.org/protobuf/proto"
@@ -44,9 +46,60 @@ func (tx *Transaction) ValidateBasic() Error {
 	if _, err := tx.Message(); err != nil {
 		return err
 	}
+	maxFee, err := tx.GetMaxFeeAmount()
+	if err != nil {
+		return err
+	}
+	tip, err := tx.GetTipAmount()
+	if err != nil {
+		return err
+	}
+	if maxFee != nil && tip.Cmp(maxFee) == 1 {
+		return ErrTipExceedsMaxFee(tx.Tip, tx.MaxFee)
+	}
+	return nil
+}
+
+// ValidateMaxHeight returns an error if the transaction cannot be included in a block at `height`,
+// either because its max height has passed or because it would stay valid for more than
+// `maxValidityBlocks`, which is the window during which its hash must be remembered to reject replays
+func (tx *Transaction) ValidateMaxHeight(height, maxValidityBlocks int64) Error {
+	if tx.MaxHeight < height {
+		return ErrTransactionExpired(tx.MaxHeight, height)
+	}
+	if maxAllowedHeight := height + maxValidityBlocks; tx.MaxHeight > maxAllowedHeight {
+		return ErrMaxHeightBeyondValidityWindow(tx.MaxHeight, maxAllowedHeight)
+	}
 	return nil
 }
 
+// GetMaxFeeAmount returns the max fee of the transaction, which is nil if the signer did not set one
+func (tx *Transaction) GetMaxFeeAmount() (*big.Int, Error) {
+	if tx.MaxFee == "" {
+		return nil, nil
+	}
+	return stringToNonNegativeBigInt(tx.MaxFee)
+}
+
+// GetTipAmount returns the tip of the transaction, which defaults to zero
+func (tx *Transaction) GetTipAmount() (*big.Int, Error) {
+	if tx.Tip == "" {
+		return big.NewInt(0), nil
+	}
+	return stringToNonNegativeBigInt(tx.Tip)
+}
+
+func stringToNonNegativeBigInt(s string) (*big.Int, Error) {
+	amount, err := StringToBigInt(s)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() == -1 {
+		return nil, ErrInvalidAmount()
+	}
+	return amount, nil
+}
+
 func (tx *Transaction) Message() (Message, Error) {
 	codec := codec.GetCodec()
 	msg, er := codec.FromAny(tx.Msg)
@@ -136,7 +189,7 @@ func (x *DefaultTxResult) HashFromBytes(bz []byte) ([]byte, error) {
 	return crypto.SHA3Hash(bz), nil
 }
 
-func (tx *Transaction) ToTxResult(height int64, index int, signer, recipient, msgType string, error Error) (*DefaultTxResult, Error) {
+func (tx *Transaction) ToTxResult(height int64, index int, signer, recipient, msgType string, events []*coreTypes.Event, error Error) (*DefaultTxResult, Error) {
 	txBytes, err := tx.Bytes()
 	if err != nil {
 		return nil, err
@@ -144,7 +197,7 @@ func (tx *Transaction) ToTxResult(height int64, index int, signer, recipient, ms
 	code, errString := int32(0), ""
 	if error != nil {
 		code = int32(error.Code())
-		errString = err.Error()
+		errString = error.Error()
 	}
 	return &DefaultTxResult{
 		Tx:            txBytes,
@@ -155,6 +208,7 @@ func (tx *Transaction) ToTxResult(height int64, index int, signer, recipient, ms
 		SignerAddr:    signer,
 		RecipientAddr: recipient,
 		MessageType:   msgType,
+		Events:        events,
 	}, nil
 }
 
@@ -123,4 +123,30 @@ func TestTransaction_ValidateBasic(t *testing.T) {
 	er = txInvalidSignature.ValidateBasic()
 	require.Equal(t, ErrSignatureVerificationFailed().Code(), er.Code())
 
+	txTipAboveMaxFee := NewUnsignedTestingTransaction(t)
+	txTipAboveMaxFee.MaxFee = "100"
+	txTipAboveMaxFee.Tip = "101"
+	require.NoError(t, txTipAboveMaxFee.Sign(testingSenderPrivateKey))
+	er = txTipAboveMaxFee.ValidateBasic()
+	require.Equal(t, CodeTipExceedsMaxFeeError, er.Code())
+
+	txNegativeTip := NewUnsignedTestingTransaction(t)
+	txNegativeTip.Tip = "-1"
+	require.NoError(t, txNegativeTip.Sign(testingSenderPrivateKey))
+	er = txNegativeTip.ValidateBasic()
+	require.Equal(t, CodeInvalidAmountError, er.Code())
+}
+
+func TestTransaction_ValidateMaxHeight(t *testing.T) {
+	tx := NewUnsignedTestingTransaction(t)
+	tx.MaxHeight = 10
+
+	require.NoError(t, tx.ValidateMaxHeight(10, 5), "a transaction can be included at its max height")
+	require.NoError(t, tx.ValidateMaxHeight(5, 5))
+
+	er := tx.ValidateMaxHeight(11, 5)
+	require.Equal(t, CodeTransactionExpiredError, er.Code())
+
+	er = tx.ValidateMaxHeight(4, 5)
+	require.Equal(t, CodeMaxHeightBeyondValidityWindowError, er.Code())
 }
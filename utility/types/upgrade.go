@@ -0,0 +1,37 @@
+package types
+
+// Protocol upgrades are scheduled via feature flags: the flag `UpgradeFlagPrefix + <upgrade name>`
+// holds the height at which the upgrade activates and whether it is enabled. Disabling the flag
+// before the activation height cancels the upgrade.
+const (
+	UpgradeFlagPrefix = "upgrade_"
+
+	// The length of the `name` column of the flags table
+	MaxFlagNameLength = 64
+)
+
+// The upgrades implemented by this binary
+const (
+	// Replaces the fixed per message fees with a base fee that adjusts to the block sizes plus a tip; see `utility/fee_market.go`
+	DynamicFeesUpgrade = "dynamic_fees"
+	// Limits the proposed blocks by their serialized size rather than the size of the block struct; see `consensus/upgrade.go`
+	BlockSizeLimitUpgrade = "block_size_limit"
+)
+
+// KnownUpgrades contains the upgrades implemented by this binary. A node that reaches the activation
+// height of an upgrade it does not know halts instead of applying blocks with the wrong rules.
+//
+// New upgrades must be registered here alongside the code paths they gate via `IsFeatureActive`.
+var KnownUpgrades = map[string]struct{}{
+	DynamicFeesUpgrade:    {},
+	BlockSizeLimitUpgrade: {},
+}
+
+func UpgradeFlagName(upgradeName string) string {
+	return UpgradeFlagPrefix + upgradeName
+}
+
+func IsKnownUpgrade(upgradeName string) bool {
+	_, ok := KnownUpgrades[upgradeName]
+	return ok
+}
@@ -0,0 +1,141 @@
+package utility
+
+import (
+	"strconv"
+	"strings"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+/*
+	This 'upgrade' file contains the height scheduled protocol upgrade logic.
+
+	An upgrade is scheduled by the ACL owner via `MessageUpgradePlan` (or via a governance flag change
+	proposal) which sets the feature flag `upgrade_<name>` to the activation height. From that height
+	onwards `IsFeatureActive(<name>)` returns true, allowing utility and consensus to switch code paths.
+
+	A node whose binary does not know an upgrade halts at, or past, the activation height rather than
+	applying blocks with the rules of the previous protocol version.
+*/
+
+func (u *UtilityContext) HandleMessageUpgradePlan(message *typesUtil.MessageUpgradePlan) typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	flagName := typesUtil.UpgradeFlagName(message.Name)
+	active, err := u.isFeatureActiveAtHeight(message.Name, height)
+	if err != nil {
+		return err
+	}
+	if active {
+		return typesUtil.ErrUpgradeAlreadyActive(message.Name)
+	}
+	if message.Cancel {
+		activationHeight, enabled, er := u.getUpgradeFlag(message.Name, height)
+		if er != nil {
+			return er
+		}
+		if !enabled {
+			return typesUtil.ErrUpgradeNotScheduled(message.Name)
+		}
+		if er := store.SetFlag(flagName, activationHeight, false); er != nil {
+			return typesUtil.ErrUpdateParam(er)
+		}
+		u.emitEvent(coreTypes.EventType_EVENT_TYPE_UPGRADE_PLAN,
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyUpgradeName, message.Name),
+			coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyHeight, strconv.FormatInt(typesUtil.HeightNotUsed, 10)))
+		return nil
+	}
+	if message.Height <= height {
+		return typesUtil.ErrInvalidUpgradeHeight(message.Height, height)
+	}
+	if er := store.SetFlag(flagName, message.Height, true); er != nil {
+		return typesUtil.ErrUpdateParam(er)
+	}
+	u.emitEvent(coreTypes.EventType_EVENT_TYPE_UPGRADE_PLAN,
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyUpgradeName, message.Name),
+		coreTypes.NewEventAttribute(coreTypes.EventAttributeKeyHeight, strconv.FormatInt(message.Height, 10)))
+	return nil
+}
+
+// IsFeatureActive returns whether the upgrade `featureName` is enabled and its activation height
+// has been reached at the height of the context
+func (u *UtilityContext) IsFeatureActive(featureName string) (bool, error) {
+	height, err := u.GetLatestBlockHeight()
+	if err != nil {
+		return false, err
+	}
+	return u.isFeatureActiveAtHeight(featureName, height)
+}
+
+// CheckScheduledUpgrades returns an `ErrUpgradeRequired` if an upgrade unknown to this binary is
+// active at the height of the context
+func (u *UtilityContext) CheckScheduledUpgrades() error {
+	return u.checkScheduledUpgrades()
+}
+
+func (u *UtilityContext) checkScheduledUpgrades() typesUtil.Error {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return err
+	}
+	flags, er := store.GetAllFlags(height)
+	if er != nil {
+		return typesUtil.ErrGetFlag(typesUtil.UpgradeFlagPrefix, er)
+	}
+	for _, flag := range flags {
+		if !strings.HasPrefix(flag.Name, typesUtil.UpgradeFlagPrefix) {
+			continue
+		}
+		upgradeName := strings.TrimPrefix(flag.Name, typesUtil.UpgradeFlagPrefix)
+		if typesUtil.IsKnownUpgrade(upgradeName) {
+			continue
+		}
+		activationHeight, enabled, err := u.getUpgradeFlag(upgradeName, height)
+		if err != nil {
+			return err
+		}
+		// A node restarted or synced past the activation height with an outdated binary must not apply blocks either
+		if enabled && activationHeight <= height {
+			return typesUtil.ErrUpgradeRequired(upgradeName, height)
+		}
+	}
+	return nil
+}
+
+func (u *UtilityContext) isFeatureActiveAtHeight(featureName string, height int64) (bool, typesUtil.Error) {
+	activationHeight, enabled, err := u.getUpgradeFlag(featureName, height)
+	if err != nil {
+		return false, err
+	}
+	return enabled && activationHeight <= height, nil
+}
+
+// getUpgradeFlag returns the activation height of the upgrade and whether it is enabled; an upgrade
+// that has never been scheduled is reported as disabled
+func (u *UtilityContext) getUpgradeFlag(upgradeName string, height int64) (activationHeight int64, enabled bool, err typesUtil.Error) {
+	store := u.Store()
+	flagName := typesUtil.UpgradeFlagName(upgradeName)
+	exists, er := store.GetFlagExists(flagName, height)
+	if er != nil {
+		return 0, false, typesUtil.ErrGetFlag(flagName, er)
+	}
+	if !exists {
+		return 0, false, nil
+	}
+	value, enabled, er := store.GetIntFlag(flagName, height)
+	if er != nil {
+		return 0, false, typesUtil.ErrGetFlag(flagName, er)
+	}
+	return int64(value), enabled, nil
+}
+
+func (u *UtilityContext) GetMessageUpgradePlanSignerCandidates(msg *typesUtil.MessageUpgradePlan) ([][]byte, typesUtil.Error) {
+	owner, err := u.GetParamOwner(typesUtil.AclOwner)
+	if err != nil {
+		return nil, typesUtil.ErrGetParam(typesUtil.AclOwner, err)
+	}
+	return [][]byte{owner}, nil
+}
@@ -0,0 +1,71 @@
+package utility
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// 'Vesting accounts' are accounts whose balance is partially locked until the heights defined by their vesting schedule.
+//  Locked tokens still count towards the balance of the account but cannot be sent, staked, delegated, deposited or
+//  spent on fees.
+
+func (u *UtilityContext) GetAccountVesting(address []byte) (*coreTypes.VestingSchedule, typesUtil.Error) {
+	store, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return nil, err
+	}
+	vesting, er := store.GetAccountVesting(address, height)
+	if er != nil {
+		return nil, typesUtil.ErrGetAccountVesting(er)
+	}
+	return vesting, nil
+}
+
+// GetAccountLockedAmount returns the part of the account's original vesting amount that has not vested yet
+func (u *UtilityContext) GetAccountLockedAmount(address []byte) (*big.Int, typesUtil.Error) {
+	vesting, err := u.GetAccountVesting(address)
+	if err != nil {
+		return nil, err
+	}
+	_, height, err := u.GetStoreAndHeight()
+	if err != nil {
+		return nil, err
+	}
+	locked, er := vesting.LockedAmount(height)
+	if er != nil {
+		return nil, typesUtil.ErrInvalidVestingSchedule(er)
+	}
+	return locked, nil
+}
+
+// GetAccountUnlockedAmount returns the part of the account's balance that is not locked by its vesting schedule
+func (u *UtilityContext) GetAccountUnlockedAmount(address []byte) (*big.Int, typesUtil.Error) {
+	accountAmount, err := u.GetAccountAmount(address)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := u.GetAccountLockedAmount(address)
+	if err != nil {
+		return nil, err
+	}
+	accountAmount.Sub(accountAmount, locked)
+	if accountAmount.Sign() == -1 {
+		return big.NewInt(0), nil
+	}
+	return accountAmount, nil
+}
+
+// checkUnlockedAmount ensures the account can spend `amount` without touching its locked tokens
+func (u *UtilityContext) checkUnlockedAmount(address []byte, amount *big.Int) typesUtil.Error {
+	unlocked, err := u.GetAccountUnlockedAmount(address)
+	if err != nil {
+		return err
+	}
+	if unlocked.Cmp(amount) == -1 {
+		return typesUtil.ErrInsufficientUnlockedAmount(hex.EncodeToString(address), typesUtil.BigIntToString(unlocked), typesUtil.BigIntToString(amount))
+	}
+	return nil
+}